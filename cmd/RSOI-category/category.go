@@ -5,7 +5,7 @@ import (
 	"github.com/andreymgn/RSOI/pkg/tracer"
 )
 
-func runPost(port int, connString, jaegerAddr string) error {
+func runPost(port int, connString, jaegerAddr string, reservedNames []string) error {
 	tracer, closer, err := tracer.NewTracer("category", jaegerAddr)
 	if err != nil {
 		return err
@@ -13,7 +13,7 @@ func runPost(port int, connString, jaegerAddr string) error {
 
 	defer closer.Close()
 
-	server, err := category.NewServer(connString)
+	server, err := category.NewServer(connString, reservedNames)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// runExport exports reports from running category service, args are flags of export mode
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	addr := flags.String("addr", "localhost:8080", "address of category service")
+	cert := flags.String("cert", "/cert.pem", "TLS certificate of category service")
+	categoryUID := flags.String("category", "", "UID of exported category, all categories are exported if empty")
+	from := flags.String("from", "", "export reports created at or after RFC 3339 time")
+	to := flags.String("to", "", "export reports created before RFC 3339 time")
+	format := flags.String("format", "csv", "export format, csv or jsonl")
+	out := flags.String("out", "", "output file, standard output if empty")
+	flags.Parse(args)
+
+	req := &pb.ExportReportsRequest{CategoryUid: *categoryUID}
+	exportFormat, ok := pb.ExportFormat_value[strings.ToUpper(*format)]
+	if !ok {
+		return fmt.Errorf("unknown export format %s", *format)
+	}
+
+	req.Format = pb.ExportFormat(exportFormat)
+
+	var err error
+	req.CreatedAfter, err = parseTimeFlag("from", *from)
+	if err != nil {
+		return err
+	}
+
+	req.CreatedBefore, err = parseTimeFlag("to", *to)
+	if err != nil {
+		return err
+	}
+
+	creds, err := credentials.NewClientTLSFromFile(*cert, "")
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+
+		defer f.Close()
+		w = f
+	}
+
+	stream, err := pb.NewCategoryClient(conn).ExportReports(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if _, err := w.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+}
+
+// parseTimeFlag parses RFC 3339 time, empty value means time isn't set
+func parseTimeFlag(name, value string) (*timestamp.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -%s: %v", name, err)
+	}
+
+	return ptypes.TimestampProto(t)
+}
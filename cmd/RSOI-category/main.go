@@ -2,11 +2,21 @@ package main
 
 import (
 	"log"
+	"net/http"
 	"os"
 	"strconv"
+	"strings"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runExport(os.Args[2:]); err != nil {
+			log.Fatalf("export finished with error %v", err)
+		}
+
+		return
+	}
+
 	conn := os.Getenv("CONN")
 	port, err := strconv.Atoi(os.Getenv("PORT"))
 	if err != nil {
@@ -16,8 +26,22 @@ func main() {
 
 	jaegerAddr := os.Getenv("JAEGER-ADDR")
 
+	var reservedNames []string
+	if names := os.Getenv("RESERVED-NAMES"); names != "" {
+		reservedNames = strings.Split(names, ",")
+	}
+
+	// expvar variables, including metrics of report retention, are served on /debug/vars
+	if metricsAddr := os.Getenv("METRICS-ADDR"); metricsAddr != "" {
+		go func() {
+			if err := http.ListenAndServe(metricsAddr, nil); err != nil {
+				log.Printf("metrics server finished with error %v", err)
+			}
+		}()
+	}
+
 	log.Printf("running post service on port %d\n", port)
-	err = runPost(port, conn, jaegerAddr)
+	err = runPost(port, conn, jaegerAddr, reservedNames)
 
 	if err != nil {
 		log.Printf("finished with error %v", err)
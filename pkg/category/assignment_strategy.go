@@ -0,0 +1,87 @@
+package category
+
+import "strings"
+
+// AssignmentStrategy decides which handler of category gets new report
+type AssignmentStrategy string
+
+const (
+	// AssignmentManual leaves new reports unassigned
+	AssignmentManual AssignmentStrategy = "manual"
+	// AssignmentRoundRobin gives new report to handler who got a report the longest time ago
+	AssignmentRoundRobin AssignmentStrategy = "round_robin"
+	// AssignmentLeastLoaded gives new report to handler holding the fewest reports
+	AssignmentLeastLoaded AssignmentStrategy = "least_loaded"
+	// AssignmentKeyword gives new report to handler whose keywords occur in report reason
+	AssignmentKeyword AssignmentStrategy = "keyword"
+)
+
+// assignmentStrategy picks handler of new report among handlers who aren't away, nil means report stays unassigned
+type assignmentStrategy interface {
+	pick(report *Report, handlers []*ReportHandler) *ReportHandler
+}
+
+// strategies lists implementations of assignment strategies, manual assignment has none
+var strategies = map[AssignmentStrategy]assignmentStrategy{
+	AssignmentRoundRobin:  roundRobinStrategy{},
+	AssignmentLeastLoaded: leastLoadedStrategy{},
+	AssignmentKeyword:     keywordStrategy{},
+}
+
+type roundRobinStrategy struct{}
+
+func (roundRobinStrategy) pick(report *Report, handlers []*ReportHandler) *ReportHandler {
+	var result *ReportHandler
+	for _, handler := range handlers {
+		if result == nil || assignedEarlier(handler, result) {
+			result = handler
+		}
+	}
+
+	return result
+}
+
+// assignedEarlier tells if a got its last report before b, handlers who never got a report go first in order of joining
+func assignedEarlier(a, b *ReportHandler) bool {
+	if !a.LastAssignedAt.Equal(b.LastAssignedAt) {
+		return a.LastAssignedAt.Before(b.LastAssignedAt)
+	}
+
+	return a.CreatedAt.Before(b.CreatedAt)
+}
+
+type leastLoadedStrategy struct{}
+
+func (leastLoadedStrategy) pick(report *Report, handlers []*ReportHandler) *ReportHandler {
+	var result *ReportHandler
+	for _, handler := range handlers {
+		if result == nil || handler.Load < result.Load || handler.Load == result.Load && assignedEarlier(handler, result) {
+			result = handler
+		}
+	}
+
+	return result
+}
+
+// keywordStrategy takes turns among handlers matching report reason,
+// reports matching nobody are given to all handlers in turn
+type keywordStrategy struct{}
+
+func (keywordStrategy) pick(report *Report, handlers []*ReportHandler) *ReportHandler {
+	reason := strings.ToLower(report.Reason)
+	var matching []*ReportHandler
+	for _, handler := range handlers {
+		for _, keyword := range handler.Keywords {
+			if strings.Contains(reason, keyword) {
+				matching = append(matching, handler)
+				break
+			}
+		}
+	}
+
+	if len(matching) == 0 {
+		matching = handlers
+	}
+
+	return roundRobinStrategy{}.pick(report, matching)
+}
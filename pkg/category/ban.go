@@ -0,0 +1,179 @@
+package category
+
+import (
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	statusBanNotFound  = status.Error(codes.NotFound, "ban not found")
+	statusOwnerBanned  = status.Error(codes.FailedPrecondition, "category owner can't be banned")
+	statusUserIsBanned = status.Error(codes.PermissionDenied, "user is banned from category")
+)
+
+// SingleBan converts Ban to SingleBan
+func (b *Ban) SingleBan() (*pb.SingleBan, error) {
+	createdAtProto, err := ptypes.TimestampProto(b.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleBan)
+	res.CategoryUid = b.CategoryUID.String()
+	res.UserUid = b.UserUID.String()
+	res.ModeratorUid = b.ModeratorUID.String()
+	res.Reason = b.Reason
+	res.CreatedAt = createdAtProto
+	if !b.ExpiresAt.IsZero() {
+		res.ExpiresAt, err = ptypes.TimestampProto(b.ExpiresAt)
+		if err != nil {
+			return nil, internalError(err)
+		}
+	}
+
+	return res, nil
+}
+
+// checkNotBanned returns PermissionDenied status if user is banned from category
+func (s *Server) checkNotBanned(categoryUID, userUID uuid.UUID) error {
+	switch _, err := s.db.getActiveBan(categoryUID, userUID); err {
+	case nil:
+		return statusUserIsBanned
+	case errNotFound:
+		return nil
+	default:
+		return internalError(err)
+	}
+}
+
+// BanUser bans user from category until expiration time or permanently, banning banned user replaces the ban.
+// Moderator must be owner or report handler of category
+func (s *Server) BanUser(ctx context.Context, req *pb.BanUserRequest) (*pb.SingleBan, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	moderatorUID := v.uuid("moderatorUid", req.ModeratorUid)
+	reason := v.text("reason", req.Reason, banReasonRules)
+	expiresAt := v.expirationTime("expiresAt", req.ExpiresAt)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	category, err := s.getModeratedCategory(categoryUID, moderatorUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if category.UserUID == userUID {
+		return nil, statusOwnerBanned
+	}
+
+	ban, err := s.db.banUser(categoryUID, userUID, moderatorUID, reason, expiresAt)
+	switch err {
+	case nil:
+		return ban.SingleBan()
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// UnbanUser lifts ban of user before it expires, moderator must be owner or report handler of category
+func (s *Server) UnbanUser(ctx context.Context, req *pb.UnbanUserRequest) (*pb.UnbanUserResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	moderatorUID := v.uuid("moderatorUid", req.ModeratorUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getModeratedCategory(categoryUID, moderatorUID); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.unbanUser(categoryUID, userUID); err {
+	case nil:
+		return new(pb.UnbanUserResponse), nil
+	case errNotFound:
+		return nil, statusBanNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ListBans returns bans of category which haven't expired yet, newest first.
+// Moderator must be owner or report handler of category
+func (s *Server) ListBans(ctx context.Context, req *pb.ListBansRequest) (*pb.ListBansResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	moderatorUID := v.uuid("moderatorUid", req.ModeratorUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getModeratedCategory(categoryUID, moderatorUID); err != nil {
+		return nil, err
+	}
+
+	bans, err := s.db.getActiveBans(categoryUID, pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListBansResponse)
+	for _, ban := range bans {
+		banResponse, err := ban.SingleBan()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Bans = append(res.Bans, banResponse)
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
+
+// IsBanned tells if user is banned from category. It is called by post service on every post,
+// so it doesn't check that category exists and makes a single primary key lookup
+func (s *Server) IsBanned(ctx context.Context, req *pb.IsBannedRequest) (*pb.IsBannedResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	ban, err := s.db.getActiveBan(categoryUID, userUID)
+	switch err {
+	case nil:
+	case errNotFound:
+		return new(pb.IsBannedResponse), nil
+	default:
+		return nil, internalError(err)
+	}
+
+	res := new(pb.IsBannedResponse)
+	res.Banned = true
+	res.Ban, err = ban.SingleBan()
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
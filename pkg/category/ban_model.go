@@ -0,0 +1,151 @@
+package category
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Ban describes user banned from category by moderator. Ban without expiration time is permanent
+type Ban struct {
+	CategoryUID  uuid.UUID
+	UserUID      uuid.UUID
+	ModeratorUID uuid.UUID
+	Reason       string
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
+
+// activeBan is condition matching bans which haven't expired yet.
+// Expired bans are ignored by every query, so they don't need to be cleaned up
+const activeBan = "(expires_at IS NULL OR expires_at > now())"
+
+const banColumns = "category_uid, user_uid, moderator_uid, reason, expires_at, created_at"
+
+func scanBan(row scanner) (*Ban, error) {
+	ban := new(Ban)
+	var categoryUID, userUID, moderatorUID string
+	var expiresAt pq.NullTime
+	err := row.Scan(&categoryUID, &userUID, &moderatorUID, &ban.Reason, &expiresAt, &ban.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if expiresAt.Valid {
+		ban.ExpiresAt = expiresAt.Time
+	}
+
+	ban.CategoryUID, err = uuid.Parse(categoryUID)
+	if err != nil {
+		return nil, err
+	}
+
+	ban.UserUID, err = uuid.Parse(userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	ban.ModeratorUID, err = uuid.Parse(moderatorUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ban, nil
+}
+
+// banUser bans user from category replacing previous ban of the user if there is one
+func (db *db) banUser(categoryUID, userUID, moderatorUID uuid.UUID, reason string, expiresAt time.Time) (*Ban, error) {
+	ban := new(Ban)
+
+	query := `INSERT INTO bans (category_uid, user_uid, moderator_uid, reason, expires_at, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6)
+	          ON CONFLICT (category_uid, user_uid) DO UPDATE
+	          SET moderator_uid=EXCLUDED.moderator_uid, reason=EXCLUDED.reason,
+	              expires_at=EXCLUDED.expires_at, created_at=EXCLUDED.created_at`
+
+	ban.CategoryUID = categoryUID
+	ban.UserUID = userUID
+	ban.ModeratorUID = moderatorUID
+	ban.Reason = reason
+	ban.ExpiresAt = expiresAt
+	ban.CreatedAt = time.Now()
+
+	var expires interface{}
+	if !expiresAt.IsZero() {
+		expires = expiresAt
+	}
+
+	_, err := db.Exec(query, categoryUID.String(), userUID.String(), moderatorUID.String(), reason, expires, ban.CreatedAt)
+	if isForeignKeyViolation(err) {
+		return nil, errNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ban, nil
+}
+
+func (db *db) unbanUser(categoryUID, userUID uuid.UUID) error {
+	query := "DELETE FROM bans WHERE category_uid=$1 AND user_uid=$2 AND " + activeBan
+	result, err := db.Exec(query, categoryUID.String(), userUID.String())
+	if err != nil {
+		return err
+	}
+
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if nRows == 0 {
+		return errNotFound
+	}
+
+	return nil
+}
+
+// getActiveBan returns ban of user in category, it is a primary key lookup
+func (db *db) getActiveBan(categoryUID, userUID uuid.UUID) (*Ban, error) {
+	query := "SELECT " + banColumns + " FROM bans WHERE category_uid=$1 AND user_uid=$2 AND " + activeBan
+	result, err := scanBan(db.QueryRow(query, categoryUID.String(), userUID.String()))
+	switch err {
+	case nil:
+		return result, nil
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (db *db) getActiveBans(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*Ban, error) {
+	query := `SELECT ` + banColumns + ` FROM bans
+	          WHERE category_uid=$1 AND ` + activeBan + `
+	          ORDER BY created_at DESC LIMIT $2 OFFSET $3`
+	lastRecord := pageNumber * pageSize
+	rows, err := db.Query(query, categoryUID.String(), pageSize, lastRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*Ban, 0)
+	for rows.Next() {
+		ban, err := scanBan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, ban)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,150 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+var (
+	bannedUserUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000030"))
+	moderatorUID  = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000031"))
+)
+
+func (mdb *mockdb) banUser(categoryUID, userUID, moderatorUID uuid.UUID, reason string, expiresAt time.Time) (*Ban, error) {
+	return &Ban{
+		CategoryUID: categoryUID, UserUID: userUID, ModeratorUID: moderatorUID, Reason: reason, ExpiresAt: expiresAt, CreatedAt: time.Now(),
+	}, nil
+}
+
+func (mdb *mockdb) unbanUser(categoryUID, userUID uuid.UUID) error {
+	if userUID != bannedUserUID {
+		return errNotFound
+	}
+
+	return nil
+}
+
+func (mdb *mockdb) getActiveBan(categoryUID, userUID uuid.UUID) (*Ban, error) {
+	if userUID != bannedUserUID {
+		return nil, errNotFound
+	}
+
+	return &Ban{CategoryUID: categoryUID, UserUID: userUID, ModeratorUID: moderatorUID, Reason: "spam", CreatedAt: time.Now()}, nil
+}
+
+func (mdb *mockdb) getActiveBans(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*Ban, error) {
+	return []*Ban{{CategoryUID: categoryUID, UserUID: bannedUserUID, ModeratorUID: moderatorUID, Reason: "spam", CreatedAt: time.Now()}}, nil
+}
+
+func TestBanUser(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	expiresAt, _ := ptypes.TimestampProto(time.Now().Add(24 * time.Hour))
+	req := &pb.BanUserRequest{
+		CategoryUid: privateUID.String(), UserUid: memberUID.String(), ModeratorUid: moderatorUID.String(),
+		Reason: " spam ", ExpiresAt: expiresAt,
+	}
+	res, err := s.BanUser(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Reason != "spam" || res.ExpiresAt == nil {
+		t.Errorf("unexpected ban %v", res)
+	}
+
+	req.UserUid = ownerUID.String()
+	_, err = s.BanUser(context.Background(), req)
+	if err != statusOwnerBanned {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = memberUID.String()
+	req.ModeratorUid = memberUID.String()
+	_, err = s.BanUser(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Reason = ""
+	req.ExpiresAt, _ = ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	_, err = s.BanUser(context.Background(), req)
+	if !hasViolations(err, "reason", "expiresAt") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestUnbanUser(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.UnbanUserRequest{CategoryUid: privateUID.String(), UserUid: bannedUserUID.String(), ModeratorUid: ownerUID.String()}
+	_, err := s.UnbanUser(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.UnbanUser(context.Background(), req)
+	if err != statusBanNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = bannedUserUID.String()
+	req.ModeratorUid = memberUID.String()
+	_, err = s.UnbanUser(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListBans(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListBansRequest{CategoryUid: privateUID.String(), ModeratorUid: moderatorUID.String()}
+	res, err := s.ListBans(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Bans) != 1 || res.Bans[0].ExpiresAt != nil {
+		t.Errorf("unexpected bans %v", res.Bans)
+	}
+
+	req.ModeratorUid = memberUID.String()
+	_, err = s.ListBans(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestIsBanned(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.IsBannedRequest{CategoryUid: privateUID.String(), UserUid: bannedUserUID.String()}
+	res, err := s.IsBanned(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if !res.Banned || res.Ban.ModeratorUid != moderatorUID.String() {
+		t.Errorf("unexpected response %v", res)
+	}
+
+	req.UserUid = memberUID.String()
+	res, err = s.IsBanned(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Banned || res.Ban != nil {
+		t.Errorf("unexpected response %v", res)
+	}
+}
+
+func TestBannedUserCantJoin(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	joinReq := &pb.CreateJoinRequestRequest{CategoryUid: privateUID.String(), UserUid: bannedUserUID.String()}
+	_, err := s.CreateJoinRequest(context.Background(), joinReq)
+	if err != statusUserIsBanned {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	redeemReq := &pb.RedeemInviteRequest{Code: inviteCode, UserUid: bannedUserUID.String()}
+	_, err = s.RedeemInvite(context.Background(), redeemReq)
+	if err != statusUserIsBanned {
+		t.Errorf("unexpected error %v", err)
+	}
+}
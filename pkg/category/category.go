@@ -1,27 +1,50 @@
 package category
 
 import (
+	"fmt"
+	"strings"
+
 	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/uuid"
 	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const maxSuggestionsLimit = 20
+
 var (
-	statusCategoryNotFound      = status.Error(codes.NotFound, "category not found")
-	statusReportNotFound        = status.Error(codes.NotFound, "report not found")
-	statusInvalidUUID           = status.Error(codes.InvalidArgument, "invalid UUID")
-	statusNoCategoryName        = status.Error(codes.InvalidArgument, "category name is required")
-	statusNoCategoryDescription = status.Error(codes.InvalidArgument, "category description is required")
-	statusNoReportReason        = status.Error(codes.InvalidArgument, "report reason is required")
+	statusCategoryNotFound = status.Error(codes.NotFound, "category not found")
+	statusReportNotFound   = status.Error(codes.NotFound, "report not found")
 )
 
+var reportOrders = map[pb.ReportOrder]reportOrder{
+	pb.ReportOrder_NEWEST:        reportOrderNewest,
+	pb.ReportOrder_OLDEST:        reportOrderOldest,
+	pb.ReportOrder_MOST_REPORTED: reportOrderMostReported,
+}
+
 func internalError(err error) error {
 	return status.Error(codes.Internal, err.Error())
 }
 
+// categoryExistsError returns AlreadyExists status pointing to the category which has the same name
+func categoryExistsError(uid uuid.UUID) error {
+	st := status.New(codes.AlreadyExists, "category with this name already exists")
+	st, err := st.WithDetails(&errdetails.ResourceInfo{
+		ResourceType: "category",
+		ResourceName: uid.String(),
+		Description:  "category with the same name",
+	})
+	if err != nil {
+		return internalError(err)
+	}
+
+	return st.Err()
+}
+
 // SingleCategory converts Category to SingleCategory
 func (c *Category) SingleCategory() *pb.SingleCategory {
 	res := new(pb.SingleCategory)
@@ -29,6 +52,24 @@ func (c *Category) SingleCategory() *pb.SingleCategory {
 	res.UserUid = c.UserUID.String()
 	res.Name = c.Name
 	res.Description = c.Description
+	res.Language = c.Language
+	res.Slug = c.Slug
+	res.SubscriberCount = c.SubscriberCount
+	res.Visibility = visibilitiesProto[c.Visibility]
+	if c.ParentUID != uuid.Nil {
+		res.ParentUid = c.ParentUID.String()
+	}
+
+	return res
+}
+
+// CategorySearchResult converts CategorySearchResult to protobuf message
+func (r *CategorySearchResult) CategorySearchResult() *pb.CategorySearchResult {
+	res := new(pb.CategorySearchResult)
+	res.Category = r.Category.SingleCategory()
+	res.Score = r.Score
+	res.NameHighlight = r.NameHighlight
+	res.DescriptionHighlight = r.DescriptionHighlight
 
 	return res
 }
@@ -47,6 +88,25 @@ func (r *Report) SingleReport() (*pb.SingleReport, error) {
 	res.CommentUid = r.CommentUID.String()
 	res.Reason = r.Reason
 	res.CreatedAt = createdAtProto
+	if r.RuleUID != uuid.Nil {
+		res.RuleUid = r.RuleUID.String()
+	}
+
+	if r.AssigneeUID != uuid.Nil {
+		res.AssigneeUid = r.AssigneeUID.String()
+	}
+
+	if !r.ClaimExpiresAt.IsZero() {
+		res.ClaimExpiresAt, err = ptypes.TimestampProto(r.ClaimExpiresAt)
+		if err != nil {
+			return nil, internalError(err)
+		}
+	}
+
+	res.NoteCount = r.NoteCount
+	res.ReasonCode = reasonCodesProto[r.ReasonCode]
+	res.Severity = pb.Severity(reasonCodeInfo(r.ReasonCode).Severity)
+	res.Routing = routingsProto[r.Routing]
 
 	return res, nil
 }
@@ -60,7 +120,13 @@ func (s *Server) ListCategories(ctx context.Context, req *pb.ListCategoriesReque
 		pageSize = req.PageSize
 	}
 
-	categories, err := s.db.getAllCategories(pageSize, req.PageNumber)
+	v := new(validator)
+	viewerUID := v.optionalUUID("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.db.getAllCategories(viewerUID, pageSize, req.PageNumber)
 	if err != nil {
 		return nil, internalError(err)
 	}
@@ -78,48 +144,160 @@ func (s *Server) ListCategories(ctx context.Context, req *pb.ListCategoriesReque
 	return res, nil
 }
 
+// SearchCategories returns categories matching full-text query ordered by relevance
+func (s *Server) SearchCategories(ctx context.Context, req *pb.SearchCategoriesRequest) (*pb.SearchCategoriesResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	v := new(validator)
+	query := v.text("query", req.Query, searchQueryRules)
+	language := v.language("language", req.Language)
+	viewerUID := v.optionalUUID("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	results, err := s.db.searchCategories(query, language, viewerUID, pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SearchCategoriesResponse)
+	for _, result := range results {
+		res.Results = append(res.Results, result.CategorySearchResult())
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
+
+// SuggestCategories returns categories for typeahead: names starting with prefix first, then similar names
+func (s *Server) SuggestCategories(ctx context.Context, req *pb.SuggestCategoriesRequest) (*pb.SuggestCategoriesResponse, error) {
+	var limit int32
+	switch {
+	case req.Limit <= 0:
+		limit = 10
+	case req.Limit > maxSuggestionsLimit:
+		limit = maxSuggestionsLimit
+	default:
+		limit = req.Limit
+	}
+
+	v := new(validator)
+	prefix := v.text("prefix", req.Prefix, suggestPrefixRules)
+	viewerUID := v.optionalUUID("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.db.suggestCategories(strings.ToLower(prefix), viewerUID, limit)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SuggestCategoriesResponse)
+	for _, category := range categories {
+		res.Categories = append(res.Categories, category.SingleCategory())
+	}
+
+	return res, nil
+}
+
 // GetCategoryAdmin returns admin of category
 func (s *Server) GetCategoryInfo(ctx context.Context, req *pb.GetCategoryInfoRequest) (*pb.SingleCategory, error) {
-	uid, err := uuid.Parse(req.Uid)
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	viewerUID := v.optionalUUID("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	category, err := s.getVisibleCategory(uid, viewerUID)
 	if err != nil {
-		return nil, statusInvalidUUID
+		return nil, err
+	}
+
+	return category.SingleCategory(), nil
+}
+
+// GetCategoryBySlug returns category by its current or one of previous slugs
+func (s *Server) GetCategoryBySlug(ctx context.Context, req *pb.GetCategoryBySlugRequest) (*pb.SingleCategory, error) {
+	v := new(validator)
+	slug := v.slug("slug", req.Slug)
+	viewerUID := v.optionalUUID("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
 	}
 
-	category, err := s.db.getCategoryInfo(uid)
+	category, err := s.db.getCategoryBySlug(slug)
 	switch err {
 	case nil:
-		return category.SingleCategory(), nil
 	case errNotFound:
 		return nil, statusCategoryNotFound
 	default:
 		return nil, internalError(err)
 	}
+
+	ok, err := s.canRead(category, viewerUID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	if !ok {
+		return nil, statusCategoryNotFound
+	}
+
+	return category.SingleCategory(), nil
 }
 
 // CreateCategory creates a new post category
 func (s *Server) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.SingleCategory, error) {
-	if req.Name == "" {
-		return nil, statusNoCategoryName
+	v := new(validator)
+	name := v.text("name", req.Name, categoryNameRules)
+	description := v.text("description", req.Description, categoryDescriptionRules)
+	userUID := v.uuid("userUid", req.UserUid)
+	language := v.language("language", req.Language)
+	parentUID := v.optionalUUID("parentUid", req.ParentUid)
+	visibility := v.visibility("visibility", req.Visibility)
+	canonical := canonicalName(name)
+	switch {
+	case canonical == "":
+		v.addViolation("name", "category name must contain letters or digits")
+	case s.reservedNames.contains(canonical):
+		v.addViolation("name", "category name is reserved")
 	}
 
-	if req.Description == "" {
-		return nil, statusNoCategoryDescription
+	if err := v.err(); err != nil {
+		return nil, err
 	}
 
-	userUID, err := uuid.Parse(req.UserUid)
-	if err != nil {
-		return nil, statusInvalidUUID
-	}
+	category, err := s.db.createCategory(name, canonical, slugify(name), description, language, visibility, userUID, parentUID)
+	switch err {
+	case nil:
+		return category.SingleCategory(), nil
+	case errParentNotFound:
+		return nil, statusParentNotFound
+	case errCategoryTooDeep:
+		return nil, statusCategoryTooDeep
+	case errCategoryNameTaken:
+		existingUID, err := s.db.getCategoryUIDByCanonicalName(canonical)
+		if err != nil {
+			return nil, internalError(err)
+		}
 
-	category, err := s.db.createCategory(req.Name, req.Description, userUID)
-	if err != nil {
+		return nil, categoryExistsError(existingUID)
+	default:
 		return nil, internalError(err)
 	}
-
-	return category.SingleCategory(), nil
 }
 
-// ListReports returns list of reports in some category
+// ListReports returns list of reports in some category, reports routed to admins aren't listed
 func (s *Server) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*pb.ListReportsResponse, error) {
 	var pageSize int32
 	if req.PageSize == 0 {
@@ -128,12 +306,22 @@ func (s *Server) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*
 		pageSize = req.PageSize
 	}
 
-	uid, err := uuid.Parse(req.CategoryUid)
-	if err != nil {
-		return nil, statusInvalidUUID
+	v := new(validator)
+	filter := new(reportFilter)
+	filter.CategoryUIDs = []uuid.UUID{v.uuid("categoryUid", req.CategoryUid)}
+	filter.IncludeDescendants = req.IncludeDescendants
+	filter.RuleUID = v.optionalUUID("ruleUid", req.RuleUid)
+	filter.Routing = RoutingOwner
+	filter.AssigneeUID = v.optionalUUID("assigneeUid", req.AssigneeUid)
+	filter.Unassigned = req.UnassignedOnly
+	if filter.AssigneeUID != uuid.Nil && filter.Unassigned {
+		v.addViolation("unassignedOnly", "unassigned reports can't be filtered by assignee")
+	}
+	if err := v.err(); err != nil {
+		return nil, err
 	}
 
-	reports, err := s.db.getAllReports(uid, pageSize, req.PageNumber)
+	reports, err := s.db.getAllReports(filter, reportOrderNewest, pageSize, req.PageNumber)
 	if err != nil {
 		return nil, internalError(err)
 	}
@@ -148,49 +336,125 @@ func (s *Server) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*
 		res.Reports = append(res.Reports, reportResponse)
 	}
 
+	if req.GroupByRule {
+		counts, err := s.db.countReportsByRule(filter)
+		if err != nil {
+			return nil, internalError(err)
+		}
+
+		for _, count := range counts {
+			res.RuleCounts = append(res.RuleCounts, count.RuleReportCount())
+		}
+	}
+
 	res.PageSize = pageSize
 	res.PageNumber = req.PageNumber
 
 	return res, nil
 }
 
-// CreateReport creates new report
-func (s *Server) CreateReport(ctx context.Context, req *pb.CreateReportRequest) (*pb.SingleReport, error) {
-	if req.Reason == "" {
-		return nil, statusNoReportReason
+// ListAllReports returns reports of all categories matching filters of request.
+// Caller must be site admin, it is checked by gateway
+func (s *Server) ListAllReports(ctx context.Context, req *pb.ListAllReportsRequest) (*pb.ListReportsResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
 	}
 
-	categoryUID, err := uuid.Parse(req.CategoryUid)
-	if err != nil {
-		return nil, statusInvalidUUID
+	v := new(validator)
+	filter := new(reportFilter)
+	filter.CreatedAfter = v.optionalTime("createdAfter", req.CreatedAfter)
+	filter.CreatedBefore = v.optionalTime("createdBefore", req.CreatedBefore)
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+		v.addViolation("createdBefore", "createdBefore must be after createdAfter")
 	}
 
-	postUID, err := uuid.Parse(req.PostUid)
-	if err != nil {
-		return nil, statusInvalidUUID
+	filter.ReasonText = v.optionalText("reasonText", req.ReasonText, reportSearchRules)
+	if len(req.CategoryUids) > maxReportFilterCategories {
+		v.addViolation("categoryUids", fmt.Sprintf("at most %d categories can be given", maxReportFilterCategories))
 	}
 
-	commentUID, err := uuid.Parse(req.CommentUid)
-	if err != nil {
-		return nil, statusInvalidUUID
+	for i, categoryUID := range req.CategoryUids {
+		filter.CategoryUIDs = append(filter.CategoryUIDs, v.uuid(fmt.Sprintf("categoryUids[%d]", i), categoryUID))
+	}
+
+	filter.PostUID = v.optionalUUID("postUid", req.PostUid)
+	order := v.reportOrder("order", req.Order)
+	if err := v.err(); err != nil {
+		return nil, err
 	}
 
-	report, err := s.db.createReport(categoryUID, postUID, commentUID, req.Reason)
+	reports, err := s.db.getAllReports(filter, order, pageSize, req.PageNumber)
 	if err != nil {
 		return nil, internalError(err)
 	}
 
+	res := new(pb.ListReportsResponse)
+	for _, report := range reports {
+		reportResponse, err := report.SingleReport()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Reports = append(res.Reports, reportResponse)
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
+
+// CreateReport creates new report. Free-text reason is optional unless reason code is OTHER,
+// reason code decides whether report is handled by category or by site admins
+func (s *Server) CreateReport(ctx context.Context, req *pb.CreateReportRequest) (*pb.SingleReport, error) {
+	v := new(validator)
+	report := new(Report)
+	report.CategoryUID = v.uuid("categoryUid", req.CategoryUid)
+	report.PostUID = v.uuid("postUid", req.PostUid)
+	report.CommentUID = v.uuid("commentUid", req.CommentUid)
+	report.ReasonCode = v.reasonCode("reasonCode", req.ReasonCode)
+	if report.ReasonCode == ReasonOther {
+		report.Reason = v.text("reason", req.Reason, reportReasonRules)
+	} else {
+		report.Reason = v.optionalText("reason", req.Reason, reportReasonRules)
+	}
+
+	report.RuleUID = v.optionalUUID("ruleUid", req.RuleUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if report.RuleUID != uuid.Nil {
+		rule, err := s.db.getRule(report.RuleUID)
+		switch {
+		case err == errNotFound || err == nil && rule.CategoryUID != report.CategoryUID:
+			return nil, statusRuleNotFound
+		case err != nil:
+			return nil, internalError(err)
+		}
+	}
+
+	report.Routing = reasonCodeInfo(report.ReasonCode).Routing
+	if err := s.db.createReport(report); err != nil {
+		return nil, internalError(err)
+	}
+
 	return report.SingleReport()
 }
 
-// DeleteReport deletes report by ID
+// DeleteReport deletes report by ID, it is recorded as handled by moderator if one is given
 func (s *Server) DeleteReport(ctx context.Context, req *pb.DeleteReportRequest) (*pb.DeleteReportResponse, error) {
-	uid, err := uuid.Parse(req.Uid)
-	if err != nil {
-		return nil, statusInvalidUUID
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	moderatorUID := v.optionalUUID("moderatorUid", req.ModeratorUid)
+	if err := v.err(); err != nil {
+		return nil, err
 	}
 
-	err = s.db.deleteReport(uid)
+	err := s.db.deleteReport(uid, moderatorUID)
 	switch err {
 	case nil:
 		return new(pb.DeleteReportResponse), nil
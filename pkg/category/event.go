@@ -0,0 +1,57 @@
+package category
+
+import (
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+)
+
+const maxEventsLimit = 1000
+
+// SingleEvent converts Event to SingleEvent
+func (e *Event) SingleEvent() (*pb.SingleEvent, error) {
+	createdAtProto, err := ptypes.TimestampProto(e.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleEvent)
+	res.Id = e.ID
+	res.Type = e.Type
+	res.Payload = e.Payload
+	res.CreatedAt = createdAtProto
+
+	return res, nil
+}
+
+// ListEvents returns events which come after event with given ID in order of their IDs.
+// Consumers poll it with ID of the last event they handled or wait for notification on events channel.
+// Events are listed once listener of the service gives them IDs, which happens shortly after notification
+func (s *Server) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	var limit int32
+	switch {
+	case req.Limit <= 0:
+		limit = 100
+	case req.Limit > maxEventsLimit:
+		limit = maxEventsLimit
+	default:
+		limit = req.Limit
+	}
+
+	events, err := s.db.getEvents(req.AfterId, limit)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListEventsResponse)
+	for _, event := range events {
+		eventResponse, err := event.SingleEvent()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Events = append(res.Events, eventResponse)
+	}
+
+	return res, nil
+}
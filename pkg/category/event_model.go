@@ -0,0 +1,136 @@
+package category
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// eventsChannel is the Postgres notification channel which is notified when transaction emitting events commits
+const eventsChannel = "category_events"
+
+// eventsSequenceLockKey is the advisory lock held by readers while they give IDs to committed events.
+// Writers don't take it, so emitting events doesn't serialize transactions
+const eventsSequenceLockKey = 0x65766e74
+
+// Event types
+const (
+	// EventContentAutoHidden is emitted when post is hidden by threshold policy
+	EventContentAutoHidden = "content.auto_hidden"
+	// EventContentAutoUnhidden is emitted when moderator reviews post hidden by threshold policy and unhides it
+	EventContentAutoUnhidden = "content.auto_unhidden"
+	// EventReportResolved is emitted when moderator resolves report with an outcome
+	EventReportResolved = "report.resolved"
+	// EventReportCreated is emitted when report is created. Its payload only has UIDs of report and its category,
+	// events are never purged and reason of report must not outlive report retention
+	EventReportCreated = "report.created"
+	// EventReportDeleted is emitted when report leaves the queue, whether it is deleted, resolved or purged
+	EventReportDeleted = "report.deleted"
+)
+
+// Event is a message for other services. Events are stored in the same transaction as the change they describe
+// and get increasing IDs once they can't be preceded by uncommitted events, so consumers read them in order
+// starting after the last ID they handled. A long running transaction delays IDs of events emitted after it started
+type Event struct {
+	ID        int64
+	Type      string
+	Payload   string
+	CreatedAt time.Time
+}
+
+// emitEvent stores event with payload encoded as JSON and notifies listeners of events channel when tx commits
+func emitEvent(tx *sql.Tx, eventType string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	query := "INSERT INTO events (type, payload, created_at) VALUES ($1, $2, $3)"
+	if _, err := tx.Exec(query, eventType, string(data), time.Now()); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("SELECT pg_notify($1, '')", eventsChannel)
+	return err
+}
+
+// sequenceEvents gives IDs to events of transactions older than any running transaction in order of the transactions.
+// Such transactions are finished, so events without ID which are committed later get greater IDs.
+// It is only called by listener of eventHub, the lock orders listeners of service replicas
+func (db *db) sequenceEvents() error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("SELECT pg_advisory_xact_lock($1)", eventsSequenceLockKey); err != nil {
+		return err
+	}
+
+	query := `UPDATE events e SET id = n.id
+	          FROM (
+	              SELECT seq, (SELECT COALESCE(max(id), 0) FROM events) + row_number() OVER (ORDER BY tx_id, seq) AS id
+	              FROM events WHERE id IS NULL AND tx_id < txid_snapshot_xmin(txid_current_snapshot())
+	          ) n
+	          WHERE e.seq = n.seq`
+	if _, err := tx.Exec(query); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// reportEventPayload is payload of EventReportCreated and EventReportDeleted.
+// Routing tells if the report is shown to category or only to site admins
+type reportEventPayload struct {
+	ReportUID   string  `json:"reportUid"`
+	CategoryUID string  `json:"categoryUid"`
+	Routing     Routing `json:"routing"`
+}
+
+// emitReportCreated emits EventReportCreated for report of category
+func emitReportCreated(tx *sql.Tx, reportUID, categoryUID string, routing Routing) error {
+	payload := reportEventPayload{ReportUID: reportUID, CategoryUID: categoryUID, Routing: routing}
+	return emitEvent(tx, EventReportCreated, payload)
+}
+
+// emitReportDeleted emits EventReportDeleted for report of category
+func emitReportDeleted(tx *sql.Tx, reportUID, categoryUID string, routing Routing) error {
+	payload := reportEventPayload{ReportUID: reportUID, CategoryUID: categoryUID, Routing: routing}
+	return emitEvent(tx, EventReportDeleted, payload)
+}
+
+// getLastEventID returns ID of the last emitted event or 0 if there are no events
+func (db *db) getLastEventID() (int64, error) {
+	var id int64
+	err := db.QueryRow("SELECT COALESCE(max(id), 0) FROM events").Scan(&id)
+	return id, err
+}
+
+// getEvents returns events sequenced by listener of eventHub after event with afterID
+func (db *db) getEvents(afterID int64, limit int32) ([]*Event, error) {
+	query := "SELECT id, type, payload, created_at FROM events WHERE id > $1 ORDER BY id LIMIT $2"
+	rows, err := db.Query(query, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*Event, 0)
+	for rows.Next() {
+		event := new(Event)
+		if err := rows.Scan(&event.ID, &event.Type, &event.Payload, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		result = append(result, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
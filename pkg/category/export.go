@@ -0,0 +1,213 @@
+package category
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+)
+
+// exportChunkSize is the size of data after which export chunk is sent
+const exportChunkSize = 64 * 1024
+
+// exportedReport is Report as it is written to export
+type exportedReport struct {
+	UID            string     `json:"uid"`
+	CategoryUID    string     `json:"categoryUid"`
+	PostUID        string     `json:"postUid"`
+	CommentUID     string     `json:"commentUid"`
+	RuleUID        string     `json:"ruleUid,omitempty"`
+	ReasonCode     ReasonCode `json:"reasonCode"`
+	Reason         string     `json:"reason"`
+	Routing        Routing    `json:"routing"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	AssigneeUID    string     `json:"assigneeUid,omitempty"`
+	ClaimExpiresAt *time.Time `json:"claimExpiresAt,omitempty"`
+	NoteCount      int32      `json:"noteCount"`
+}
+
+// exportColumns is the header of CSV export, columns are in order of exportedReport fields
+var exportColumns = []string{
+	"uid", "categoryUid", "postUid", "commentUid", "ruleUid", "reasonCode", "reason", "routing", "createdAt",
+	"assigneeUid", "claimExpiresAt", "noteCount",
+}
+
+func newExportedReport(r *Report) *exportedReport {
+	res := &exportedReport{
+		UID:         r.UID.String(),
+		CategoryUID: r.CategoryUID.String(),
+		PostUID:     r.PostUID.String(),
+		CommentUID:  r.CommentUID.String(),
+		ReasonCode:  r.ReasonCode,
+		Reason:      r.Reason,
+		Routing:     r.Routing,
+		CreatedAt:   r.CreatedAt.UTC(),
+		NoteCount:   r.NoteCount,
+	}
+
+	if r.RuleUID != uuid.Nil {
+		res.RuleUID = r.RuleUID.String()
+	}
+
+	if r.AssigneeUID != uuid.Nil {
+		res.AssigneeUID = r.AssigneeUID.String()
+	}
+
+	if !r.ClaimExpiresAt.IsZero() {
+		claimExpiresAt := r.ClaimExpiresAt.UTC()
+		res.ClaimExpiresAt = &claimExpiresAt
+	}
+
+	return res
+}
+
+// record returns CSV record of report, times are formatted as RFC 3339
+func (r *exportedReport) record() []string {
+	var claimExpiresAt string
+	if r.ClaimExpiresAt != nil {
+		claimExpiresAt = r.ClaimExpiresAt.Format(time.RFC3339Nano)
+	}
+
+	return []string{
+		r.UID, r.CategoryUID, r.PostUID, r.CommentUID, r.RuleUID, string(r.ReasonCode), r.Reason, string(r.Routing),
+		r.CreatedAt.Format(time.RFC3339Nano), r.AssigneeUID, claimExpiresAt, strconv.Itoa(int(r.NoteCount)),
+	}
+}
+
+// reportEncoder writes reports in one of export formats
+type reportEncoder interface {
+	encode(*Report) error
+	// flush writes reports buffered by encoder
+	flush() error
+}
+
+// newReportEncoder returns encoder of format writing to w, CSV export starts with header
+func newReportEncoder(format pb.ExportFormat, w io.Writer) (reportEncoder, error) {
+	switch format {
+	case pb.ExportFormat_CSV:
+		e := &csvReportEncoder{csv.NewWriter(w)}
+		if err := e.w.Write(exportColumns); err != nil {
+			return nil, err
+		}
+
+		return e, nil
+	case pb.ExportFormat_JSONL:
+		return &jsonReportEncoder{json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("unknown export format %d", format)
+	}
+}
+
+type csvReportEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvReportEncoder) encode(r *Report) error {
+	return e.w.Write(newExportedReport(r).record())
+}
+
+func (e *csvReportEncoder) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// jsonReportEncoder writes every report as JSON object on its own line
+type jsonReportEncoder struct {
+	e *json.Encoder
+}
+
+func (e *jsonReportEncoder) encode(r *Report) error {
+	return e.e.Encode(newExportedReport(r))
+}
+
+func (e *jsonReportEncoder) flush() error {
+	return nil
+}
+
+// exportChunkWriter sends data written to it to export stream in chunks of at least exportChunkSize bytes
+type exportChunkWriter struct {
+	stream pb.Category_ExportReportsServer
+	buf    bytes.Buffer
+}
+
+func (w *exportChunkWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	if w.buf.Len() >= exportChunkSize {
+		return len(p), w.flush()
+	}
+
+	return len(p), nil
+}
+
+// flush sends buffered data, the data is copied by stream before Send returns
+func (w *exportChunkWriter) flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+
+	err := w.stream.Send(&pb.ExportReportsChunk{Data: w.buf.Bytes()})
+	w.buf.Reset()
+	return err
+}
+
+// ExportReports streams every field of reports of category or of all categories created within optional time range,
+// oldest first, as CSV or JSON Lines. Reports are read from a cursor, so export of any size doesn't use much memory.
+// Reports of category are exported to its owner or report handler without reports routed to site admins.
+// Reports of all categories are exported to site admin, it is checked by gateway
+func (s *Server) ExportReports(req *pb.ExportReportsRequest, stream pb.Category_ExportReportsServer) error {
+	v := new(validator)
+	filter := new(reportFilter)
+	categoryUID := v.optionalUUID("categoryUid", req.CategoryUid)
+	var userUID uuid.UUID
+	if categoryUID != uuid.Nil {
+		filter.CategoryUIDs = []uuid.UUID{categoryUID}
+		filter.Routing = RoutingOwner
+		userUID = v.uuid("userUid", req.UserUid)
+	}
+
+	filter.CreatedAfter = v.optionalTime("createdAfter", req.CreatedAfter)
+	filter.CreatedBefore = v.optionalTime("createdBefore", req.CreatedBefore)
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+		v.addViolation("createdBefore", "createdBefore must be after createdAfter")
+	}
+
+	if _, ok := pb.ExportFormat_name[int32(req.Format)]; !ok {
+		v.addViolation("format", fmt.Sprintf("unknown export format %d", req.Format))
+	}
+
+	if err := v.err(); err != nil {
+		return err
+	}
+
+	if categoryUID != uuid.Nil {
+		if _, err := s.getModeratedCategory(categoryUID, userUID); err != nil {
+			return err
+		}
+	}
+
+	w := &exportChunkWriter{stream: stream}
+	encoder, err := newReportEncoder(req.Format, w)
+	if err != nil {
+		return internalError(err)
+	}
+
+	if err := s.db.exportReports(stream.Context(), filter, encoder.encode); err != nil {
+		return internalError(err)
+	}
+
+	if err := encoder.flush(); err != nil {
+		return internalError(err)
+	}
+
+	if err := w.flush(); err != nil {
+		return internalError(err)
+	}
+
+	return nil
+}
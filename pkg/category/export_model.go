@@ -0,0 +1,69 @@
+package category
+
+import (
+	"database/sql"
+	"strconv"
+
+	"golang.org/x/net/context"
+)
+
+// exportBatchSize is the number of reports fetched from export cursor at once
+const exportBatchSize = 1000
+
+// exportReports calls fn for every report selected by filter, oldest first. Reports are fetched from a cursor
+// in batches, so memory doesn't grow with the number of exported reports. All reports are read from the same snapshot
+func (db *db) exportReports(ctx context.Context, filter *reportFilter, fn func(*Report) error) error {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	args := new(queryArgs)
+	query := `DECLARE report_export NO SCROLL CURSOR FOR
+	          SELECT ` + reportColumns + ` FROM reports WHERE ` + filter.where(args) + ` ORDER BY created_at, uid`
+	if _, err := tx.ExecContext(ctx, query, *args...); err != nil {
+		return err
+	}
+
+	for {
+		n, err := fetchExportedReports(ctx, tx, fn)
+		if err != nil {
+			return err
+		}
+
+		if n < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// fetchExportedReports calls fn for the next batch of reports of export cursor and returns the number of fetched reports
+func fetchExportedReports(ctx context.Context, tx *sql.Tx, fn func(*Report) error) (int, error) {
+	rows, err := tx.QueryContext(ctx, "FETCH "+strconv.Itoa(exportBatchSize)+" FROM report_export")
+	if err != nil {
+		return 0, err
+	}
+
+	defer rows.Close()
+	n := 0
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := fn(report); err != nil {
+			return 0, err
+		}
+
+		n++
+	}
+
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
@@ -0,0 +1,167 @@
+package category
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func (mdb *mockdb) exportReports(ctx context.Context, filter *reportFilter, fn func(*Report) error) error {
+	reports, _ := mdb.getAllReports(filter, reportOrderOldest, 10, 0)
+	reports[0].Reason = "spam, \"quoted\"\nand multiline"
+	reports[1].ClaimExpiresAt = time.Now().Add(claimDuration)
+	for _, report := range reports {
+		if filter.Routing != "" && report.Routing != filter.Routing {
+			continue
+		}
+
+		if err := fn(report); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportStream collects chunks sent by ExportReports
+type exportStream struct {
+	grpc.ServerStream
+	chunks [][]byte
+}
+
+func (s *exportStream) Send(chunk *pb.ExportReportsChunk) error {
+	s.chunks = append(s.chunks, append([]byte(nil), chunk.Data...))
+	return nil
+}
+
+func (s *exportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *exportStream) data() []byte {
+	return bytes.Join(s.chunks, nil)
+}
+
+func TestExportReportsCSV(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	stream := new(exportStream)
+	err := s.ExportReports(new(pb.ExportReportsRequest), stream)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(stream.data())).ReadAll()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(records) != 4 || strings.Join(records[0], ",") != strings.Join(exportColumns, ",") {
+		t.Fatalf("unexpected records %v", records)
+	}
+
+	if records[1][6] != "spam, \"quoted\"\nand multiline" || records[2][4] != ruleUID.String() || records[2][10] == "" {
+		t.Errorf("unexpected records %v", records[1:])
+	}
+
+	if records[3][9] != moderatorUID.String() || records[3][11] != "0" {
+		t.Errorf("unexpected record %v", records[3])
+	}
+}
+
+func TestExportCategoryReports(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	stream := new(exportStream)
+	req := &pb.ExportReportsRequest{CategoryUid: restrictedUID.String(), UserUid: moderatorUID.String()}
+	if err := s.ExportReports(req, stream); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(stream.data())).ReadAll()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(records) != 3 || records[1][7] != string(RoutingOwner) || records[2][7] != string(RoutingOwner) {
+		t.Errorf("unexpected records %v", records)
+	}
+
+	req.UserUid = memberUID.String()
+	if err := s.ExportReports(req, new(exportStream)); err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestExportReportsJSONL(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	stream := new(exportStream)
+	err := s.ExportReports(&pb.ExportReportsRequest{Format: pb.ExportFormat_JSONL}, stream)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(stream.data()), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("unexpected export %q", stream.data())
+	}
+
+	var report map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[1]), &report); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if report["ruleUid"] != ruleUID.String() || report["reasonCode"] != string(ReasonSpam) || report["claimExpiresAt"] == nil {
+		t.Errorf("unexpected report %v", report)
+	}
+}
+
+func TestExportReportsFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ExportReportsRequest{CategoryUid: "not uuid", Format: pb.ExportFormat(100)}
+	err := s.ExportReports(req, new(exportStream))
+	if !hasViolations(err, "categoryUid", "format") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req = &pb.ExportReportsRequest{CategoryUid: restrictedUID.String()}
+	err = s.ExportReports(req, new(exportStream))
+	if !hasViolations(err, "userUid") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestExportChunkWriter(t *testing.T) {
+	stream := new(exportStream)
+	w := &exportChunkWriter{stream: stream}
+	line := []byte(uuid.New().String() + "\n")
+	for written := 0; written < 3*exportChunkSize; written += len(line) {
+		if _, err := w.Write(line); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+
+	if err := w.flush(); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(stream.chunks) != 3 {
+		t.Fatalf("expected 3 chunks, got %d", len(stream.chunks))
+	}
+
+	for _, chunk := range stream.chunks[:2] {
+		if len(chunk) < exportChunkSize || len(chunk) >= exportChunkSize+len(line) {
+			t.Errorf("unexpected chunk of %d bytes", len(chunk))
+		}
+	}
+
+	if len(stream.data())%len(line) != 0 {
+		t.Errorf("unexpected export size %d", len(stream.data()))
+	}
+}
@@ -0,0 +1,220 @@
+package category
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// inviteCodeBytes is the number of random bytes in invite code, 128 bits can't be guessed
+const inviteCodeBytes = 16
+
+var (
+	statusInviteNotFound  = status.Error(codes.NotFound, "invite not found")
+	statusInviteExpired   = status.Error(codes.FailedPrecondition, "invite has expired")
+	statusInviteExhausted = status.Error(codes.FailedPrecondition, "invite has reached its usage limit")
+)
+
+// newInviteCode returns random URL-safe invite code
+func newInviteCode() (string, error) {
+	b := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// SingleInvite converts Invite to SingleInvite
+func (i *Invite) SingleInvite() (*pb.SingleInvite, error) {
+	createdAtProto, err := ptypes.TimestampProto(i.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleInvite)
+	res.Code = i.Code
+	res.CategoryUid = i.CategoryUID.String()
+	res.UserUid = i.UserUID.String()
+	res.MaxUses = i.MaxUses
+	res.Uses = i.Uses
+	res.CreatedAt = createdAtProto
+	if !i.ExpiresAt.IsZero() {
+		res.ExpiresAt, err = ptypes.TimestampProto(i.ExpiresAt)
+		if err != nil {
+			return nil, internalError(err)
+		}
+	}
+
+	return res, nil
+}
+
+// CreateInvite creates invite code to restricted or private category, only owner can do it.
+// Zero max uses means unlimited, missing expiration time means invite never expires
+func (s *Server) CreateInvite(ctx context.Context, req *pb.CreateInviteRequest) (*pb.SingleInvite, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if req.MaxUses < 0 {
+		v.addViolation("maxUses", "max uses can't be negative")
+	}
+
+	expiresAt := v.expirationTime("expiresAt", req.ExpiresAt)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	category, err := s.getOwnedCategory(categoryUID, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if category.Visibility != VisibilityRestricted && category.Visibility != VisibilityPrivate {
+		return nil, statusPublicCategory
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	invite, err := s.db.createInvite(code, categoryUID, userUID, req.MaxUses, expiresAt)
+	switch err {
+	case nil:
+		return invite.SingleInvite()
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// RedeemInvite makes user a member of category invite was created for
+func (s *Server) RedeemInvite(ctx context.Context, req *pb.RedeemInviteRequest) (*pb.SingleCategory, error) {
+	v := new(validator)
+	code := v.inviteCode("code", req.Code)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	invite, err := s.db.getInvite(code)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusInviteNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	category, err := s.db.getCategoryInfo(invite.CategoryUID)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusInviteNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	if category.UserUID == userUID {
+		return nil, statusAlreadyMember
+	}
+
+	if err := s.checkNotBanned(category.UID, userUID); err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.redeemInvite(code, userUID)
+	switch err {
+	case nil:
+		return category.SingleCategory(), nil
+	case errNotFound:
+		return nil, statusInviteNotFound
+	case errInviteExpired:
+		return nil, statusInviteExpired
+	case errInviteExhausted:
+		return nil, statusInviteExhausted
+	case errAlreadyMember:
+		return nil, statusAlreadyMember
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ListInvites returns invites of category including expired and used up ones, newest first. Only owner can list them
+func (s *Server) ListInvites(ctx context.Context, req *pb.ListInvitesRequest) (*pb.ListInvitesResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	invites, err := s.db.getInvites(categoryUID, pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListInvitesResponse)
+	for _, invite := range invites {
+		inviteResponse, err := invite.SingleInvite()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Invites = append(res.Invites, inviteResponse)
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
+
+// RevokeInvite deletes invite, members who already redeemed it stay members
+func (s *Server) RevokeInvite(ctx context.Context, req *pb.RevokeInviteRequest) (*pb.RevokeInviteResponse, error) {
+	v := new(validator)
+	code := v.inviteCode("code", req.Code)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	invite, err := s.db.getInvite(code)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusInviteNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	if _, err := s.getOwnedCategory(invite.CategoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.deleteInvite(code); err {
+	case nil:
+		return new(pb.RevokeInviteResponse), nil
+	case errNotFound:
+		return nil, statusInviteNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
@@ -0,0 +1,207 @@
+package category
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var (
+	errInviteExpired   = errors.New("invite has expired")
+	errInviteExhausted = errors.New("invite has reached its usage limit")
+	errAlreadyMember   = errors.New("user is already a member of category")
+)
+
+// Invite describes shareable code which makes anyone who redeems it a member of category
+type Invite struct {
+	Code        string
+	CategoryUID uuid.UUID
+	UserUID     uuid.UUID
+	MaxUses     int32
+	Uses        int32
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+// usable returns reason why invite can't be redeemed at the moment or nil
+func (i *Invite) usable(now time.Time) error {
+	switch {
+	case !i.ExpiresAt.IsZero() && !now.Before(i.ExpiresAt):
+		return errInviteExpired
+	case i.MaxUses > 0 && i.Uses >= i.MaxUses:
+		return errInviteExhausted
+	}
+
+	return nil
+}
+
+const inviteColumns = "code, category_uid, user_uid, max_uses, uses, expires_at, created_at"
+
+func scanInvite(row scanner) (*Invite, error) {
+	invite := new(Invite)
+	var categoryUID, userUID string
+	var expiresAt pq.NullTime
+	err := row.Scan(&invite.Code, &categoryUID, &userUID, &invite.MaxUses, &invite.Uses, &expiresAt, &invite.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if expiresAt.Valid {
+		invite.ExpiresAt = expiresAt.Time
+	}
+
+	invite.CategoryUID, err = uuid.Parse(categoryUID)
+	if err != nil {
+		return nil, err
+	}
+
+	invite.UserUID, err = uuid.Parse(userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
+
+func (db *db) createInvite(code string, categoryUID, userUID uuid.UUID, maxUses int32, expiresAt time.Time) (*Invite, error) {
+	invite := new(Invite)
+
+	query := `INSERT INTO invites (code, category_uid, user_uid, max_uses, uses, expires_at, created_at)
+	          VALUES ($1, $2, $3, $4, 0, $5, $6)`
+
+	invite.Code = code
+	invite.CategoryUID = categoryUID
+	invite.UserUID = userUID
+	invite.MaxUses = maxUses
+	invite.ExpiresAt = expiresAt
+	invite.CreatedAt = time.Now()
+
+	var expires interface{}
+	if !expiresAt.IsZero() {
+		expires = expiresAt
+	}
+
+	_, err := db.Exec(query, code, categoryUID.String(), userUID.String(), maxUses, expires, invite.CreatedAt)
+	if isForeignKeyViolation(err) {
+		return nil, errNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
+
+func (db *db) getInvite(code string) (*Invite, error) {
+	query := "SELECT " + inviteColumns + " FROM invites WHERE code=$1"
+	result, err := scanInvite(db.QueryRow(query, code))
+	switch err {
+	case nil:
+		return result, nil
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (db *db) getInvites(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*Invite, error) {
+	query := `SELECT ` + inviteColumns + ` FROM invites
+	          WHERE category_uid=$1
+	          ORDER BY created_at DESC LIMIT $2 OFFSET $3`
+	lastRecord := pageNumber * pageSize
+	rows, err := db.Query(query, categoryUID.String(), pageSize, lastRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*Invite, 0)
+	for rows.Next() {
+		invite, err := scanInvite(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, invite)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// redeemInvite makes user a member of invite's category and counts the use.
+// Invite row is locked until commit, so concurrent redemptions can't exceed max uses
+func (db *db) redeemInvite(code string, userUID uuid.UUID) (*Invite, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	query := "SELECT " + inviteColumns + " FROM invites WHERE code=$1 FOR UPDATE"
+	invite, err := scanInvite(tx.QueryRow(query, code))
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, errNotFound
+	case err != nil:
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := invite.usable(now); err != nil {
+		return nil, err
+	}
+
+	query = `INSERT INTO category_members (category_uid, user_uid, created_at) VALUES ($1, $2, $3)
+	         ON CONFLICT DO NOTHING`
+	result, err := tx.Exec(query, invite.CategoryUID.String(), userUID.String(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if nRows == 0 {
+		return nil, errAlreadyMember
+	}
+
+	_, err = tx.Exec("UPDATE invites SET uses=uses+1 WHERE code=$1", code)
+	if err != nil {
+		return nil, err
+	}
+
+	invite.Uses++
+
+	return invite, tx.Commit()
+}
+
+func (db *db) deleteInvite(code string) error {
+	query := "DELETE FROM invites WHERE code=$1"
+	result, err := db.Exec(query, code)
+	if err != nil {
+		return err
+	}
+
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if nRows == 0 {
+		return errNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+const (
+	inviteCode          = "aaaaaaaaaaaaaaaaaaaaaa"
+	expiredInviteCode   = "bbbbbbbbbbbbbbbbbbbbbb"
+	exhaustedInviteCode = "cccccccccccccccccccccc"
+)
+
+func (mdb *mockdb) createInvite(code string, categoryUID, userUID uuid.UUID, maxUses int32, expiresAt time.Time) (*Invite, error) {
+	return &Invite{
+		Code: code, CategoryUID: categoryUID, UserUID: userUID, MaxUses: maxUses, ExpiresAt: expiresAt, CreatedAt: time.Now(),
+	}, nil
+}
+
+func (mdb *mockdb) getInvite(code string) (*Invite, error) {
+	switch code {
+	case inviteCode, expiredInviteCode, exhaustedInviteCode:
+		return &Invite{Code: code, CategoryUID: privateUID, UserUID: ownerUID, CreatedAt: time.Now()}, nil
+	default:
+		return nil, errNotFound
+	}
+}
+
+func (mdb *mockdb) getInvites(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*Invite, error) {
+	return []*Invite{{Code: inviteCode, CategoryUID: categoryUID, UserUID: ownerUID, MaxUses: 5, Uses: 1, CreatedAt: time.Now()}}, nil
+}
+
+func (mdb *mockdb) redeemInvite(code string, userUID uuid.UUID) (*Invite, error) {
+	switch {
+	case code == expiredInviteCode:
+		return nil, errInviteExpired
+	case code == exhaustedInviteCode:
+		return nil, errInviteExhausted
+	case userUID == memberUID:
+		return nil, errAlreadyMember
+	}
+
+	return &Invite{Code: code, CategoryUID: privateUID, UserUID: ownerUID, Uses: 1, CreatedAt: time.Now()}, nil
+}
+
+func (mdb *mockdb) deleteInvite(code string) error {
+	return nil
+}
+
+func TestNewInviteCode(t *testing.T) {
+	code, err := newInviteCode()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	v := new(validator)
+	v.inviteCode("code", code)
+	if err := v.err(); err != nil {
+		t.Errorf("generated code %q is invalid: %v", code, err)
+	}
+
+	other, err := newInviteCode()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if code == other {
+		t.Errorf("two codes are equal: %q", code)
+	}
+}
+
+func TestInviteUsable(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		invite Invite
+		err    error
+	}{
+		{Invite{}, nil},
+		{Invite{MaxUses: 2, Uses: 1, ExpiresAt: now.Add(time.Hour)}, nil},
+		{Invite{MaxUses: 2, Uses: 2}, errInviteExhausted},
+		{Invite{ExpiresAt: now}, errInviteExpired},
+	}
+
+	for _, tt := range tests {
+		if err := tt.invite.usable(now); err != tt.err {
+			t.Errorf("%+v: expected %v, got %v", tt.invite, tt.err, err)
+		}
+	}
+}
+
+func TestCreateInvite(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	expiresAt, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	req := &pb.CreateInviteRequest{CategoryUid: privateUID.String(), UserUid: ownerUID.String(), MaxUses: 10, ExpiresAt: expiresAt}
+	res, err := s.CreateInvite(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.MaxUses != 10 || res.ExpiresAt == nil || res.Code == "" {
+		t.Errorf("unexpected invite %v", res)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.CreateInvite(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.CategoryUid = nilUIDString
+	req.UserUid = nilUIDString
+	_, err = s.CreateInvite(context.Background(), req)
+	if err != statusPublicCategory {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.MaxUses = -1
+	req.ExpiresAt, _ = ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	_, err = s.CreateInvite(context.Background(), req)
+	if !hasViolations(err, "maxUses", "expiresAt") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestRedeemInvite(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	tests := []struct {
+		code    string
+		userUID uuid.UUID
+		err     error
+	}{
+		{inviteCode, pendingUserUID, nil},
+		{inviteCode, memberUID, statusAlreadyMember},
+		{inviteCode, ownerUID, statusAlreadyMember},
+		{expiredInviteCode, pendingUserUID, statusInviteExpired},
+		{exhaustedInviteCode, pendingUserUID, statusInviteExhausted},
+		{"dddddddddddddddddddddd", pendingUserUID, statusInviteNotFound},
+	}
+
+	for _, tt := range tests {
+		req := &pb.RedeemInviteRequest{Code: tt.code, UserUid: tt.userUID.String()}
+		res, err := s.RedeemInvite(context.Background(), req)
+		if err != tt.err {
+			t.Errorf("user %s redeeming %s: unexpected error %v", tt.userUID, tt.code, err)
+		} else if err == nil && res.Uid != privateUID.String() {
+			t.Errorf("unexpected category %v", res)
+		}
+	}
+
+	req := &pb.RedeemInviteRequest{Code: "not a code", UserUid: pendingUserUID.String()}
+	_, err := s.RedeemInvite(context.Background(), req)
+	if !hasViolations(err, "code") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListInvites(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListInvitesRequest{CategoryUid: privateUID.String(), UserUid: ownerUID.String()}
+	res, err := s.ListInvites(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Invites) != 1 || res.Invites[0].ExpiresAt != nil {
+		t.Errorf("unexpected invites %v", res.Invites)
+	}
+}
+
+func TestRevokeInvite(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.RevokeInviteRequest{Code: inviteCode, UserUid: ownerUID.String()}
+	_, err := s.RevokeInvite(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.RevokeInvite(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+}
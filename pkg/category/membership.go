@@ -0,0 +1,313 @@
+package category
+
+import (
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	statusNotCategoryOwner    = status.Error(codes.PermissionDenied, "only category owner can do this")
+	statusJoinRequestNotFound = status.Error(codes.NotFound, "join request not found")
+	statusJoinRequestExists   = status.Error(codes.AlreadyExists, "join request is already pending")
+	statusJoinRequestDecided  = status.Error(codes.FailedPrecondition, "join request is already decided")
+	statusAlreadyMember       = status.Error(codes.AlreadyExists, "user is already a member of category")
+	statusPublicCategory      = status.Error(codes.FailedPrecondition, "public category doesn't require membership")
+)
+
+var visibilities = map[pb.Visibility]Visibility{
+	pb.Visibility_PUBLIC:     VisibilityPublic,
+	pb.Visibility_RESTRICTED: VisibilityRestricted,
+	pb.Visibility_PRIVATE:    VisibilityPrivate,
+}
+
+var visibilitiesProto = map[Visibility]pb.Visibility{
+	VisibilityPublic:     pb.Visibility_PUBLIC,
+	VisibilityRestricted: pb.Visibility_RESTRICTED,
+	VisibilityPrivate:    pb.Visibility_PRIVATE,
+}
+
+var joinRequestStatusesProto = map[JoinRequestStatus]pb.JoinRequestStatus{
+	JoinRequestPending:  pb.JoinRequestStatus_PENDING,
+	JoinRequestApproved: pb.JoinRequestStatus_APPROVED,
+	JoinRequestDenied:   pb.JoinRequestStatus_DENIED,
+}
+
+// SingleJoinRequest converts JoinRequest to SingleJoinRequest
+func (r *JoinRequest) SingleJoinRequest() (*pb.SingleJoinRequest, error) {
+	createdAtProto, err := ptypes.TimestampProto(r.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleJoinRequest)
+	res.Uid = r.UID.String()
+	res.CategoryUid = r.CategoryUID.String()
+	res.UserUid = r.UserUID.String()
+	res.Status = joinRequestStatusesProto[r.Status]
+	res.CreatedAt = createdAtProto
+	if !r.DecidedAt.IsZero() {
+		res.DecidedAt, err = ptypes.TimestampProto(r.DecidedAt)
+		if err != nil {
+			return nil, internalError(err)
+		}
+	}
+
+	return res, nil
+}
+
+// membership returns relation of user to category, owner is always a member
+func (s *Server) membership(category *Category, userUID uuid.UUID) (*Membership, error) {
+	if userUID == uuid.Nil {
+		return new(Membership), nil
+	}
+
+	if category.UserUID == userUID {
+		return &Membership{Member: true}, nil
+	}
+
+	return s.db.getMembership(category.UID, userUID)
+}
+
+// canRead checks if user can see category, private categories are visible only to members.
+// uuid.Nil is an anonymous user
+func (s *Server) canRead(category *Category, userUID uuid.UUID) (bool, error) {
+	if category.Visibility != VisibilityPrivate {
+		return true, nil
+	}
+
+	membership, err := s.membership(category, userUID)
+	if err != nil {
+		return false, err
+	}
+
+	return membership.Member, nil
+}
+
+// getVisibleCategory returns category if it can be read by user, hidden categories are reported as not found
+func (s *Server) getVisibleCategory(uid, userUID uuid.UUID) (*Category, error) {
+	category, err := s.db.getCategoryInfo(uid)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	ok, err := s.canRead(category, userUID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	if !ok {
+		return nil, statusCategoryNotFound
+	}
+
+	return category, nil
+}
+
+// getOwnedCategory returns category if user is its owner
+func (s *Server) getOwnedCategory(uid, userUID uuid.UUID) (*Category, error) {
+	category, err := s.getVisibleCategory(uid, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if category.UserUID != userUID {
+		return nil, statusNotCategoryOwner
+	}
+
+	return category, nil
+}
+
+// SetCategoryVisibility changes visibility of category, only owner can do it
+func (s *Server) SetCategoryVisibility(ctx context.Context, req *pb.SetCategoryVisibilityRequest) (*pb.SingleCategory, error) {
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	userUID := v.uuid("userUid", req.UserUid)
+	visibility := v.visibility("visibility", req.Visibility)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(uid, userUID); err != nil {
+		return nil, err
+	}
+
+	category, err := s.db.setCategoryVisibility(uid, visibility)
+	switch err {
+	case nil:
+		return category.SingleCategory(), nil
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// CreateJoinRequest asks owner of restricted or private category to make user a member
+func (s *Server) CreateJoinRequest(ctx context.Context, req *pb.CreateJoinRequestRequest) (*pb.SingleJoinRequest, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	category, err := s.db.getCategoryInfo(categoryUID)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	if category.Visibility != VisibilityRestricted && category.Visibility != VisibilityPrivate {
+		return nil, statusPublicCategory
+	}
+
+	membership, err := s.membership(category, userUID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	if membership.Member {
+		return nil, statusAlreadyMember
+	}
+
+	if err := s.checkNotBanned(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	joinRequest, err := s.db.createJoinRequest(categoryUID, userUID)
+	switch err {
+	case nil:
+		return joinRequest.SingleJoinRequest()
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	case errJoinRequestExists:
+		return nil, statusJoinRequestExists
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ListJoinRequests returns pending join requests of category, oldest first. Only owner can list them
+func (s *Server) ListJoinRequests(ctx context.Context, req *pb.ListJoinRequestsRequest) (*pb.ListJoinRequestsResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	joinRequests, err := s.db.getPendingJoinRequests(categoryUID, pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListJoinRequestsResponse)
+	for _, joinRequest := range joinRequests {
+		joinRequestResponse, err := joinRequest.SingleJoinRequest()
+		if err != nil {
+			return nil, err
+		}
+
+		res.JoinRequests = append(res.JoinRequests, joinRequestResponse)
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
+
+// ApproveJoinRequest makes author of join request a member of category
+func (s *Server) ApproveJoinRequest(ctx context.Context, req *pb.DecideJoinRequestRequest) (*pb.SingleJoinRequest, error) {
+	return s.decideJoinRequest(req, true)
+}
+
+// DenyJoinRequest rejects join request
+func (s *Server) DenyJoinRequest(ctx context.Context, req *pb.DecideJoinRequestRequest) (*pb.SingleJoinRequest, error) {
+	return s.decideJoinRequest(req, false)
+}
+
+func (s *Server) decideJoinRequest(req *pb.DecideJoinRequestRequest, approve bool) (*pb.SingleJoinRequest, error) {
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	joinRequest, err := s.db.getJoinRequest(uid)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusJoinRequestNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	if _, err := s.getOwnedCategory(joinRequest.CategoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	joinRequest, err = s.db.decideJoinRequest(uid, approve)
+	switch err {
+	case nil:
+		return joinRequest.SingleJoinRequest()
+	case errJoinRequestDecided:
+		return nil, statusJoinRequestDecided
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// CheckMembership tells if user is a member of category and what user can do in it
+func (s *Server) CheckMembership(ctx context.Context, req *pb.CheckMembershipRequest) (*pb.CheckMembershipResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	category, err := s.db.getCategoryInfo(categoryUID)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	membership, err := s.membership(category, userUID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.CheckMembershipResponse)
+	res.Member = membership.Member
+	res.PendingRequest = membership.PendingRequest
+	res.CanRead = category.Visibility != VisibilityPrivate || membership.Member
+	res.CanPost = category.Visibility != VisibilityRestricted && category.Visibility != VisibilityPrivate || membership.Member
+
+	return res, nil
+}
@@ -0,0 +1,248 @@
+package category
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var (
+	errJoinRequestExists  = errors.New("join request is already pending")
+	errJoinRequestDecided = errors.New("join request is already decided")
+)
+
+// Visibility controls who can read and post in category
+type Visibility string
+
+const (
+	// VisibilityPublic categories are read and posted to by anyone
+	VisibilityPublic Visibility = "public"
+	// VisibilityRestricted categories are read by anyone, but only members post
+	VisibilityRestricted Visibility = "restricted"
+	// VisibilityPrivate categories are read and posted to by members only
+	VisibilityPrivate Visibility = "private"
+)
+
+// JoinRequestStatus is the state of join request
+type JoinRequestStatus string
+
+// Join request is pending until category owner approves or denies it
+const (
+	JoinRequestPending  JoinRequestStatus = "pending"
+	JoinRequestApproved JoinRequestStatus = "approved"
+	JoinRequestDenied   JoinRequestStatus = "denied"
+)
+
+// Membership describes relation of user to category
+type Membership struct {
+	Member         bool
+	PendingRequest bool
+}
+
+// JoinRequest describes request of user to become member of category
+type JoinRequest struct {
+	UID         uuid.UUID
+	CategoryUID uuid.UUID
+	UserUID     uuid.UUID
+	Status      JoinRequestStatus
+	CreatedAt   time.Time
+	DecidedAt   time.Time
+}
+
+// visibleTo returns condition matching categories which can be read by viewer passed as query parameter n.
+// Anonymous viewer is passed as NULL, so it only matches non-private categories
+func visibleTo(n int) string {
+	return fmt.Sprintf(`(visibility <> 'private' OR user_uid=$%[1]d OR EXISTS (
+	    SELECT 1 FROM category_members m WHERE m.category_uid=categories.uid AND m.user_uid=$%[1]d
+	))`, n)
+}
+
+// nullableUUID converts uuid.Nil to NULL query parameter
+func nullableUUID(uid uuid.UUID) interface{} {
+	if uid == uuid.Nil {
+		return nil
+	}
+
+	return uid.String()
+}
+
+const joinRequestColumns = "uid, category_uid, user_uid, status, created_at, decided_at"
+
+func scanJoinRequest(row scanner) (*JoinRequest, error) {
+	joinRequest := new(JoinRequest)
+	var uid, categoryUID, userUID, status string
+	var decidedAt pq.NullTime
+	err := row.Scan(&uid, &categoryUID, &userUID, &status, &joinRequest.CreatedAt, &decidedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	joinRequest.Status = JoinRequestStatus(status)
+	if decidedAt.Valid {
+		joinRequest.DecidedAt = decidedAt.Time
+	}
+
+	joinRequest.UID, err = uuid.Parse(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	joinRequest.CategoryUID, err = uuid.Parse(categoryUID)
+	if err != nil {
+		return nil, err
+	}
+
+	joinRequest.UserUID, err = uuid.Parse(userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return joinRequest, nil
+}
+
+func (db *db) setCategoryVisibility(uid uuid.UUID, visibility Visibility) (*Category, error) {
+	query := "UPDATE categories SET visibility=$1 WHERE uid=$2 RETURNING " + categoryColumns
+	row := db.QueryRow(query, string(visibility), uid.String())
+	result, err := scanCategory(row)
+	switch err {
+	case nil:
+		return result, nil
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (db *db) getMembership(categoryUID, userUID uuid.UUID) (*Membership, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM category_members WHERE category_uid=$1 AND user_uid=$2),
+	                 EXISTS (SELECT 1 FROM join_requests WHERE category_uid=$1 AND user_uid=$2 AND status=$3)`
+	result := new(Membership)
+	err := db.QueryRow(query, categoryUID.String(), userUID.String(), string(JoinRequestPending)).
+		Scan(&result.Member, &result.PendingRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (db *db) createJoinRequest(categoryUID, userUID uuid.UUID) (*JoinRequest, error) {
+	joinRequest := new(JoinRequest)
+
+	query := `INSERT INTO join_requests (uid, category_uid, user_uid, status, created_at) VALUES ($1, $2, $3, $4, $5)
+	          ON CONFLICT DO NOTHING`
+	uid := uuid.New()
+
+	joinRequest.UID = uid
+	joinRequest.CategoryUID = categoryUID
+	joinRequest.UserUID = userUID
+	joinRequest.Status = JoinRequestPending
+	joinRequest.CreatedAt = time.Now()
+
+	result, err := db.Exec(query,
+		joinRequest.UID.String(), joinRequest.CategoryUID.String(), joinRequest.UserUID.String(),
+		string(joinRequest.Status), joinRequest.CreatedAt,
+	)
+	if isForeignKeyViolation(err) {
+		return nil, errNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if nRows == 0 {
+		return nil, errJoinRequestExists
+	}
+
+	return joinRequest, nil
+}
+
+func (db *db) getJoinRequest(uid uuid.UUID) (*JoinRequest, error) {
+	query := "SELECT " + joinRequestColumns + " FROM join_requests WHERE uid=$1"
+	result, err := scanJoinRequest(db.QueryRow(query, uid.String()))
+	switch err {
+	case nil:
+		return result, nil
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (db *db) getPendingJoinRequests(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*JoinRequest, error) {
+	query := `SELECT ` + joinRequestColumns + ` FROM join_requests
+	          WHERE category_uid=$1 AND status=$2
+	          ORDER BY created_at LIMIT $3 OFFSET $4`
+	lastRecord := pageNumber * pageSize
+	rows, err := db.Query(query, categoryUID.String(), string(JoinRequestPending), pageSize, lastRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*JoinRequest, 0)
+	for rows.Next() {
+		joinRequest, err := scanJoinRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, joinRequest)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// decideJoinRequest approves or denies pending join request, approved user becomes member of category
+func (db *db) decideJoinRequest(uid uuid.UUID, approve bool) (*JoinRequest, error) {
+	status := JoinRequestDenied
+	if approve {
+		status = JoinRequestApproved
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	query := `UPDATE join_requests SET status=$1, decided_at=$2
+	          WHERE uid=$3 AND status=$4
+	          RETURNING ` + joinRequestColumns
+	row := tx.QueryRow(query, string(status), time.Now(), uid.String(), string(JoinRequestPending))
+	joinRequest, err := scanJoinRequest(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, errJoinRequestDecided
+	case err != nil:
+		return nil, err
+	}
+
+	if approve {
+		query := `INSERT INTO category_members (category_uid, user_uid, created_at) VALUES ($1, $2, $3)
+		          ON CONFLICT DO NOTHING`
+		_, err := tx.Exec(query, joinRequest.CategoryUID.String(), joinRequest.UserUID.String(), joinRequest.DecidedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return joinRequest, tx.Commit()
+}
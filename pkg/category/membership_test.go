@@ -0,0 +1,233 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	privateUID            = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000020"))
+	restrictedUID         = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000021"))
+	ownerUID              = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000022"))
+	memberUID             = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000023"))
+	pendingUserUID        = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000024"))
+	pendingJoinRequestUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000025"))
+	decidedJoinRequestUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000026"))
+)
+
+func (mdb *mockdb) setCategoryVisibility(uid uuid.UUID, visibility Visibility) (*Category, error) {
+	return &Category{UID: uid, UserUID: ownerUID, Name: "aaa", Description: "aaa", Visibility: visibility}, nil
+}
+
+func (mdb *mockdb) getMembership(categoryUID, userUID uuid.UUID) (*Membership, error) {
+	return &Membership{Member: userUID == memberUID, PendingRequest: userUID == pendingUserUID}, nil
+}
+
+func (mdb *mockdb) createJoinRequest(categoryUID, userUID uuid.UUID) (*JoinRequest, error) {
+	if userUID == pendingUserUID {
+		return nil, errJoinRequestExists
+	}
+
+	return &JoinRequest{
+		UID: uuid.New(), CategoryUID: categoryUID, UserUID: userUID, Status: JoinRequestPending, CreatedAt: time.Now(),
+	}, nil
+}
+
+func (mdb *mockdb) getJoinRequest(uid uuid.UUID) (*JoinRequest, error) {
+	switch uid {
+	case pendingJoinRequestUID:
+		return &JoinRequest{UID: uid, CategoryUID: privateUID, UserUID: pendingUserUID, Status: JoinRequestPending, CreatedAt: time.Now()}, nil
+	case decidedJoinRequestUID:
+		return &JoinRequest{UID: uid, CategoryUID: privateUID, UserUID: memberUID, Status: JoinRequestApproved, CreatedAt: time.Now()}, nil
+	default:
+		return nil, errNotFound
+	}
+}
+
+func (mdb *mockdb) getPendingJoinRequests(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*JoinRequest, error) {
+	return []*JoinRequest{
+		{UID: pendingJoinRequestUID, CategoryUID: categoryUID, UserUID: pendingUserUID, Status: JoinRequestPending, CreatedAt: time.Now()},
+	}, nil
+}
+
+func (mdb *mockdb) decideJoinRequest(uid uuid.UUID, approve bool) (*JoinRequest, error) {
+	if uid != pendingJoinRequestUID {
+		return nil, errJoinRequestDecided
+	}
+
+	joinRequest := &JoinRequest{UID: uid, CategoryUID: privateUID, UserUID: pendingUserUID, CreatedAt: time.Now(), DecidedAt: time.Now()}
+	if approve {
+		joinRequest.Status = JoinRequestApproved
+	} else {
+		joinRequest.Status = JoinRequestDenied
+	}
+
+	return joinRequest, nil
+}
+
+func TestGetPrivateCategoryInfo(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	tests := []struct {
+		userUID uuid.UUID
+		err     error
+	}{
+		{uuid.Nil, statusCategoryNotFound},
+		{pendingUserUID, statusCategoryNotFound},
+		{memberUID, nil},
+		{ownerUID, nil},
+	}
+
+	for _, tt := range tests {
+		req := &pb.GetCategoryInfoRequest{Uid: privateUID.String()}
+		if tt.userUID != uuid.Nil {
+			req.UserUid = tt.userUID.String()
+		}
+
+		res, err := s.GetCategoryInfo(context.Background(), req)
+		if err != tt.err {
+			t.Errorf("user %s: unexpected error %v", tt.userUID, err)
+		} else if err == nil && res.Visibility != pb.Visibility_PRIVATE {
+			t.Errorf("user %s: expected private visibility, got %v", tt.userUID, res.Visibility)
+		}
+	}
+}
+
+func TestSetCategoryVisibility(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.SetCategoryVisibilityRequest{Uid: restrictedUID.String(), UserUid: ownerUID.String(), Visibility: pb.Visibility_PRIVATE}
+	res, err := s.SetCategoryVisibility(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Visibility != pb.Visibility_PRIVATE {
+		t.Errorf("expected private visibility, got %v", res.Visibility)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.SetCategoryVisibility(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Visibility = pb.Visibility(42)
+	_, err = s.SetCategoryVisibility(context.Background(), req)
+	if !hasViolations(err, "visibility") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestCreateJoinRequest(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	tests := []struct {
+		categoryUID, userUID uuid.UUID
+		code                 codes.Code
+	}{
+		{restrictedUID, uuid.New(), codes.OK},
+		{privateUID, uuid.New(), codes.OK},
+		{rootUID, uuid.New(), codes.FailedPrecondition},
+		{privateUID, memberUID, codes.AlreadyExists},
+		{privateUID, ownerUID, codes.AlreadyExists},
+		{privateUID, pendingUserUID, codes.AlreadyExists},
+	}
+
+	for _, tt := range tests {
+		req := &pb.CreateJoinRequestRequest{CategoryUid: tt.categoryUID.String(), UserUid: tt.userUID.String()}
+		res, err := s.CreateJoinRequest(context.Background(), req)
+		if status.Code(err) != tt.code {
+			t.Errorf("user %s joining %s: unexpected error %v", tt.userUID, tt.categoryUID, err)
+		} else if err == nil && res.Status != pb.JoinRequestStatus_PENDING {
+			t.Errorf("expected pending join request, got %v", res.Status)
+		}
+	}
+}
+
+func TestListJoinRequests(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListJoinRequestsRequest{CategoryUid: privateUID.String(), UserUid: ownerUID.String()}
+	res, err := s.ListJoinRequests(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.JoinRequests) != 1 || res.JoinRequests[0].DecidedAt != nil {
+		t.Errorf("unexpected join requests %v", res.JoinRequests)
+	}
+
+	req.UserUid = pendingUserUID.String()
+	_, err = s.ListJoinRequests(context.Background(), req)
+	if err != statusCategoryNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.CategoryUid = restrictedUID.String()
+	_, err = s.ListJoinRequests(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestDecideJoinRequest(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.DecideJoinRequestRequest{Uid: pendingJoinRequestUID.String(), UserUid: ownerUID.String()}
+	res, err := s.ApproveJoinRequest(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Status != pb.JoinRequestStatus_APPROVED || res.DecidedAt == nil {
+		t.Errorf("unexpected join request %v", res)
+	}
+
+	res, err = s.DenyJoinRequest(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Status != pb.JoinRequestStatus_DENIED {
+		t.Errorf("unexpected join request %v", res)
+	}
+
+	req.Uid = decidedJoinRequestUID.String()
+	_, err = s.ApproveJoinRequest(context.Background(), req)
+	if err != statusJoinRequestDecided {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.ApproveJoinRequest(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Uid = nilUIDString
+	_, err = s.DenyJoinRequest(context.Background(), req)
+	if err != statusJoinRequestNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestCheckMembership(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	tests := []struct {
+		categoryUID, userUID                     uuid.UUID
+		member, pendingRequest, canRead, canPost bool
+	}{
+		{rootUID, uuid.New(), false, false, true, true},
+		{restrictedUID, pendingUserUID, false, true, true, false},
+		{restrictedUID, memberUID, true, false, true, true},
+		{privateUID, pendingUserUID, false, true, false, false},
+		{privateUID, ownerUID, true, false, true, true},
+	}
+
+	for _, tt := range tests {
+		req := &pb.CheckMembershipRequest{CategoryUid: tt.categoryUID.String(), UserUid: tt.userUID.String()}
+		res, err := s.CheckMembership(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error %v", err)
+			continue
+		}
+
+		if res.Member != tt.member || res.PendingRequest != tt.pendingRequest || res.CanRead != tt.canRead || res.CanPost != tt.canPost {
+			t.Errorf("user %s in %s: unexpected membership %v", tt.userUID, tt.categoryUID, res)
+		}
+	}
+}
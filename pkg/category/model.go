@@ -3,24 +3,40 @@ package category
 import (
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
+	"golang.org/x/net/context"
 )
 
 var (
-	errNotFound           = errors.New("category not found")
-	errCategoryNotCreated = errors.New("category not created")
-	errReportNotCreated   = errors.New("report not created")
+	errNotFound          = errors.New("category not found")
+	errCategoryNameTaken = errors.New("category name is taken")
+	errReportNotCreated  = errors.New("report not created")
 )
 
 // Category describes category created by user
 type Category struct {
-	UID         uuid.UUID
-	UserUID     uuid.UUID
-	Name        string
-	Description string
+	UID             uuid.UUID
+	UserUID         uuid.UUID
+	ParentUID       uuid.UUID
+	Name            string
+	Description     string
+	Language        string
+	Slug            string
+	SubscriberCount int64
+	Visibility      Visibility
+}
+
+// CategorySearchResult describes category found by full-text search
+type CategorySearchResult struct {
+	Category             *Category
+	Score                float32
+	NameHighlight        string
+	DescriptionHighlight string
 }
 
 // Report describes report submitted by user
@@ -29,17 +45,112 @@ type Report struct {
 	CategoryUID uuid.UUID
 	PostUID     uuid.UUID
 	CommentUID  uuid.UUID
+	RuleUID     uuid.UUID
+	ReasonCode  ReasonCode
 	Reason      string
+	Routing     Routing
 	CreatedAt   time.Time
+	// AssigneeUID is the moderator handling report, claims of moderators expire at ClaimExpiresAt
+	AssigneeUID    uuid.UUID
+	ClaimExpiresAt time.Time
+	NoteCount      int32
 }
 
+// ts_headline options used to mark matched words in search results.
+// Names are short, so they are highlighted as a whole, descriptions are cut to fragments around matches.
+const (
+	nameHighlightOptions        = "StartSel=<mark>, StopSel=</mark>, HighlightAll=TRUE"
+	descriptionHighlightOptions = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, FragmentDelimiter=\" ... \""
+)
+
 type datastore interface {
-	getAllCategories(int32, int32) ([]*Category, error)
+	getAllCategories(uuid.UUID, int32, int32) ([]*Category, error)
 	getCategoryInfo(uuid.UUID) (*Category, error)
-	createCategory(string, string, uuid.UUID) (*Category, error)
-	getAllReports(uuid.UUID, int32, int32) ([]*Report, error)
-	createReport(uuid.UUID, uuid.UUID, uuid.UUID, string) (*Report, error)
-	deleteReport(uuid.UUID) error
+	getCategoryUIDByCanonicalName(string) (uuid.UUID, error)
+	getCategoryBySlug(string) (*Category, error)
+	createCategory(string, string, string, string, string, Visibility, uuid.UUID, uuid.UUID) (*Category, error)
+	setCategoryVisibility(uuid.UUID, Visibility) (*Category, error)
+	getChildCategories(uuid.UUID, uuid.UUID, int32, int32) ([]*Category, error)
+	getCategoryAncestors(uuid.UUID, uuid.UUID) ([]*Category, error)
+	moveCategory(uuid.UUID, uuid.UUID) (*Category, error)
+	getCategoriesWithoutSlug(int32) ([]*Category, error)
+	backfillCategorySlug(uuid.UUID, string) (string, error)
+	searchCategories(string, string, uuid.UUID, int32, int32) ([]*CategorySearchResult, error)
+	suggestCategories(string, uuid.UUID, int32) ([]*Category, error)
+	subscribe(uuid.UUID, uuid.UUID) error
+	unsubscribe(uuid.UUID, uuid.UUID) error
+	getSubscribers(uuid.UUID, int32, int32) ([]*Subscription, error)
+	getSubscriptions(uuid.UUID, uuid.UUID, int32, int32) ([]*Category, error)
+	getMembership(uuid.UUID, uuid.UUID) (*Membership, error)
+	createJoinRequest(uuid.UUID, uuid.UUID) (*JoinRequest, error)
+	getJoinRequest(uuid.UUID) (*JoinRequest, error)
+	getPendingJoinRequests(uuid.UUID, int32, int32) ([]*JoinRequest, error)
+	decideJoinRequest(uuid.UUID, bool) (*JoinRequest, error)
+	createInvite(string, uuid.UUID, uuid.UUID, int32, time.Time) (*Invite, error)
+	getInvite(string) (*Invite, error)
+	getInvites(uuid.UUID, int32, int32) ([]*Invite, error)
+	redeemInvite(string, uuid.UUID) (*Invite, error)
+	deleteInvite(string) error
+	banUser(uuid.UUID, uuid.UUID, uuid.UUID, string, time.Time) (*Ban, error)
+	unbanUser(uuid.UUID, uuid.UUID) error
+	getActiveBan(uuid.UUID, uuid.UUID) (*Ban, error)
+	getActiveBans(uuid.UUID, int32, int32) ([]*Ban, error)
+	getEscalationPolicy(uuid.UUID) (*EscalationPolicy, error)
+	setEscalationPolicy(*EscalationPolicy) error
+	addStrike(uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, string) (*Strike, *Suspension, error)
+	getStrikes(uuid.UUID, uuid.UUID, bool, int32, int32) ([]*Strike, error)
+	getActiveSuspension(uuid.UUID, uuid.UUID) (*Suspension, error)
+	hasNoteAccess(uuid.UUID, uuid.UUID) (bool, error)
+	grantNoteAccess(uuid.UUID, uuid.UUID) (*NoteAccessGrant, error)
+	revokeNoteAccess(uuid.UUID, uuid.UUID) error
+	getNoteAccessGrants(uuid.UUID) ([]*NoteAccessGrant, error)
+	createUserNote(uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, string) (*UserNote, error)
+	getUserNote(uuid.UUID) (*UserNote, error)
+	getUserNotes(uuid.UUID, uuid.UUID, int32, int32) ([]*UserNote, error)
+	deleteUserNote(uuid.UUID) error
+	createRule(uuid.UUID, string, string) (*Rule, error)
+	updateRule(uuid.UUID, string, string) (*Rule, error)
+	getRule(uuid.UUID) (*Rule, error)
+	getRules(uuid.UUID) ([]*Rule, error)
+	reorderRules(uuid.UUID, []uuid.UUID) ([]*Rule, error)
+	getAllReports(*reportFilter, reportOrder, int32, int32) ([]*Report, error)
+	countReportsByRule(*reportFilter) ([]*RuleReportCount, error)
+	createReport(*Report) error
+	deleteReport(uuid.UUID, uuid.UUID) error
+	getReportCategoryUID(uuid.UUID) (uuid.UUID, error)
+	claimReport(uuid.UUID, uuid.UUID, time.Time) (*Report, error)
+	releaseReport(uuid.UUID, uuid.UUID) (*Report, error)
+	assignReport(uuid.UUID, uuid.UUID, uuid.UUID) (*Report, error)
+	getAssignmentStrategy(uuid.UUID) (AssignmentStrategy, error)
+	setAssignmentStrategy(uuid.UUID, AssignmentStrategy) error
+	addReportHandler(uuid.UUID, uuid.UUID, []string) (*ReportHandler, error)
+	removeReportHandler(uuid.UUID, uuid.UUID) error
+	isReportHandler(uuid.UUID, uuid.UUID) (bool, error)
+	setReportHandlerAway(uuid.UUID, uuid.UUID, bool) (*ReportHandler, error)
+	getReportHandlers(uuid.UUID) ([]*ReportHandler, error)
+	createThresholdPolicy(*ThresholdPolicy) error
+	getThresholdPolicy(uuid.UUID) (*ThresholdPolicy, error)
+	deleteThresholdPolicy(uuid.UUID) error
+	getThresholdPolicies(uuid.UUID) ([]*ThresholdPolicy, error)
+	getAutoActions(uuid.UUID, int32, int32) ([]*AutoAction, error)
+	getAutoAction(uuid.UUID) (*AutoAction, error)
+	reviewAutoAction(uuid.UUID, uuid.UUID, bool) (*AutoAction, error)
+	getEvents(int64, int32) ([]*Event, error)
+	getLastEventID() (int64, error)
+	addReportNote(*ReportNote) error
+	getReportNotes(uuid.UUID) ([]*ReportNote, bool, error)
+	resolveReport(*ReportOutcome) error
+	getReportOutcomes(uuid.UUID, int32, int32) ([]*ReportOutcome, error)
+	deleteReports(*reportFilter, uuid.UUID, bool) ([]*Report, error)
+	setRetentionPolicy(*RetentionPolicy) error
+	deleteRetentionPolicy(uuid.UUID) error
+	getRetentionPolicies(int32, int32) ([]*RetentionPolicy, error)
+	applyRetention(RetentionAction, int32) (int64, error)
+	purgeArchivedReportNotes(int32) (int64, error)
+	previewRetention() ([]*RetentionPreview, int64, error)
+	exportReports(context.Context, *reportFilter, func(*Report) error) error
+	getHandleTimeHistograms(uuid.UUID, time.Time, time.Time) ([]*HandleTimeHistogram, error)
+	getOpenReportCount(uuid.UUID) (int64, error)
 }
 
 type db struct {
@@ -51,30 +162,60 @@ func newDB(connString string) (*db, error) {
 	return &db{postgres}, err
 }
 
-func (db *db) getAllCategories(pageSize, pageNumber int32) ([]*Category, error) {
-	query := "SELECT uid, user_uid, name, description FROM categories LIMIT $1 OFFSET $2"
-	lastRecord := pageNumber * pageSize
-	rows, err := db.Query(query, pageSize, lastRecord)
+// categoryColumns are selected by every query returning categories, in the order expected by scanCategory
+const categoryColumns = "uid, user_uid, parent_uid, name, description, language, COALESCE(slug, ''), subscriber_count, visibility"
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanCategory scans categoryColumns followed by extra destinations
+func scanCategory(row scanner, extra ...interface{}) (*Category, error) {
+	category := new(Category)
+	var uid, userUID, visibility string
+	var parentUID sql.NullString
+	dest := append([]interface{}{
+		&uid, &userUID, &parentUID, &category.Name, &category.Description, &category.Language, &category.Slug,
+		&category.SubscriberCount, &visibility,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	category.Visibility = Visibility(visibility)
+
+	var err error
+	category.UID, err = uuid.Parse(uid)
 	if err != nil {
 		return nil, err
 	}
 
-	defer rows.Close()
-	result := make([]*Category, 0)
-	for rows.Next() {
-		category := new(Category)
-		var uid, userUID string
-		err := rows.Scan(&uid, &userUID, &category.Name, &category.Description)
-		if err != nil {
-			return nil, err
-		}
+	category.UserUID, err = uuid.Parse(userUID)
+	if err != nil {
+		return nil, err
+	}
 
-		category.UID, err = uuid.Parse(uid)
+	if parentUID.Valid {
+		category.ParentUID, err = uuid.Parse(parentUID.String)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		category.UserUID, err = uuid.Parse(userUID)
+	return category, nil
+}
+
+// queryCategories runs query returning categoryColumns
+func (db *db) queryCategories(query string, args ...interface{}) ([]*Category, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*Category, 0)
+	for rows.Next() {
+		category, err := scanCategory(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -89,20 +230,33 @@ func (db *db) getAllCategories(pageSize, pageNumber int32) ([]*Category, error)
 	return result, nil
 }
 
+func (db *db) getAllCategories(viewerUID uuid.UUID, pageSize, pageNumber int32) ([]*Category, error) {
+	query := "SELECT " + categoryColumns + " FROM categories WHERE " + visibleTo(3) + " LIMIT $1 OFFSET $2"
+	lastRecord := pageNumber * pageSize
+	return db.queryCategories(query, pageSize, lastRecord, nullableUUID(viewerUID))
+}
+
 func (db *db) getCategoryInfo(uid uuid.UUID) (*Category, error) {
-	query := "SELECT user_uid, name, description FROM categories WHERE uid=$1"
+	query := "SELECT " + categoryColumns + " FROM categories WHERE uid=$1"
 	row := db.QueryRow(query, uid.String())
-	result := new(Category)
-	var stringUserUID string
-	switch err := row.Scan(&stringUserUID, &result.Name, &result.Description); err {
+	result, err := scanCategory(row)
+	switch err {
 	case nil:
-		result.UID = uid
-		userUID, err := uuid.Parse(stringUserUID)
-		if err != nil {
-			return nil, err
-		}
+		return result, nil
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+}
 
-		result.UserUID = userUID
+func (db *db) getCategoryBySlug(slug string) (*Category, error) {
+	query := `SELECT ` + categoryColumns + ` FROM categories
+	          WHERE uid=(SELECT category_uid FROM category_slugs WHERE slug=$1)`
+	row := db.QueryRow(query, slug)
+	result, err := scanCategory(row)
+	switch err {
+	case nil:
 		return result, nil
 	case sql.ErrNoRows:
 		return nil, errNotFound
@@ -111,18 +265,59 @@ func (db *db) getCategoryInfo(uid uuid.UUID) (*Category, error) {
 	}
 }
 
-func (db *db) createCategory(name, description string, userUID uuid.UUID) (*Category, error) {
+func (db *db) getCategoryUIDByCanonicalName(canonicalName string) (uuid.UUID, error) {
+	query := "SELECT uid FROM categories WHERE canonical_name=$1"
+	row := db.QueryRow(query, canonicalName)
+	var uid string
+	switch err := row.Scan(&uid); err {
+	case nil:
+		return uuid.Parse(uid)
+	case sql.ErrNoRows:
+		return uuid.Nil, errNotFound
+	default:
+		return uuid.Nil, err
+	}
+}
+
+func (db *db) createCategory(name, canonicalName, baseSlug, description, language string, visibility Visibility, userUID, parentUID uuid.UUID) (*Category, error) {
 	category := new(Category)
 
-	query := "INSERT INTO categories (uid, user_uid, name, description) VALUES ($1, $2, $3, $4)"
+	query := `INSERT INTO categories (uid, user_uid, parent_uid, name, canonical_name, description, language, visibility)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	          ON CONFLICT (canonical_name) DO NOTHING`
 	uid := uuid.New()
 
 	category.UID = uid
 	category.UserUID = userUID
+	category.ParentUID = parentUID
 	category.Name = name
 	category.Description = description
+	category.Language = language
+	category.Visibility = visibility
 
-	result, err := db.Exec(query, category.UID.String(), userUID.String(), name, description)
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	var parent interface{}
+	if parentUID != uuid.Nil {
+		if err := lockCategoryTree(tx); err != nil {
+			return nil, err
+		}
+
+		if err := checkParent(tx, parentUID, 0); err != nil {
+			return nil, err
+		}
+
+		parent = parentUID.String()
+	}
+
+	result, err := tx.Exec(query,
+		category.UID.String(), userUID.String(), parent, name, canonicalName, description, language, string(visibility),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -133,50 +328,339 @@ func (db *db) createCategory(name, description string, userUID uuid.UUID) (*Cate
 	}
 
 	if nRows == 0 {
-		return nil, errCategoryNotCreated
+		return nil, errCategoryNameTaken
 	}
 
-	return category, nil
+	category.Slug, err = setCategorySlug(tx, uid, baseSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, tx.Commit()
+}
+
+func (db *db) getCategoriesWithoutSlug(limit int32) ([]*Category, error) {
+	query := "SELECT " + categoryColumns + " FROM categories WHERE slug IS NULL LIMIT $1"
+	return db.queryCategories(query, limit)
+}
+
+// backfillCategorySlug sets slug of category which has none, slug set concurrently by another replica is returned as is
+func (db *db) backfillCategorySlug(uid uuid.UUID, baseSlug string) (string, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return "", err
+	}
+
+	defer tx.Rollback()
+
+	var slug sql.NullString
+	err = tx.QueryRow("SELECT slug FROM categories WHERE uid=$1 FOR UPDATE", uid.String()).Scan(&slug)
+	switch {
+	case err == sql.ErrNoRows:
+		return "", errNotFound
+	case err != nil:
+		return "", err
+	case slug.Valid:
+		return slug.String, nil
+	}
+
+	result, err := setCategorySlug(tx, uid, baseSlug)
+	if err != nil {
+		return "", err
+	}
+
+	return result, tx.Commit()
+}
+
+// setCategorySlug claims the first candidate for baseSlug which is free or already belongs to the category,
+// records it in slug history and makes it the current slug of category.
+// Slugs are never given to another category, so old slugs keep pointing to the category
+func setCategorySlug(tx *sql.Tx, uid uuid.UUID, baseSlug string) (string, error) {
+	query := `INSERT INTO category_slugs (slug, category_uid, created_at)
+	          VALUES ($1, $2, $3)
+	          ON CONFLICT (slug) DO UPDATE SET created_at=EXCLUDED.created_at
+	          WHERE category_slugs.category_uid=EXCLUDED.category_uid`
+	for n := 1; ; n++ {
+		slug := slugCandidate(baseSlug, uid, n)
+		result, err := tx.Exec(query, slug, uid.String(), time.Now())
+		if err != nil {
+			return "", err
+		}
+
+		nRows, err := result.RowsAffected()
+		if err != nil {
+			return "", err
+		}
+
+		if nRows == 0 {
+			continue
+		}
+
+		_, err = tx.Exec("UPDATE categories SET slug=$1 WHERE uid=$2", slug, uid.String())
+		return slug, err
+	}
 }
 
-func (db *db) getAllReports(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*Report, error) {
-	query := `SELECT uid, post_uid, comment_uid, reason, created_at
-	          FROM reports
-	          WHERE category_uid=$1
-	          ORDER BY created_at DESC LIMIT $2 OFFSET $3`
+func (db *db) searchCategories(text, language string, viewerUID uuid.UUID, pageSize, pageNumber int32) ([]*CategorySearchResult, error) {
+	query := `SELECT ` + categoryColumns + `, ts_rank(search_vector, query),
+	                 ts_headline(language, name, query, $5),
+	                 ts_headline(language, description, query, $6)
+	          FROM categories, plainto_tsquery($1::regconfig, $2) query
+	          WHERE search_vector @@ query AND ` + visibleTo(7) + `
+	          ORDER BY ts_rank(search_vector, query) DESC, name LIMIT $3 OFFSET $4`
 	lastRecord := pageNumber * pageSize
-	rows, err := db.Query(query, categoryUID.String(), pageSize, lastRecord)
+	rows, err := db.Query(query, language, text, pageSize, lastRecord,
+		nameHighlightOptions, descriptionHighlightOptions, nullableUUID(viewerUID),
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	defer rows.Close()
-	result := make([]*Report, 0)
+	result := make([]*CategorySearchResult, 0)
 	for rows.Next() {
-		report := new(Report)
-		var uid, postUID, commentUID string
-		err := rows.Scan(&uid, &postUID, &commentUID, &report.Reason, &report.CreatedAt)
+		searchResult := new(CategorySearchResult)
+		searchResult.Category, err = scanCategory(rows,
+			&searchResult.Score, &searchResult.NameHighlight, &searchResult.DescriptionHighlight,
+		)
 		if err != nil {
 			return nil, err
 		}
 
-		report.UID, err = uuid.Parse(uid)
+		result = append(result, searchResult)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// minFuzzyPrefixLength is the shortest prefix for which trigram similarity gives meaningful suggestions
+const minFuzzyPrefixLength = 3
+
+func (db *db) suggestCategories(prefix string, viewerUID uuid.UUID, limit int32) ([]*Category, error) {
+	query := `(SELECT ` + categoryColumns + `
+	           FROM categories
+	           WHERE lower(name) LIKE $1 || '%' AND ` + visibleTo(5) + `
+	           ORDER BY subscriber_count DESC, name LIMIT $3)
+	          UNION ALL
+	          (SELECT ` + categoryColumns + `
+	           FROM categories
+	           WHERE char_length($2) >= $4 AND lower(name) % $2 AND lower(name) NOT LIKE $1 || '%' AND ` + visibleTo(5) + `
+	           ORDER BY similarity(lower(name), $2) DESC, subscriber_count DESC, name LIMIT $3)
+	          LIMIT $3`
+	return db.queryCategories(query, escapeLike(prefix), prefix, limit, minFuzzyPrefixLength, nullableUUID(viewerUID))
+}
+
+// uuidStrings converts UIDs to strings which can be passed as Postgres array
+func uuidStrings(uids []uuid.UUID) []string {
+	result := make([]string, len(uids))
+	for i, uid := range uids {
+		result[i] = uid.String()
+	}
+
+	return result
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLike escapes LIKE wildcards so s is matched literally
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// reportFilter selects reports, reports of every category are selected when CategoryUIDs is empty
+type reportFilter struct {
+	UIDs               []uuid.UUID
+	CategoryUIDs       []uuid.UUID
+	IncludeDescendants bool
+	RuleUID            uuid.UUID
+	Routing            Routing
+	PostUID            uuid.UUID
+	CreatedAfter       time.Time
+	CreatedBefore      time.Time
+	ReasonText         string
+	AssigneeUID        uuid.UUID
+	Unassigned         bool
+}
+
+// reportOrder is the order in which reports are listed
+type reportOrder int
+
+const (
+	reportOrderNewest reportOrder = iota
+	reportOrderOldest
+	// reportOrderMostReported lists reports of the most reported posts and comments first. Reports of post or comment
+	// are counted regardless of filter, so filter only selects reports and doesn't change their order
+	reportOrderMostReported
+)
+
+// queryArgs collects parameters of dynamically built query
+type queryArgs []interface{}
+
+// add appends parameter and returns its placeholder
+func (a *queryArgs) add(value interface{}) string {
+	*a = append(*a, value)
+	return fmt.Sprintf("$%d", len(*a))
+}
+
+// where returns condition matching reports selected by filter, its parameters are added to args
+func (f *reportFilter) where(args *queryArgs) string {
+	conditions := []string{"TRUE"}
+	if len(f.UIDs) > 0 {
+		conditions = append(conditions, "uid=ANY("+args.add(pq.Array(uuidStrings(f.UIDs)))+")")
+	}
+
+	if len(f.CategoryUIDs) > 0 {
+		categoryUIDs := uuidStrings(f.CategoryUIDs)
+		if f.IncludeDescendants {
+			conditions = append(conditions, `category_uid IN (
+			    WITH RECURSIVE subtree(uid, level) AS (
+			        SELECT uid, 0 FROM categories WHERE uid=ANY(`+args.add(pq.Array(categoryUIDs))+`)
+			        UNION ALL
+			        SELECT c.uid, s.level + 1
+			        FROM categories c JOIN subtree s ON c.parent_uid=s.uid
+			        WHERE s.level <= `+args.add(maxCategoryDepth)+`
+			    )
+			    SELECT uid FROM subtree
+			)`)
+		} else {
+			conditions = append(conditions, "category_uid=ANY("+args.add(pq.Array(categoryUIDs))+")")
+		}
+	}
+
+	if f.RuleUID != uuid.Nil {
+		conditions = append(conditions, "rule_uid="+args.add(f.RuleUID.String()))
+	}
+
+	if f.Routing != "" {
+		conditions = append(conditions, "routing="+args.add(string(f.Routing)))
+	}
+
+	if f.PostUID != uuid.Nil {
+		conditions = append(conditions, "post_uid="+args.add(f.PostUID.String()))
+	}
+
+	if !f.CreatedAfter.IsZero() {
+		conditions = append(conditions, "created_at >= "+args.add(f.CreatedAfter))
+	}
+
+	if !f.CreatedBefore.IsZero() {
+		conditions = append(conditions, "created_at < "+args.add(f.CreatedBefore))
+	}
+
+	if f.ReasonText != "" {
+		conditions = append(conditions, "lower(reason) LIKE '%' || "+args.add(escapeLike(strings.ToLower(f.ReasonText)))+" || '%'")
+	}
+
+	if f.AssigneeUID != uuid.Nil {
+		conditions = append(conditions, "assignee_uid="+args.add(f.AssigneeUID.String())+" AND "+claimActive)
+	}
+
+	if f.Unassigned {
+		conditions = append(conditions, "(assignee_uid IS NULL OR NOT "+claimActive+")")
+	}
+
+	return strings.Join(conditions, " AND ")
+}
+
+// reportColumns select assignee of report only while it holds report
+const reportColumns = `uid, category_uid, post_uid, comment_uid, rule_uid, reason_code, reason, routing, created_at,
+	CASE WHEN ` + claimActive + ` THEN assignee_uid END, CASE WHEN ` + claimActive + ` THEN claim_expires_at END, note_count`
+
+func scanReport(row scanner) (*Report, error) {
+	report := new(Report)
+	var uid, categoryUID, postUID, commentUID, reasonCode, routing string
+	var ruleUID, assigneeUID sql.NullString
+	var claimExpiresAt pq.NullTime
+	err := row.Scan(&uid, &categoryUID, &postUID, &commentUID, &ruleUID, &reasonCode, &report.Reason, &routing, &report.CreatedAt,
+		&assigneeUID, &claimExpiresAt, &report.NoteCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if assigneeUID.Valid {
+		report.AssigneeUID, err = uuid.Parse(assigneeUID.String)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		report.PostUID, err = uuid.Parse(postUID)
+	if claimExpiresAt.Valid {
+		report.ClaimExpiresAt = claimExpiresAt.Time
+	}
+
+	report.ReasonCode = ReasonCode(reasonCode)
+	report.Routing = Routing(routing)
+
+	report.UID, err = uuid.Parse(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	report.CategoryUID, err = uuid.Parse(categoryUID)
+	if err != nil {
+		return nil, err
+	}
+
+	report.PostUID, err = uuid.Parse(postUID)
+	if err != nil {
+		return nil, err
+	}
+
+	report.CommentUID, err = uuid.Parse(commentUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ruleUID.Valid {
+		report.RuleUID, err = uuid.Parse(ruleUID.String)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		report.CommentUID, err = uuid.Parse(commentUID)
+	return report, nil
+}
+
+// reportsQuery returns query selecting reports by filter in given order, its parameters are added to args
+func reportsQuery(filter *reportFilter, order reportOrder, args *queryArgs) string {
+	where := filter.where(args)
+	switch order {
+	case reportOrderOldest:
+		return `SELECT ` + reportColumns + ` FROM reports WHERE ` + where + ` ORDER BY created_at, uid`
+	case reportOrderMostReported:
+		// report_targets keeps the number of all reports of every post or comment, so the most reported targets are
+		// read from its index and only their reports are filtered instead of counting every report selected by filter.
+		// Targets are thus ordered by all of their reports, even by ones which filter doesn't select
+		return `SELECT ` + reportColumns + ` FROM report_targets t JOIN reports USING (post_uid, comment_uid)
+		        WHERE ` + where + `
+		        ORDER BY t.report_count DESC, post_uid, comment_uid, created_at DESC, uid`
+	default:
+		return `SELECT ` + reportColumns + ` FROM reports WHERE ` + where + ` ORDER BY created_at DESC, uid`
+	}
+}
+
+func (db *db) getAllReports(filter *reportFilter, order reportOrder, pageSize, pageNumber int32) ([]*Report, error) {
+	args := new(queryArgs)
+	query := reportsQuery(filter, order, args)
+	query += ` LIMIT ` + args.add(pageSize) + ` OFFSET ` + args.add(pageNumber*pageSize)
+	rows, err := db.Query(query, *args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*Report, 0)
+	for rows.Next() {
+		report, err := scanReport(rows)
 		if err != nil {
 			return nil, err
 		}
 
-		report.CategoryUID = categoryUID
-
 		result = append(result, report)
 	}
 
@@ -187,54 +671,150 @@ func (db *db) getAllReports(categoryUID uuid.UUID, pageSize, pageNumber int32) (
 	return result, nil
 }
 
-func (db *db) createReport(categoryUID, postUID, commentUID uuid.UUID, reason string) (*Report, error) {
-	report := new(Report)
+// countReportsByRule returns numbers of reports selected by filter for every cited rule, most cited rules first
+func (db *db) countReportsByRule(filter *reportFilter) ([]*RuleReportCount, error) {
+	args := new(queryArgs)
+	query := `SELECT g.rule_uid, COALESCE(r.title, ''), g.n
+	          FROM (SELECT rule_uid, count(*) AS n FROM reports WHERE ` + filter.where(args) + ` GROUP BY rule_uid) g
+	          LEFT JOIN category_rules r ON r.uid=g.rule_uid
+	          ORDER BY g.n DESC, r.position`
+	rows, err := db.Query(query, *args...)
+	if err != nil {
+		return nil, err
+	}
 
-	query := "INSERT INTO reports (uid, category_uid, post_uid, comment_uid, reason, created_at) VALUES ($1, $2, $3, $4, $5, $6)"
-	uid := uuid.New()
+	defer rows.Close()
+	result := make([]*RuleReportCount, 0)
+	for rows.Next() {
+		count := new(RuleReportCount)
+		var ruleUID sql.NullString
+		if err := rows.Scan(&ruleUID, &count.Title, &count.Count); err != nil {
+			return nil, err
+		}
+
+		if ruleUID.Valid {
+			count.RuleUID, err = uuid.Parse(ruleUID.String)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, count)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// createReport stores report, its UID and creation time are set.
+// Reports handled by category are assigned by assignment strategy of category,
+// threshold policies of category are applied to reported post
+func (db *db) createReport(report *Report) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
 
-	report.UID = uid
-	report.CategoryUID = categoryUID
-	report.PostUID = postUID
-	report.CommentUID = commentUID
-	report.Reason = reason
+	defer tx.Rollback()
+
+	query := `INSERT INTO reports (uid, category_uid, post_uid, comment_uid, rule_uid, reason_code, reason, routing, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	report.UID = uuid.New()
 	report.CreatedAt = time.Now()
 
-	result, err := db.Exec(query,
-		report.UID.String(), report.CategoryUID.String(), report.PostUID.String(),
-		report.CommentUID.String(), report.Reason, report.CreatedAt,
+	result, err := tx.Exec(query,
+		report.UID.String(), report.CategoryUID.String(), report.PostUID.String(), report.CommentUID.String(),
+		nullableUUID(report.RuleUID), string(report.ReasonCode), report.Reason, string(report.Routing), report.CreatedAt,
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	nRows, err := result.RowsAffected()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if nRows == 0 {
-		return nil, errReportNotCreated
+		return errReportNotCreated
 	}
 
-	return report, nil
+	if report.Routing == RoutingOwner {
+		if err := autoAssignReport(tx, report); err != nil {
+			return err
+		}
+	}
+
+	if err := applyThresholdPolicies(tx, report); err != nil {
+		return err
+	}
+
+	if err := emitReportCreated(tx, report.UID.String(), report.CategoryUID.String(), report.Routing); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// getReportCategoryUID returns UID of category of report, deleted report is found while its notes are archived
+func (db *db) getReportCategoryUID(uid uuid.UUID) (uuid.UUID, error) {
+	query := `SELECT category_uid FROM reports WHERE uid=$1
+	          UNION ALL
+	          SELECT category_uid FROM archived_report_notes WHERE report_uid=$1
+	          LIMIT 1`
+	var categoryUID string
+	err := db.QueryRow(query, uid.String()).Scan(&categoryUID)
+	switch err {
+	case nil:
+		return uuid.Parse(categoryUID)
+	case sql.ErrNoRows:
+		return uuid.Nil, errNotFound
+	default:
+		return uuid.Nil, err
+	}
 }
 
-func (db *db) deleteReport(uid uuid.UUID) error {
-	query := "DELETE FROM reports WHERE uid=$1"
-	result, err := db.Exec(query, uid.String())
+// deleteReport deletes report as handled by moderator, its notes are moved to archive.
+// Moderator is uuid.Nil when it isn't known who handled report
+func (db *db) deleteReport(uid, moderatorUID uuid.UUID) error {
+	tx, err := db.Begin()
 	if err != nil {
 		return err
 	}
 
-	nRows, err := result.RowsAffected()
-	if err != nil {
+	defer tx.Rollback()
+
+	if err := archiveReportNotes(tx, uid); err != nil {
 		return err
 	}
 
-	if nRows == 0 {
+	var categoryUID, routing string
+	var reportedAt time.Time
+	query := "DELETE FROM reports WHERE uid=$1 RETURNING category_uid, routing, created_at"
+	err = tx.QueryRow(query, uid.String()).Scan(&categoryUID, &routing, &reportedAt)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
 		return errNotFound
+	default:
+		return err
+	}
+
+	categoryUUID, err := uuid.Parse(categoryUID)
+	if err != nil {
+		return err
+	}
+
+	if err := recordHandledReport(tx, uid, categoryUUID, moderatorUID, reportedAt); err != nil {
+		return err
+	}
+
+	if err := emitReportDeleted(tx, uid.String(), categoryUID, Routing(routing)); err != nil {
+		return err
 	}
 
-	return nil
+	return tx.Commit()
 }
@@ -0,0 +1,109 @@
+package category
+
+import (
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+// handleTimePercentile estimates time within which share p of reports of histogram were handled.
+// It is interpolated linearly inside bucket, reports of the unbounded bucket count at its lower bound
+func handleTimePercentile(buckets []int64, p float64) time.Duration {
+	var total int64
+	for _, n := range buckets {
+		total += n
+	}
+
+	rank := p * float64(total)
+	var seen int64
+	for i, n := range buckets {
+		if n == 0 || float64(seen+n) < rank {
+			seen += n
+			continue
+		}
+
+		var lower time.Duration
+		if i > 0 {
+			lower = handleTimeBounds[i-1]
+		}
+
+		if i >= len(handleTimeBounds) {
+			return lower
+		}
+
+		return lower + time.Duration(float64(handleTimeBounds[i]-lower)*(rank-float64(seen))/float64(n))
+	}
+
+	return 0
+}
+
+// ModeratorStats converts HandleTimeHistogram to ModeratorStats
+func (h *HandleTimeHistogram) ModeratorStats() *pb.ModeratorStats {
+	res := new(pb.ModeratorStats)
+	if h.ModeratorUID != uuid.Nil {
+		res.ModeratorUid = h.ModeratorUID.String()
+	}
+
+	for _, n := range h.Buckets {
+		res.HandledCount += n
+	}
+
+	res.MedianTimeToHandle = ptypes.DurationProto(handleTimePercentile(h.Buckets, 0.5))
+
+	return res
+}
+
+// GetModerationStats returns the number of open reports of category and how fast reports handled in time range were handled.
+// Range is widened to whole UTC days and isn't limited from the side without bound. Times to handle are estimated
+// from daily histograms, moderator without UID stands for reports handled by unknown moderator. Only owner can get them
+func (s *Server) GetModerationStats(ctx context.Context, req *pb.GetModerationStatsRequest) (*pb.ModerationStats, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	from := v.optionalTime("from", req.From)
+	to := v.optionalTime("to", req.To)
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		v.addViolation("to", "to can't be before from")
+	}
+
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	fromDay := from.UTC().Truncate(24 * time.Hour)
+	toDay := to.UTC().Truncate(24 * time.Hour)
+	histograms, err := s.db.getHandleTimeHistograms(categoryUID, fromDay, toDay)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	openReportCount, err := s.db.getOpenReportCount(categoryUID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ModerationStats)
+	buckets := make([]int64, len(handleTimeBounds)+1)
+	for _, histogram := range histograms {
+		for i, n := range histogram.Buckets {
+			buckets[i] += n
+		}
+
+		moderator := histogram.ModeratorStats()
+		res.HandledCount += moderator.HandledCount
+		res.Moderators = append(res.Moderators, moderator)
+	}
+
+	res.OpenReportCount = openReportCount
+	res.MedianTimeToHandle = ptypes.DurationProto(handleTimePercentile(buckets, 0.5))
+	res.P95TimeToHandle = ptypes.DurationProto(handleTimePercentile(buckets, 0.95))
+
+	return res, nil
+}
@@ -0,0 +1,122 @@
+package category
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// handleTimeBounds are upper bounds of time to handle histogram buckets, the last bucket doesn't have one
+var handleTimeBounds = []time.Duration{
+	time.Minute, 5 * time.Minute, 15 * time.Minute, 30 * time.Minute,
+	time.Hour, 2 * time.Hour, 4 * time.Hour, 8 * time.Hour, 12 * time.Hour,
+	24 * time.Hour, 2 * 24 * time.Hour, 3 * 24 * time.Hour, 7 * 24 * time.Hour, 14 * 24 * time.Hour, 30 * 24 * time.Hour,
+}
+
+// HandleTimeHistogram counts reports handled by moderator by time it took to handle them.
+// Buckets has a bucket for every bound of handleTimeBounds followed by the unbounded one.
+// ModeratorUID is uuid.Nil for reports handled by unknown moderator
+type HandleTimeHistogram struct {
+	ModeratorUID uuid.UUID
+	Buckets      []int64
+}
+
+// handleTimeBucket returns index of histogram bucket of time to handle
+func handleTimeBucket(handleTime time.Duration) int {
+	for i, bound := range handleTimeBounds {
+		if handleTime < bound {
+			return i
+		}
+	}
+
+	return len(handleTimeBounds)
+}
+
+// recordHandledReport records that moderator handled report now and adds it to daily statistics of category.
+// Moderator is uuid.Nil when it isn't known who handled report
+func recordHandledReport(tx *sql.Tx, reportUID, categoryUID, moderatorUID uuid.UUID, reportedAt time.Time) error {
+	handledAt := time.Now()
+	query := `INSERT INTO handled_reports (report_uid, category_uid, moderator_uid, reported_at, handled_at)
+	          VALUES ($1, $2, $3, $4, $5)`
+	_, err := tx.Exec(query, reportUID.String(), categoryUID.String(), moderatorUID.String(), reportedAt, handledAt)
+	if err != nil {
+		return err
+	}
+
+	bucket := handleTimeBucket(handledAt.Sub(reportedAt))
+	buckets := make([]int64, len(handleTimeBounds)+1)
+	buckets[bucket] = 1
+	query = `INSERT INTO moderation_daily_stats AS s (category_uid, day, moderator_uid, handled_count, handle_buckets)
+	         VALUES ($1, $2, $3, 1, $4)
+	         ON CONFLICT (category_uid, day, moderator_uid) DO UPDATE
+	         SET handled_count=s.handled_count+1, handle_buckets[$5]=s.handle_buckets[$5]+1`
+	day := handledAt.UTC().Format("2006-01-02")
+	// Postgres arrays are indexed from 1
+	_, err = tx.Exec(query, categoryUID.String(), day, moderatorUID.String(), pq.Array(buckets), bucket+1)
+	return err
+}
+
+// getHandleTimeHistograms returns histograms of reports of category handled from one UTC day to another including both,
+// zero day means the range isn't limited from that side. Moderators are ordered by the number of handled reports
+func (db *db) getHandleTimeHistograms(categoryUID uuid.UUID, fromDay, toDay time.Time) ([]*HandleTimeHistogram, error) {
+	var from, to interface{}
+	if !fromDay.IsZero() {
+		from = fromDay.Format("2006-01-02")
+	}
+
+	if !toDay.IsZero() {
+		to = toDay.Format("2006-01-02")
+	}
+
+	query := `SELECT s.moderator_uid, b.i, sum(b.n), sum(sum(b.n)) OVER (PARTITION BY s.moderator_uid) AS total
+	          FROM moderation_daily_stats s, unnest(s.handle_buckets) WITH ORDINALITY AS b(n, i)
+	          WHERE s.category_uid=$1 AND ($2::date IS NULL OR s.day >= $2) AND ($3::date IS NULL OR s.day <= $3)
+	          GROUP BY s.moderator_uid, b.i
+	          ORDER BY total DESC, s.moderator_uid, b.i`
+	rows, err := db.Query(query, categoryUID.String(), from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*HandleTimeHistogram, 0)
+	var histogram *HandleTimeHistogram
+	for rows.Next() {
+		var moderatorUID string
+		var i, n, total int64
+		if err := rows.Scan(&moderatorUID, &i, &n, &total); err != nil {
+			return nil, err
+		}
+
+		uid, err := uuid.Parse(moderatorUID)
+		if err != nil {
+			return nil, err
+		}
+
+		if histogram == nil || histogram.ModeratorUID != uid {
+			histogram = &HandleTimeHistogram{ModeratorUID: uid, Buckets: make([]int64, len(handleTimeBounds)+1)}
+			result = append(result, histogram)
+		}
+
+		if i >= 1 && int(i) <= len(histogram.Buckets) {
+			histogram.Buckets[i-1] = n
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// getOpenReportCount returns the number of reports of category which weren't handled yet.
+// Reports anonymized by retention policy aren't waiting for moderator, so they aren't counted
+func (db *db) getOpenReportCount(categoryUID uuid.UUID) (int64, error) {
+	query := "SELECT count(*) FROM reports WHERE category_uid=$1 AND anonymized_at IS NULL"
+	var result int64
+	err := db.QueryRow(query, categoryUID.String()).Scan(&result)
+	return result, err
+}
@@ -0,0 +1,104 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+// mockdb has statistics only for private category, it was handled by moderator and unknown moderator
+func (mdb *mockdb) getHandleTimeHistograms(categoryUID uuid.UUID, fromDay, toDay time.Time) ([]*HandleTimeHistogram, error) {
+	if !fromDay.Equal(fromDay.Truncate(24*time.Hour)) || !toDay.Equal(toDay.Truncate(24*time.Hour)) {
+		return nil, errDummy
+	}
+
+	result := make([]*HandleTimeHistogram, 0)
+	if categoryUID != privateUID {
+		return result, nil
+	}
+
+	moderator := &HandleTimeHistogram{ModeratorUID: moderatorUID, Buckets: make([]int64, len(handleTimeBounds)+1)}
+	moderator.Buckets[handleTimeBucket(30*time.Second)] = 2
+	moderator.Buckets[handleTimeBucket(90*time.Minute)] = 2
+	unknown := &HandleTimeHistogram{ModeratorUID: uuid.Nil, Buckets: make([]int64, len(handleTimeBounds)+1)}
+	unknown.Buckets[handleTimeBucket(60*24*time.Hour)] = 1
+
+	return append(result, moderator, unknown), nil
+}
+
+func (mdb *mockdb) getOpenReportCount(categoryUID uuid.UUID) (int64, error) {
+	return 3, nil
+}
+
+func TestHandleTimePercentile(t *testing.T) {
+	if bucket := handleTimeBucket(time.Minute); bucket != 1 {
+		t.Errorf("unexpected bucket %d", bucket)
+	}
+
+	buckets := make([]int64, len(handleTimeBounds)+1)
+	if d := handleTimePercentile(buckets, 0.5); d != 0 {
+		t.Errorf("unexpected percentile %v of empty histogram", d)
+	}
+
+	buckets[handleTimeBucket(10*time.Second)] = 4
+	if d := handleTimePercentile(buckets, 0.5); d != 30*time.Second {
+		t.Errorf("unexpected percentile %v", d)
+	}
+
+	buckets[len(buckets)-1] = 4
+	if d := handleTimePercentile(buckets, 0.95); d != handleTimeBounds[len(handleTimeBounds)-1] {
+		t.Errorf("unexpected percentile %v", d)
+	}
+}
+
+func TestGetModerationStats(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	from, _ := ptypes.TimestampProto(time.Now().Add(-48 * time.Hour))
+	to, _ := ptypes.TimestampProto(time.Now())
+	req := &pb.GetModerationStatsRequest{CategoryUid: privateUID.String(), UserUid: ownerUID.String(), From: from, To: to}
+	res, err := s.GetModerationStats(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if res.OpenReportCount != 3 || res.HandledCount != 5 || len(res.Moderators) != 2 {
+		t.Fatalf("unexpected stats %v", res)
+	}
+
+	median, _ := ptypes.Duration(res.MedianTimeToHandle)
+	p95, _ := ptypes.Duration(res.P95TimeToHandle)
+	if median != 75*time.Minute || p95 != 30*24*time.Hour {
+		t.Errorf("unexpected times to handle %v, %v", median, p95)
+	}
+
+	moderator := res.Moderators[0]
+	moderatorMedian, _ := ptypes.Duration(moderator.MedianTimeToHandle)
+	if moderator.ModeratorUid != moderatorUID.String() || moderator.HandledCount != 4 || moderatorMedian != time.Minute {
+		t.Errorf("unexpected moderator stats %v", moderator)
+	}
+
+	if res.Moderators[1].ModeratorUid != "" || res.Moderators[1].HandledCount != 1 {
+		t.Errorf("unexpected moderator stats %v", res.Moderators[1])
+	}
+}
+
+func TestGetModerationStatsFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.GetModerationStatsRequest{CategoryUid: privateUID.String(), UserUid: memberUID.String()}
+	_, err := s.GetModerationStats(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	from, _ := ptypes.TimestampProto(time.Now())
+	to, _ := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	req = &pb.GetModerationStatsRequest{CategoryUid: privateUID.String(), UserUid: ownerUID.String(), From: from, To: to}
+	_, err = s.GetModerationStats(context.Background(), req)
+	if !hasViolations(err, "to") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package category
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// defaultReservedNames are category names nobody can create
+var defaultReservedNames = []string{
+	"about",
+	"admin",
+	"administrator",
+	"api",
+	"help",
+	"login",
+	"logout",
+	"mod",
+	"moderator",
+	"new",
+	"root",
+	"search",
+	"settings",
+	"signup",
+	"support",
+	"system",
+}
+
+// nameSeparators are dropped from canonical names, so "Go-lang" and "golang" collide
+const nameSeparators = " -_."
+
+// confusables maps characters which look like latin letters or digits to them.
+// Keep in sync with translate() call in sql/migrations/000003_category_canonical_names.up.sql
+var confusables = map[rune]rune{
+	// cyrillic
+	'а': 'a', 'в': 'b', 'е': 'e', 'к': 'k', 'м': 'm', 'н': 'h', 'о': 'o', 'р': 'p',
+	'с': 'c', 'т': 't', 'у': 'y', 'х': 'x', 'ѕ': 's', 'і': 'i', 'ј': 'j', 'ԁ': 'd',
+	// greek
+	'α': 'a', 'β': 'b', 'ε': 'e', 'ι': 'i', 'κ': 'k', 'ν': 'v', 'ο': 'o', 'ρ': 'p',
+	'τ': 't', 'υ': 'u', 'χ': 'x',
+	// digits
+	'0': 'o', '1': 'l',
+}
+
+var folder = cases.Fold()
+
+// canonicalName returns form of name used to detect duplicates:
+// compatibility normalized, case folded, with confusable characters replaced and separators removed
+func canonicalName(name string) string {
+	name = folder.String(norm.NFKC.String(name))
+
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(nameSeparators, r) {
+			return -1
+		}
+
+		if c, ok := confusables[r]; ok {
+			return c
+		}
+
+		return r
+	}, name)
+}
+
+// reservedNames is a set of canonical forms of reserved category names
+type reservedNames map[string]bool
+
+func newReservedNames(names []string) reservedNames {
+	result := make(reservedNames)
+	for _, name := range names {
+		if canonical := canonicalName(name); canonical != "" {
+			result[canonical] = true
+		}
+	}
+
+	return result
+}
+
+func (r reservedNames) contains(canonical string) bool {
+	return r[canonical]
+}
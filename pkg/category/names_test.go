@@ -0,0 +1,39 @@
+package category
+
+import "testing"
+
+func TestCanonicalName(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{"Golang", "golang"},
+		{"Go-Lang", "golang"},
+		{"Gоlang", "golang"}, // cyrillic о
+		{"ＧＯＬＡＮＧ", "golang"},
+		{"G0lang", "golang"},
+		{"Straße", "strasse"},
+	}
+
+	for _, tt := range tests {
+		if canonicalName(tt.a) != canonicalName(tt.b) {
+			t.Errorf("expected %q and %q to collide, got %q and %q", tt.a, tt.b, canonicalName(tt.a), canonicalName(tt.b))
+		}
+	}
+
+	if canonicalName("C++") == canonicalName("C") {
+		t.Errorf("expected C++ and C to be different")
+	}
+}
+
+func TestReservedNames(t *testing.T) {
+	reserved := newReservedNames([]string{"Admin", " help "})
+	for _, name := range []string{"admin", "ADMIN", "аdmin", "help"} {
+		if !reserved.contains(canonicalName(name)) {
+			t.Errorf("expected %q to be reserved", name)
+		}
+	}
+
+	if reserved.contains(canonicalName("golang")) {
+		t.Errorf("expected golang not to be reserved")
+	}
+}
@@ -6,6 +6,7 @@ package category
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
 import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 
 import (
@@ -24,9 +25,372 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type Visibility int32
+
+const (
+	Visibility_PUBLIC     Visibility = 0
+	Visibility_RESTRICTED Visibility = 1
+	Visibility_PRIVATE    Visibility = 2
+)
+
+var Visibility_name = map[int32]string{
+	0: "PUBLIC",
+	1: "RESTRICTED",
+	2: "PRIVATE",
+}
+
+var Visibility_value = map[string]int32{
+	"PUBLIC":     0,
+	"RESTRICTED": 1,
+	"PRIVATE":    2,
+}
+
+func (x Visibility) String() string {
+	return proto.EnumName(Visibility_name, int32(x))
+}
+
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{0}
+}
+
+type JoinRequestStatus int32
+
+const (
+	JoinRequestStatus_PENDING  JoinRequestStatus = 0
+	JoinRequestStatus_APPROVED JoinRequestStatus = 1
+	JoinRequestStatus_DENIED   JoinRequestStatus = 2
+)
+
+var JoinRequestStatus_name = map[int32]string{
+	0: "PENDING",
+	1: "APPROVED",
+	2: "DENIED",
+}
+
+var JoinRequestStatus_value = map[string]int32{
+	"PENDING":  0,
+	"APPROVED": 1,
+	"DENIED":   2,
+}
+
+func (x JoinRequestStatus) String() string {
+	return proto.EnumName(JoinRequestStatus_name, int32(x))
+}
+
+func (JoinRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{1}
+}
+
+type ReasonCode int32
+
+const (
+	ReasonCode_OTHER           ReasonCode = 0
+	ReasonCode_SPAM            ReasonCode = 1
+	ReasonCode_OFF_TOPIC       ReasonCode = 2
+	ReasonCode_MISINFORMATION  ReasonCode = 3
+	ReasonCode_HARASSMENT      ReasonCode = 4
+	ReasonCode_HATE_SPEECH     ReasonCode = 5
+	ReasonCode_VIOLENCE        ReasonCode = 6
+	ReasonCode_SEXUAL_CONTENT  ReasonCode = 7
+	ReasonCode_SELF_HARM       ReasonCode = 8
+	ReasonCode_ILLEGAL_CONTENT ReasonCode = 9
+)
+
+var ReasonCode_name = map[int32]string{
+	0: "OTHER",
+	1: "SPAM",
+	2: "OFF_TOPIC",
+	3: "MISINFORMATION",
+	4: "HARASSMENT",
+	5: "HATE_SPEECH",
+	6: "VIOLENCE",
+	7: "SEXUAL_CONTENT",
+	8: "SELF_HARM",
+	9: "ILLEGAL_CONTENT",
+}
+
+var ReasonCode_value = map[string]int32{
+	"OTHER":           0,
+	"SPAM":            1,
+	"OFF_TOPIC":       2,
+	"MISINFORMATION":  3,
+	"HARASSMENT":      4,
+	"HATE_SPEECH":     5,
+	"VIOLENCE":        6,
+	"SEXUAL_CONTENT":  7,
+	"SELF_HARM":       8,
+	"ILLEGAL_CONTENT": 9,
+}
+
+func (x ReasonCode) String() string {
+	return proto.EnumName(ReasonCode_name, int32(x))
+}
+
+func (ReasonCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{2}
+}
+
+type Severity int32
+
+const (
+	Severity_LOW      Severity = 0
+	Severity_MEDIUM   Severity = 1
+	Severity_HIGH     Severity = 2
+	Severity_CRITICAL Severity = 3
+)
+
+var Severity_name = map[int32]string{
+	0: "LOW",
+	1: "MEDIUM",
+	2: "HIGH",
+	3: "CRITICAL",
+}
+
+var Severity_value = map[string]int32{
+	"LOW":      0,
+	"MEDIUM":   1,
+	"HIGH":     2,
+	"CRITICAL": 3,
+}
+
+func (x Severity) String() string {
+	return proto.EnumName(Severity_name, int32(x))
+}
+
+func (Severity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{3}
+}
+
+type Routing int32
+
+const (
+	Routing_CATEGORY_OWNER Routing = 0
+	Routing_ADMINS         Routing = 1
+)
+
+var Routing_name = map[int32]string{
+	0: "CATEGORY_OWNER",
+	1: "ADMINS",
+}
+
+var Routing_value = map[string]int32{
+	"CATEGORY_OWNER": 0,
+	"ADMINS":         1,
+}
+
+func (x Routing) String() string {
+	return proto.EnumName(Routing_name, int32(x))
+}
+
+func (Routing) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{4}
+}
+
+type ReportOrder int32
+
+const (
+	ReportOrder_NEWEST ReportOrder = 0
+	ReportOrder_OLDEST ReportOrder = 1
+	// reports of posts and comments with the most reports first. Reports are counted over all time and categories,
+	// not only over reports selected by filter of the request
+	ReportOrder_MOST_REPORTED ReportOrder = 2
+)
+
+var ReportOrder_name = map[int32]string{
+	0: "NEWEST",
+	1: "OLDEST",
+	2: "MOST_REPORTED",
+}
+
+var ReportOrder_value = map[string]int32{
+	"NEWEST":        0,
+	"OLDEST":        1,
+	"MOST_REPORTED": 2,
+}
+
+func (x ReportOrder) String() string {
+	return proto.EnumName(ReportOrder_name, int32(x))
+}
+
+func (ReportOrder) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{5}
+}
+
+type AssignmentStrategy int32
+
+const (
+	AssignmentStrategy_MANUAL       AssignmentStrategy = 0
+	AssignmentStrategy_ROUND_ROBIN  AssignmentStrategy = 1
+	AssignmentStrategy_LEAST_LOADED AssignmentStrategy = 2
+	AssignmentStrategy_KEYWORD      AssignmentStrategy = 3
+)
+
+var AssignmentStrategy_name = map[int32]string{
+	0: "MANUAL",
+	1: "ROUND_ROBIN",
+	2: "LEAST_LOADED",
+	3: "KEYWORD",
+}
+
+var AssignmentStrategy_value = map[string]int32{
+	"MANUAL":       0,
+	"ROUND_ROBIN":  1,
+	"LEAST_LOADED": 2,
+	"KEYWORD":      3,
+}
+
+func (x AssignmentStrategy) String() string {
+	return proto.EnumName(AssignmentStrategy_name, int32(x))
+}
+
+func (AssignmentStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{6}
+}
+
+type Outcome int32
+
+const (
+	Outcome_NO_VIOLATION    Outcome = 0
+	Outcome_CONTENT_REMOVED Outcome = 1
+	Outcome_USER_WARNED     Outcome = 2
+	Outcome_USER_BANNED     Outcome = 3
+	Outcome_ESCALATED       Outcome = 4
+)
+
+var Outcome_name = map[int32]string{
+	0: "NO_VIOLATION",
+	1: "CONTENT_REMOVED",
+	2: "USER_WARNED",
+	3: "USER_BANNED",
+	4: "ESCALATED",
+}
+
+var Outcome_value = map[string]int32{
+	"NO_VIOLATION":    0,
+	"CONTENT_REMOVED": 1,
+	"USER_WARNED":     2,
+	"USER_BANNED":     3,
+	"ESCALATED":       4,
+}
+
+func (x Outcome) String() string {
+	return proto.EnumName(Outcome_name, int32(x))
+}
+
+func (Outcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{7}
+}
+
+type BulkReportStatus int32
+
+const (
+	BulkReportStatus_DELETED      BulkReportStatus = 0
+	BulkReportStatus_NOT_FOUND    BulkReportStatus = 1
+	BulkReportStatus_WOULD_DELETE BulkReportStatus = 2
+)
+
+var BulkReportStatus_name = map[int32]string{
+	0: "DELETED",
+	1: "NOT_FOUND",
+	2: "WOULD_DELETE",
+}
+
+var BulkReportStatus_value = map[string]int32{
+	"DELETED":      0,
+	"NOT_FOUND":    1,
+	"WOULD_DELETE": 2,
+}
+
+func (x BulkReportStatus) String() string {
+	return proto.EnumName(BulkReportStatus_name, int32(x))
+}
+
+func (BulkReportStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{8}
+}
+
+type RetentionAction int32
+
+const (
+	RetentionAction_PURGE     RetentionAction = 0
+	RetentionAction_ANONYMIZE RetentionAction = 1
+	RetentionAction_KEEP      RetentionAction = 2
+)
+
+var RetentionAction_name = map[int32]string{
+	0: "PURGE",
+	1: "ANONYMIZE",
+	2: "KEEP",
+}
+
+var RetentionAction_value = map[string]int32{
+	"PURGE":     0,
+	"ANONYMIZE": 1,
+	"KEEP":      2,
+}
+
+func (x RetentionAction) String() string {
+	return proto.EnumName(RetentionAction_name, int32(x))
+}
+
+func (RetentionAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{9}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_CSV   ExportFormat = 0
+	ExportFormat_JSONL ExportFormat = 1
+)
+
+var ExportFormat_name = map[int32]string{
+	0: "CSV",
+	1: "JSONL",
+}
+
+var ExportFormat_value = map[string]int32{
+	"CSV":   0,
+	"JSONL": 1,
+}
+
+func (x ExportFormat) String() string {
+	return proto.EnumName(ExportFormat_name, int32(x))
+}
+
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{10}
+}
+
+type ReportEventType int32
+
+const (
+	ReportEventType_REPORT_CREATED ReportEventType = 0
+	ReportEventType_REPORT_DELETED ReportEventType = 1
+)
+
+var ReportEventType_name = map[int32]string{
+	0: "REPORT_CREATED",
+	1: "REPORT_DELETED",
+}
+
+var ReportEventType_value = map[string]int32{
+	"REPORT_CREATED": 0,
+	"REPORT_DELETED": 1,
+}
+
+func (x ReportEventType) String() string {
+	return proto.EnumName(ReportEventType_name, int32(x))
+}
+
+func (ReportEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{11}
+}
+
 type ListCategoriesRequest struct {
 	PageSize             int32    `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
 	PageNumber           int32    `protobuf:"varint,2,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	UserUid              string   `protobuf:"bytes,3,opt,name=userUid,proto3" json:"userUid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -36,7 +400,7 @@ func (m *ListCategoriesRequest) Reset()         { *m = ListCategoriesRequest{} }
 func (m *ListCategoriesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCategoriesRequest) ProtoMessage()    {}
 func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_category_63066037b18693f0, []int{0}
+	return fileDescriptor_category_be8b86b54344ed0a, []int{0}
 }
 func (m *ListCategoriesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListCategoriesRequest.Unmarshal(m, b)
@@ -70,6 +434,13 @@ func (m *ListCategoriesRequest) GetPageNumber() int32 {
 	return 0
 }
 
+func (m *ListCategoriesRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
 type ListCategoriesResponse struct {
 	Categories           []*SingleCategory `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
 	PageSize             int32             `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
@@ -83,7 +454,7 @@ func (m *ListCategoriesResponse) Reset()         { *m = ListCategoriesResponse{}
 func (m *ListCategoriesResponse) String() string { return proto.CompactTextString(m) }
 func (*ListCategoriesResponse) ProtoMessage()    {}
 func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_category_63066037b18693f0, []int{1}
+	return fileDescriptor_category_be8b86b54344ed0a, []int{1}
 }
 func (m *ListCategoriesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListCategoriesResponse.Unmarshal(m, b)
@@ -125,20 +496,25 @@ func (m *ListCategoriesResponse) GetPageNumber() int32 {
 }
 
 type SingleCategory struct {
-	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Uid                  string     `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	UserUid              string     `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	Name                 string     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Language             string     `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	Slug                 string     `protobuf:"bytes,6,opt,name=slug,proto3" json:"slug,omitempty"`
+	ParentUid            string     `protobuf:"bytes,7,opt,name=parentUid,proto3" json:"parentUid,omitempty"`
+	SubscriberCount      int64      `protobuf:"varint,8,opt,name=subscriberCount,proto3" json:"subscriberCount,omitempty"`
+	Visibility           Visibility `protobuf:"varint,9,opt,name=visibility,proto3,enum=category.Visibility" json:"visibility,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *SingleCategory) Reset()         { *m = SingleCategory{} }
 func (m *SingleCategory) String() string { return proto.CompactTextString(m) }
 func (*SingleCategory) ProtoMessage()    {}
 func (*SingleCategory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_category_63066037b18693f0, []int{2}
+	return fileDescriptor_category_be8b86b54344ed0a, []int{2}
 }
 func (m *SingleCategory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SingleCategory.Unmarshal(m, b)
@@ -186,20 +562,58 @@ func (m *SingleCategory) GetDescription() string {
 	return ""
 }
 
+func (m *SingleCategory) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *SingleCategory) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *SingleCategory) GetParentUid() string {
+	if m != nil {
+		return m.ParentUid
+	}
+	return ""
+}
+
+func (m *SingleCategory) GetSubscriberCount() int64 {
+	if m != nil {
+		return m.SubscriberCount
+	}
+	return 0
+}
+
+func (m *SingleCategory) GetVisibility() Visibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return Visibility_PUBLIC
+}
+
 type CreateCategoryRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	UserUid              string   `protobuf:"bytes,3,opt,name=userUid,proto3" json:"userUid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name                 string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	UserUid              string     `protobuf:"bytes,3,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	Language             string     `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	ParentUid            string     `protobuf:"bytes,5,opt,name=parentUid,proto3" json:"parentUid,omitempty"`
+	Visibility           Visibility `protobuf:"varint,6,opt,name=visibility,proto3,enum=category.Visibility" json:"visibility,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *CreateCategoryRequest) Reset()         { *m = CreateCategoryRequest{} }
 func (m *CreateCategoryRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateCategoryRequest) ProtoMessage()    {}
 func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_category_63066037b18693f0, []int{3}
+	return fileDescriptor_category_be8b86b54344ed0a, []int{3}
 }
 func (m *CreateCategoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateCategoryRequest.Unmarshal(m, b)
@@ -240,574 +654,10112 @@ func (m *CreateCategoryRequest) GetUserUid() string {
 	return ""
 }
 
-type GetCategoryInfoRequest struct {
-	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreateCategoryRequest) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
 }
 
-func (m *GetCategoryInfoRequest) Reset()         { *m = GetCategoryInfoRequest{} }
-func (m *GetCategoryInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCategoryInfoRequest) ProtoMessage()    {}
-func (*GetCategoryInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_category_63066037b18693f0, []int{4}
-}
-func (m *GetCategoryInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCategoryInfoRequest.Unmarshal(m, b)
-}
-func (m *GetCategoryInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCategoryInfoRequest.Marshal(b, m, deterministic)
-}
-func (dst *GetCategoryInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCategoryInfoRequest.Merge(dst, src)
-}
-func (m *GetCategoryInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCategoryInfoRequest.Size(m)
-}
-func (m *GetCategoryInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCategoryInfoRequest.DiscardUnknown(m)
+func (m *CreateCategoryRequest) GetParentUid() string {
+	if m != nil {
+		return m.ParentUid
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetCategoryInfoRequest proto.InternalMessageInfo
-
-func (m *GetCategoryInfoRequest) GetUid() string {
+func (m *CreateCategoryRequest) GetVisibility() Visibility {
 	if m != nil {
-		return m.Uid
+		return m.Visibility
 	}
-	return ""
+	return Visibility_PUBLIC
 }
 
-type ListReportsRequest struct {
-	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+type ListChildCategoriesRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
 	PageNumber           int32    `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	UserUid              string   `protobuf:"bytes,4,opt,name=userUid,proto3" json:"userUid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListReportsRequest) Reset()         { *m = ListReportsRequest{} }
-func (m *ListReportsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListReportsRequest) ProtoMessage()    {}
-func (*ListReportsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_category_63066037b18693f0, []int{5}
+func (m *ListChildCategoriesRequest) Reset()         { *m = ListChildCategoriesRequest{} }
+func (m *ListChildCategoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChildCategoriesRequest) ProtoMessage()    {}
+func (*ListChildCategoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{4}
 }
-func (m *ListReportsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListReportsRequest.Unmarshal(m, b)
+func (m *ListChildCategoriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChildCategoriesRequest.Unmarshal(m, b)
 }
-func (m *ListReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListReportsRequest.Marshal(b, m, deterministic)
+func (m *ListChildCategoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChildCategoriesRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListReportsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListReportsRequest.Merge(dst, src)
+func (dst *ListChildCategoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChildCategoriesRequest.Merge(dst, src)
 }
-func (m *ListReportsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListReportsRequest.Size(m)
+func (m *ListChildCategoriesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListChildCategoriesRequest.Size(m)
 }
-func (m *ListReportsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListReportsRequest.DiscardUnknown(m)
+func (m *ListChildCategoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChildCategoriesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListReportsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListChildCategoriesRequest proto.InternalMessageInfo
 
-func (m *ListReportsRequest) GetCategoryUid() string {
+func (m *ListChildCategoriesRequest) GetUid() string {
 	if m != nil {
-		return m.CategoryUid
+		return m.Uid
 	}
 	return ""
 }
 
-func (m *ListReportsRequest) GetPageSize() int32 {
+func (m *ListChildCategoriesRequest) GetPageSize() int32 {
 	if m != nil {
 		return m.PageSize
 	}
 	return 0
 }
 
-func (m *ListReportsRequest) GetPageNumber() int32 {
+func (m *ListChildCategoriesRequest) GetPageNumber() int32 {
 	if m != nil {
 		return m.PageNumber
 	}
 	return 0
 }
 
-type ListReportsResponse struct {
-	Reports              []*SingleReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
-	PageSize             int32           `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
-	PageNumber           int32           `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *ListChildCategoriesRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
 }
 
-func (m *ListReportsResponse) Reset()         { *m = ListReportsResponse{} }
-func (m *ListReportsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListReportsResponse) ProtoMessage()    {}
-func (*ListReportsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_category_63066037b18693f0, []int{6}
+type GetCategoryAncestorsRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ListReportsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListReportsResponse.Unmarshal(m, b)
+
+func (m *GetCategoryAncestorsRequest) Reset()         { *m = GetCategoryAncestorsRequest{} }
+func (m *GetCategoryAncestorsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCategoryAncestorsRequest) ProtoMessage()    {}
+func (*GetCategoryAncestorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{5}
 }
-func (m *ListReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListReportsResponse.Marshal(b, m, deterministic)
+func (m *GetCategoryAncestorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCategoryAncestorsRequest.Unmarshal(m, b)
 }
-func (dst *ListReportsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListReportsResponse.Merge(dst, src)
+func (m *GetCategoryAncestorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCategoryAncestorsRequest.Marshal(b, m, deterministic)
 }
-func (m *ListReportsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListReportsResponse.Size(m)
+func (dst *GetCategoryAncestorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCategoryAncestorsRequest.Merge(dst, src)
 }
-func (m *ListReportsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListReportsResponse.DiscardUnknown(m)
+func (m *GetCategoryAncestorsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCategoryAncestorsRequest.Size(m)
+}
+func (m *GetCategoryAncestorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCategoryAncestorsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListReportsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetCategoryAncestorsRequest proto.InternalMessageInfo
 
-func (m *ListReportsResponse) GetReports() []*SingleReport {
+func (m *GetCategoryAncestorsRequest) GetUid() string {
 	if m != nil {
-		return m.Reports
+		return m.Uid
 	}
-	return nil
+	return ""
 }
 
-func (m *ListReportsResponse) GetPageSize() int32 {
+func (m *GetCategoryAncestorsRequest) GetUserUid() string {
 	if m != nil {
-		return m.PageSize
+		return m.UserUid
 	}
-	return 0
+	return ""
 }
 
-func (m *ListReportsResponse) GetPageNumber() int32 {
+type GetCategoryAncestorsResponse struct {
+	Categories           []*SingleCategory `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCategoryAncestorsResponse) Reset()         { *m = GetCategoryAncestorsResponse{} }
+func (m *GetCategoryAncestorsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCategoryAncestorsResponse) ProtoMessage()    {}
+func (*GetCategoryAncestorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{6}
+}
+func (m *GetCategoryAncestorsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCategoryAncestorsResponse.Unmarshal(m, b)
+}
+func (m *GetCategoryAncestorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCategoryAncestorsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetCategoryAncestorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCategoryAncestorsResponse.Merge(dst, src)
+}
+func (m *GetCategoryAncestorsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCategoryAncestorsResponse.Size(m)
+}
+func (m *GetCategoryAncestorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCategoryAncestorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCategoryAncestorsResponse proto.InternalMessageInfo
+
+func (m *GetCategoryAncestorsResponse) GetCategories() []*SingleCategory {
 	if m != nil {
-		return m.PageNumber
+		return m.Categories
 	}
-	return 0
+	return nil
 }
 
-type CreateReportRequest struct {
-	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
-	PostUid              string   `protobuf:"bytes,2,opt,name=postUid,proto3" json:"postUid,omitempty"`
-	CommentUid           string   `protobuf:"bytes,3,opt,name=commentUid,proto3" json:"commentUid,omitempty"`
-	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+type MoveCategoryRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ParentUid            string   `protobuf:"bytes,2,opt,name=parentUid,proto3" json:"parentUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,3,opt,name=userUid,proto3" json:"userUid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateReportRequest) Reset()         { *m = CreateReportRequest{} }
-func (m *CreateReportRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateReportRequest) ProtoMessage()    {}
-func (*CreateReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_category_63066037b18693f0, []int{7}
+func (m *MoveCategoryRequest) Reset()         { *m = MoveCategoryRequest{} }
+func (m *MoveCategoryRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveCategoryRequest) ProtoMessage()    {}
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{7}
 }
-func (m *CreateReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateReportRequest.Unmarshal(m, b)
+func (m *MoveCategoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveCategoryRequest.Unmarshal(m, b)
 }
-func (m *CreateReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateReportRequest.Marshal(b, m, deterministic)
+func (m *MoveCategoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveCategoryRequest.Marshal(b, m, deterministic)
 }
-func (dst *CreateReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateReportRequest.Merge(dst, src)
+func (dst *MoveCategoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveCategoryRequest.Merge(dst, src)
 }
-func (m *CreateReportRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateReportRequest.Size(m)
+func (m *MoveCategoryRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveCategoryRequest.Size(m)
 }
-func (m *CreateReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateReportRequest.DiscardUnknown(m)
+func (m *MoveCategoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveCategoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateReportRequest proto.InternalMessageInfo
-
-func (m *CreateReportRequest) GetCategoryUid() string {
-	if m != nil {
-		return m.CategoryUid
-	}
-	return ""
-}
+var xxx_messageInfo_MoveCategoryRequest proto.InternalMessageInfo
 
-func (m *CreateReportRequest) GetPostUid() string {
+func (m *MoveCategoryRequest) GetUid() string {
 	if m != nil {
-		return m.PostUid
+		return m.Uid
 	}
 	return ""
 }
 
-func (m *CreateReportRequest) GetCommentUid() string {
+func (m *MoveCategoryRequest) GetParentUid() string {
 	if m != nil {
-		return m.CommentUid
+		return m.ParentUid
 	}
 	return ""
 }
 
-func (m *CreateReportRequest) GetReason() string {
+func (m *MoveCategoryRequest) GetUserUid() string {
 	if m != nil {
-		return m.Reason
+		return m.UserUid
 	}
 	return ""
 }
 
-type SingleReport struct {
-	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	CategoryUid          string               `protobuf:"bytes,2,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
-	PostUid              string               `protobuf:"bytes,3,opt,name=postUid,proto3" json:"postUid,omitempty"`
-	CommentUid           string               `protobuf:"bytes,4,opt,name=commentUid,proto3" json:"commentUid,omitempty"`
-	Reason               string               `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type SetCategoryVisibilityRequest struct {
+	Uid                  string     `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	UserUid              string     `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	Visibility           Visibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=category.Visibility" json:"visibility,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *SingleReport) Reset()         { *m = SingleReport{} }
-func (m *SingleReport) String() string { return proto.CompactTextString(m) }
-func (*SingleReport) ProtoMessage()    {}
-func (*SingleReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_category_63066037b18693f0, []int{8}
+func (m *SetCategoryVisibilityRequest) Reset()         { *m = SetCategoryVisibilityRequest{} }
+func (m *SetCategoryVisibilityRequest) String() string { return proto.CompactTextString(m) }
+func (*SetCategoryVisibilityRequest) ProtoMessage()    {}
+func (*SetCategoryVisibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{8}
 }
-func (m *SingleReport) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SingleReport.Unmarshal(m, b)
+func (m *SetCategoryVisibilityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetCategoryVisibilityRequest.Unmarshal(m, b)
 }
-func (m *SingleReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SingleReport.Marshal(b, m, deterministic)
+func (m *SetCategoryVisibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetCategoryVisibilityRequest.Marshal(b, m, deterministic)
 }
-func (dst *SingleReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SingleReport.Merge(dst, src)
+func (dst *SetCategoryVisibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCategoryVisibilityRequest.Merge(dst, src)
 }
-func (m *SingleReport) XXX_Size() int {
-	return xxx_messageInfo_SingleReport.Size(m)
+func (m *SetCategoryVisibilityRequest) XXX_Size() int {
+	return xxx_messageInfo_SetCategoryVisibilityRequest.Size(m)
 }
-func (m *SingleReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_SingleReport.DiscardUnknown(m)
+func (m *SetCategoryVisibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCategoryVisibilityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SingleReport proto.InternalMessageInfo
+var xxx_messageInfo_SetCategoryVisibilityRequest proto.InternalMessageInfo
 
-func (m *SingleReport) GetUid() string {
+func (m *SetCategoryVisibilityRequest) GetUid() string {
 	if m != nil {
 		return m.Uid
 	}
 	return ""
 }
 
-func (m *SingleReport) GetCategoryUid() string {
+func (m *SetCategoryVisibilityRequest) GetUserUid() string {
 	if m != nil {
-		return m.CategoryUid
+		return m.UserUid
 	}
 	return ""
 }
 
-func (m *SingleReport) GetPostUid() string {
+func (m *SetCategoryVisibilityRequest) GetVisibility() Visibility {
 	if m != nil {
-		return m.PostUid
+		return m.Visibility
 	}
-	return ""
+	return Visibility_PUBLIC
 }
 
-func (m *SingleReport) GetCommentUid() string {
+type SubscribeRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{9}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(dst, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetCategoryUid() string {
 	if m != nil {
-		return m.CommentUid
+		return m.CategoryUid
 	}
 	return ""
 }
 
-func (m *SingleReport) GetReason() string {
+func (m *SubscribeRequest) GetUserUid() string {
 	if m != nil {
-		return m.Reason
+		return m.UserUid
 	}
 	return ""
 }
 
-func (m *SingleReport) GetCreatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.CreatedAt
-	}
-	return nil
+type SubscribeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type DeleteReportRequest struct {
-	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
+func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeResponse) ProtoMessage()    {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{10}
+}
+func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeResponse.Unmarshal(m, b)
+}
+func (m *SubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeResponse.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeResponse.Merge(dst, src)
+}
+func (m *SubscribeResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeResponse.Size(m)
+}
+func (m *SubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeResponse proto.InternalMessageInfo
+
+type UnsubscribeRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteReportRequest) Reset()         { *m = DeleteReportRequest{} }
-func (m *DeleteReportRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteReportRequest) ProtoMessage()    {}
-func (*DeleteReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_category_63066037b18693f0, []int{9}
+func (m *UnsubscribeRequest) Reset()         { *m = UnsubscribeRequest{} }
+func (m *UnsubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsubscribeRequest) ProtoMessage()    {}
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{11}
 }
-func (m *DeleteReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteReportRequest.Unmarshal(m, b)
+func (m *UnsubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeRequest.Unmarshal(m, b)
 }
-func (m *DeleteReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteReportRequest.Marshal(b, m, deterministic)
+func (m *UnsubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeRequest.Marshal(b, m, deterministic)
 }
-func (dst *DeleteReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteReportRequest.Merge(dst, src)
+func (dst *UnsubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeRequest.Merge(dst, src)
 }
-func (m *DeleteReportRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteReportRequest.Size(m)
+func (m *UnsubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeRequest.Size(m)
 }
-func (m *DeleteReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteReportRequest.DiscardUnknown(m)
+func (m *UnsubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnsubscribeRequest proto.InternalMessageInfo
 
-func (m *DeleteReportRequest) GetUid() string {
+func (m *UnsubscribeRequest) GetCategoryUid() string {
 	if m != nil {
-		return m.Uid
+		return m.CategoryUid
 	}
 	return ""
 }
 
-type DeleteReportResponse struct {
+func (m *UnsubscribeRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type UnsubscribeResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteReportResponse) Reset()         { *m = DeleteReportResponse{} }
-func (m *DeleteReportResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteReportResponse) ProtoMessage()    {}
-func (*DeleteReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_category_63066037b18693f0, []int{10}
+func (m *UnsubscribeResponse) Reset()         { *m = UnsubscribeResponse{} }
+func (m *UnsubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsubscribeResponse) ProtoMessage()    {}
+func (*UnsubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{12}
 }
-func (m *DeleteReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteReportResponse.Unmarshal(m, b)
+func (m *UnsubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeResponse.Unmarshal(m, b)
 }
-func (m *DeleteReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteReportResponse.Marshal(b, m, deterministic)
+func (m *UnsubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeResponse.Marshal(b, m, deterministic)
 }
-func (dst *DeleteReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteReportResponse.Merge(dst, src)
+func (dst *UnsubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeResponse.Merge(dst, src)
 }
-func (m *DeleteReportResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteReportResponse.Size(m)
+func (m *UnsubscribeResponse) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeResponse.Size(m)
 }
-func (m *DeleteReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteReportResponse.DiscardUnknown(m)
+func (m *UnsubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteReportResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnsubscribeResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*ListCategoriesRequest)(nil), "category.ListCategoriesRequest")
-	proto.RegisterType((*ListCategoriesResponse)(nil), "category.ListCategoriesResponse")
-	proto.RegisterType((*SingleCategory)(nil), "category.SingleCategory")
-	proto.RegisterType((*CreateCategoryRequest)(nil), "category.CreateCategoryRequest")
-	proto.RegisterType((*GetCategoryInfoRequest)(nil), "category.GetCategoryInfoRequest")
-	proto.RegisterType((*ListReportsRequest)(nil), "category.ListReportsRequest")
-	proto.RegisterType((*ListReportsResponse)(nil), "category.ListReportsResponse")
-	proto.RegisterType((*CreateReportRequest)(nil), "category.CreateReportRequest")
-	proto.RegisterType((*SingleReport)(nil), "category.SingleReport")
-	proto.RegisterType((*DeleteReportRequest)(nil), "category.DeleteReportRequest")
-	proto.RegisterType((*DeleteReportResponse)(nil), "category.DeleteReportResponse")
+type ListSubscribersRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	UserUid              string   `protobuf:"bytes,4,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ListSubscribersRequest) Reset()         { *m = ListSubscribersRequest{} }
+func (m *ListSubscribersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSubscribersRequest) ProtoMessage()    {}
+func (*ListSubscribersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{13}
+}
+func (m *ListSubscribersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscribersRequest.Unmarshal(m, b)
+}
+func (m *ListSubscribersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscribersRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListSubscribersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscribersRequest.Merge(dst, src)
+}
+func (m *ListSubscribersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSubscribersRequest.Size(m)
+}
+func (m *ListSubscribersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscribersRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ListSubscribersRequest proto.InternalMessageInfo
 
-// CategoryClient is the client API for Category service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type CategoryClient interface {
-	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
-	GetCategoryInfo(ctx context.Context, in *GetCategoryInfoRequest, opts ...grpc.CallOption) (*SingleCategory, error)
-	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*SingleCategory, error)
-	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
-	CreateReport(ctx context.Context, in *CreateReportRequest, opts ...grpc.CallOption) (*SingleReport, error)
-	DeleteReport(ctx context.Context, in *DeleteReportRequest, opts ...grpc.CallOption) (*DeleteReportResponse, error)
+func (m *ListSubscribersRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
 }
 
-type categoryClient struct {
-	cc *grpc.ClientConn
+func (m *ListSubscribersRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
 
-func NewCategoryClient(cc *grpc.ClientConn) CategoryClient {
-	return &categoryClient{cc}
+func (m *ListSubscribersRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
 }
 
-func (c *categoryClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
-	out := new(ListCategoriesResponse)
-	err := c.cc.Invoke(ctx, "/category.Category/ListCategories", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListSubscribersRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
 	}
-	return out, nil
+	return ""
 }
 
-func (c *categoryClient) GetCategoryInfo(ctx context.Context, in *GetCategoryInfoRequest, opts ...grpc.CallOption) (*SingleCategory, error) {
-	out := new(SingleCategory)
-	err := c.cc.Invoke(ctx, "/category.Category/GetCategoryInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+type SingleSubscription struct {
+	CategoryUid          string               `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string               `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleSubscription) Reset()         { *m = SingleSubscription{} }
+func (m *SingleSubscription) String() string { return proto.CompactTextString(m) }
+func (*SingleSubscription) ProtoMessage()    {}
+func (*SingleSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{14}
+}
+func (m *SingleSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleSubscription.Unmarshal(m, b)
+}
+func (m *SingleSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleSubscription.Marshal(b, m, deterministic)
+}
+func (dst *SingleSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleSubscription.Merge(dst, src)
+}
+func (m *SingleSubscription) XXX_Size() int {
+	return xxx_messageInfo_SingleSubscription.Size(m)
+}
+func (m *SingleSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleSubscription proto.InternalMessageInfo
+
+func (m *SingleSubscription) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleSubscription) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *SingleSubscription) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ListSubscribersResponse struct {
+	Subscriptions        []*SingleSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	PageSize             int32                 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32                 `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListSubscribersResponse) Reset()         { *m = ListSubscribersResponse{} }
+func (m *ListSubscribersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSubscribersResponse) ProtoMessage()    {}
+func (*ListSubscribersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{15}
+}
+func (m *ListSubscribersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscribersResponse.Unmarshal(m, b)
+}
+func (m *ListSubscribersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscribersResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListSubscribersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscribersResponse.Merge(dst, src)
+}
+func (m *ListSubscribersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSubscribersResponse.Size(m)
+}
+func (m *ListSubscribersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscribersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubscribersResponse proto.InternalMessageInfo
+
+func (m *ListSubscribersResponse) GetSubscriptions() []*SingleSubscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *ListSubscribersResponse) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListSubscribersResponse) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type ListSubscriptionsRequest struct {
+	UserUid              string   `protobuf:"bytes,1,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	ViewerUid            string   `protobuf:"bytes,4,opt,name=viewerUid,proto3" json:"viewerUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSubscriptionsRequest) Reset()         { *m = ListSubscriptionsRequest{} }
+func (m *ListSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSubscriptionsRequest) ProtoMessage()    {}
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{16}
+}
+func (m *ListSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscriptionsRequest.Unmarshal(m, b)
+}
+func (m *ListSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscriptionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscriptionsRequest.Merge(dst, src)
+}
+func (m *ListSubscriptionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSubscriptionsRequest.Size(m)
+}
+func (m *ListSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubscriptionsRequest proto.InternalMessageInfo
+
+func (m *ListSubscriptionsRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *ListSubscriptionsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListSubscriptionsRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+func (m *ListSubscriptionsRequest) GetViewerUid() string {
+	if m != nil {
+		return m.ViewerUid
+	}
+	return ""
+}
+
+type CreateJoinRequestRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateJoinRequestRequest) Reset()         { *m = CreateJoinRequestRequest{} }
+func (m *CreateJoinRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateJoinRequestRequest) ProtoMessage()    {}
+func (*CreateJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{17}
+}
+func (m *CreateJoinRequestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateJoinRequestRequest.Unmarshal(m, b)
+}
+func (m *CreateJoinRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateJoinRequestRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateJoinRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateJoinRequestRequest.Merge(dst, src)
+}
+func (m *CreateJoinRequestRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateJoinRequestRequest.Size(m)
+}
+func (m *CreateJoinRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateJoinRequestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateJoinRequestRequest proto.InternalMessageInfo
+
+func (m *CreateJoinRequestRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *CreateJoinRequestRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type SingleJoinRequest struct {
+	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CategoryUid          string               `protobuf:"bytes,2,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string               `protobuf:"bytes,3,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	Status               JoinRequestStatus    `protobuf:"varint,4,opt,name=status,proto3,enum=category.JoinRequestStatus" json:"status,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	DecidedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=decidedAt,proto3" json:"decidedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleJoinRequest) Reset()         { *m = SingleJoinRequest{} }
+func (m *SingleJoinRequest) String() string { return proto.CompactTextString(m) }
+func (*SingleJoinRequest) ProtoMessage()    {}
+func (*SingleJoinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{18}
+}
+func (m *SingleJoinRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleJoinRequest.Unmarshal(m, b)
+}
+func (m *SingleJoinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleJoinRequest.Marshal(b, m, deterministic)
+}
+func (dst *SingleJoinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleJoinRequest.Merge(dst, src)
+}
+func (m *SingleJoinRequest) XXX_Size() int {
+	return xxx_messageInfo_SingleJoinRequest.Size(m)
+}
+func (m *SingleJoinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleJoinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleJoinRequest proto.InternalMessageInfo
+
+func (m *SingleJoinRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SingleJoinRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleJoinRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *SingleJoinRequest) GetStatus() JoinRequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return JoinRequestStatus_PENDING
+}
+
+func (m *SingleJoinRequest) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *SingleJoinRequest) GetDecidedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.DecidedAt
+	}
+	return nil
+}
+
+type ListJoinRequestsRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	PageSize             int32    `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,4,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListJoinRequestsRequest) Reset()         { *m = ListJoinRequestsRequest{} }
+func (m *ListJoinRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListJoinRequestsRequest) ProtoMessage()    {}
+func (*ListJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{19}
+}
+func (m *ListJoinRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJoinRequestsRequest.Unmarshal(m, b)
+}
+func (m *ListJoinRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJoinRequestsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListJoinRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJoinRequestsRequest.Merge(dst, src)
+}
+func (m *ListJoinRequestsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListJoinRequestsRequest.Size(m)
+}
+func (m *ListJoinRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJoinRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJoinRequestsRequest proto.InternalMessageInfo
+
+func (m *ListJoinRequestsRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ListJoinRequestsRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *ListJoinRequestsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListJoinRequestsRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type ListJoinRequestsResponse struct {
+	JoinRequests         []*SingleJoinRequest `protobuf:"bytes,1,rep,name=joinRequests,proto3" json:"joinRequests,omitempty"`
+	PageSize             int32                `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32                `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListJoinRequestsResponse) Reset()         { *m = ListJoinRequestsResponse{} }
+func (m *ListJoinRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListJoinRequestsResponse) ProtoMessage()    {}
+func (*ListJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{20}
+}
+func (m *ListJoinRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJoinRequestsResponse.Unmarshal(m, b)
+}
+func (m *ListJoinRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJoinRequestsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListJoinRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJoinRequestsResponse.Merge(dst, src)
+}
+func (m *ListJoinRequestsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListJoinRequestsResponse.Size(m)
+}
+func (m *ListJoinRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJoinRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJoinRequestsResponse proto.InternalMessageInfo
+
+func (m *ListJoinRequestsResponse) GetJoinRequests() []*SingleJoinRequest {
+	if m != nil {
+		return m.JoinRequests
+	}
+	return nil
+}
+
+func (m *ListJoinRequestsResponse) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListJoinRequestsResponse) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type DecideJoinRequestRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecideJoinRequestRequest) Reset()         { *m = DecideJoinRequestRequest{} }
+func (m *DecideJoinRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*DecideJoinRequestRequest) ProtoMessage()    {}
+func (*DecideJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{21}
+}
+func (m *DecideJoinRequestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecideJoinRequestRequest.Unmarshal(m, b)
+}
+func (m *DecideJoinRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecideJoinRequestRequest.Marshal(b, m, deterministic)
+}
+func (dst *DecideJoinRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecideJoinRequestRequest.Merge(dst, src)
+}
+func (m *DecideJoinRequestRequest) XXX_Size() int {
+	return xxx_messageInfo_DecideJoinRequestRequest.Size(m)
+}
+func (m *DecideJoinRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecideJoinRequestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecideJoinRequestRequest proto.InternalMessageInfo
+
+func (m *DecideJoinRequestRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *DecideJoinRequestRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type CheckMembershipRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckMembershipRequest) Reset()         { *m = CheckMembershipRequest{} }
+func (m *CheckMembershipRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckMembershipRequest) ProtoMessage()    {}
+func (*CheckMembershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{22}
+}
+func (m *CheckMembershipRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckMembershipRequest.Unmarshal(m, b)
+}
+func (m *CheckMembershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckMembershipRequest.Marshal(b, m, deterministic)
+}
+func (dst *CheckMembershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckMembershipRequest.Merge(dst, src)
+}
+func (m *CheckMembershipRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckMembershipRequest.Size(m)
+}
+func (m *CheckMembershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckMembershipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckMembershipRequest proto.InternalMessageInfo
+
+func (m *CheckMembershipRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *CheckMembershipRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type CheckMembershipResponse struct {
+	Member               bool     `protobuf:"varint,1,opt,name=member,proto3" json:"member,omitempty"`
+	PendingRequest       bool     `protobuf:"varint,2,opt,name=pendingRequest,proto3" json:"pendingRequest,omitempty"`
+	CanRead              bool     `protobuf:"varint,3,opt,name=canRead,proto3" json:"canRead,omitempty"`
+	CanPost              bool     `protobuf:"varint,4,opt,name=canPost,proto3" json:"canPost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckMembershipResponse) Reset()         { *m = CheckMembershipResponse{} }
+func (m *CheckMembershipResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckMembershipResponse) ProtoMessage()    {}
+func (*CheckMembershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{23}
+}
+func (m *CheckMembershipResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckMembershipResponse.Unmarshal(m, b)
+}
+func (m *CheckMembershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckMembershipResponse.Marshal(b, m, deterministic)
+}
+func (dst *CheckMembershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckMembershipResponse.Merge(dst, src)
+}
+func (m *CheckMembershipResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckMembershipResponse.Size(m)
+}
+func (m *CheckMembershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckMembershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckMembershipResponse proto.InternalMessageInfo
+
+func (m *CheckMembershipResponse) GetMember() bool {
+	if m != nil {
+		return m.Member
+	}
+	return false
+}
+
+func (m *CheckMembershipResponse) GetPendingRequest() bool {
+	if m != nil {
+		return m.PendingRequest
+	}
+	return false
+}
+
+func (m *CheckMembershipResponse) GetCanRead() bool {
+	if m != nil {
+		return m.CanRead
+	}
+	return false
+}
+
+func (m *CheckMembershipResponse) GetCanPost() bool {
+	if m != nil {
+		return m.CanPost
+	}
+	return false
+}
+
+type CreateInviteRequest struct {
+	CategoryUid          string               `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string               `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	MaxUses              int32                `protobuf:"varint,3,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CreateInviteRequest) Reset()         { *m = CreateInviteRequest{} }
+func (m *CreateInviteRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateInviteRequest) ProtoMessage()    {}
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{24}
+}
+func (m *CreateInviteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateInviteRequest.Unmarshal(m, b)
+}
+func (m *CreateInviteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateInviteRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateInviteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateInviteRequest.Merge(dst, src)
+}
+func (m *CreateInviteRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateInviteRequest.Size(m)
+}
+func (m *CreateInviteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateInviteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateInviteRequest proto.InternalMessageInfo
+
+func (m *CreateInviteRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *CreateInviteRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *CreateInviteRequest) GetMaxUses() int32 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *CreateInviteRequest) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+type SingleInvite struct {
+	Code                 string               `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	CategoryUid          string               `protobuf:"bytes,2,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string               `protobuf:"bytes,3,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	MaxUses              int32                `protobuf:"varint,4,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	Uses                 int32                `protobuf:"varint,5,opt,name=uses,proto3" json:"uses,omitempty"`
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleInvite) Reset()         { *m = SingleInvite{} }
+func (m *SingleInvite) String() string { return proto.CompactTextString(m) }
+func (*SingleInvite) ProtoMessage()    {}
+func (*SingleInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{25}
+}
+func (m *SingleInvite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleInvite.Unmarshal(m, b)
+}
+func (m *SingleInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleInvite.Marshal(b, m, deterministic)
+}
+func (dst *SingleInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleInvite.Merge(dst, src)
+}
+func (m *SingleInvite) XXX_Size() int {
+	return xxx_messageInfo_SingleInvite.Size(m)
+}
+func (m *SingleInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleInvite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleInvite proto.InternalMessageInfo
+
+func (m *SingleInvite) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *SingleInvite) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleInvite) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *SingleInvite) GetMaxUses() int32 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *SingleInvite) GetUses() int32 {
+	if m != nil {
+		return m.Uses
+	}
+	return 0
+}
+
+func (m *SingleInvite) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *SingleInvite) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type RedeemInviteRequest struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedeemInviteRequest) Reset()         { *m = RedeemInviteRequest{} }
+func (m *RedeemInviteRequest) String() string { return proto.CompactTextString(m) }
+func (*RedeemInviteRequest) ProtoMessage()    {}
+func (*RedeemInviteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{26}
+}
+func (m *RedeemInviteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedeemInviteRequest.Unmarshal(m, b)
+}
+func (m *RedeemInviteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedeemInviteRequest.Marshal(b, m, deterministic)
+}
+func (dst *RedeemInviteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeemInviteRequest.Merge(dst, src)
+}
+func (m *RedeemInviteRequest) XXX_Size() int {
+	return xxx_messageInfo_RedeemInviteRequest.Size(m)
+}
+func (m *RedeemInviteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeemInviteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeemInviteRequest proto.InternalMessageInfo
+
+func (m *RedeemInviteRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *RedeemInviteRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type ListInvitesRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	PageSize             int32    `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,4,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListInvitesRequest) Reset()         { *m = ListInvitesRequest{} }
+func (m *ListInvitesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListInvitesRequest) ProtoMessage()    {}
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{27}
+}
+func (m *ListInvitesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInvitesRequest.Unmarshal(m, b)
+}
+func (m *ListInvitesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInvitesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListInvitesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvitesRequest.Merge(dst, src)
+}
+func (m *ListInvitesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListInvitesRequest.Size(m)
+}
+func (m *ListInvitesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvitesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInvitesRequest proto.InternalMessageInfo
+
+func (m *ListInvitesRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ListInvitesRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *ListInvitesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListInvitesRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type ListInvitesResponse struct {
+	Invites              []*SingleInvite `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	PageSize             int32           `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32           `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListInvitesResponse) Reset()         { *m = ListInvitesResponse{} }
+func (m *ListInvitesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListInvitesResponse) ProtoMessage()    {}
+func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{28}
+}
+func (m *ListInvitesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInvitesResponse.Unmarshal(m, b)
+}
+func (m *ListInvitesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInvitesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListInvitesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvitesResponse.Merge(dst, src)
+}
+func (m *ListInvitesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListInvitesResponse.Size(m)
+}
+func (m *ListInvitesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvitesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInvitesResponse proto.InternalMessageInfo
+
+func (m *ListInvitesResponse) GetInvites() []*SingleInvite {
+	if m != nil {
+		return m.Invites
+	}
+	return nil
+}
+
+func (m *ListInvitesResponse) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListInvitesResponse) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type RevokeInviteRequest struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeInviteRequest) Reset()         { *m = RevokeInviteRequest{} }
+func (m *RevokeInviteRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeInviteRequest) ProtoMessage()    {}
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{29}
+}
+func (m *RevokeInviteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeInviteRequest.Unmarshal(m, b)
+}
+func (m *RevokeInviteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeInviteRequest.Marshal(b, m, deterministic)
+}
+func (dst *RevokeInviteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeInviteRequest.Merge(dst, src)
+}
+func (m *RevokeInviteRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeInviteRequest.Size(m)
+}
+func (m *RevokeInviteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeInviteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeInviteRequest proto.InternalMessageInfo
+
+func (m *RevokeInviteRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *RevokeInviteRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type RevokeInviteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeInviteResponse) Reset()         { *m = RevokeInviteResponse{} }
+func (m *RevokeInviteResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeInviteResponse) ProtoMessage()    {}
+func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{30}
+}
+func (m *RevokeInviteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeInviteResponse.Unmarshal(m, b)
+}
+func (m *RevokeInviteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeInviteResponse.Marshal(b, m, deterministic)
+}
+func (dst *RevokeInviteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeInviteResponse.Merge(dst, src)
+}
+func (m *RevokeInviteResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeInviteResponse.Size(m)
+}
+func (m *RevokeInviteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeInviteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeInviteResponse proto.InternalMessageInfo
+
+type BanUserRequest struct {
+	CategoryUid          string               `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string               `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	ModeratorUid         string               `protobuf:"bytes,3,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	Reason               string               `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BanUserRequest) Reset()         { *m = BanUserRequest{} }
+func (m *BanUserRequest) String() string { return proto.CompactTextString(m) }
+func (*BanUserRequest) ProtoMessage()    {}
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{31}
+}
+func (m *BanUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanUserRequest.Unmarshal(m, b)
+}
+func (m *BanUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanUserRequest.Marshal(b, m, deterministic)
+}
+func (dst *BanUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanUserRequest.Merge(dst, src)
+}
+func (m *BanUserRequest) XXX_Size() int {
+	return xxx_messageInfo_BanUserRequest.Size(m)
+}
+func (m *BanUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BanUserRequest proto.InternalMessageInfo
+
+func (m *BanUserRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *BanUserRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *BanUserRequest) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+func (m *BanUserRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BanUserRequest) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+type SingleBan struct {
+	CategoryUid          string               `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string               `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	ModeratorUid         string               `protobuf:"bytes,3,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	Reason               string               `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleBan) Reset()         { *m = SingleBan{} }
+func (m *SingleBan) String() string { return proto.CompactTextString(m) }
+func (*SingleBan) ProtoMessage()    {}
+func (*SingleBan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{32}
+}
+func (m *SingleBan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleBan.Unmarshal(m, b)
+}
+func (m *SingleBan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleBan.Marshal(b, m, deterministic)
+}
+func (dst *SingleBan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleBan.Merge(dst, src)
+}
+func (m *SingleBan) XXX_Size() int {
+	return xxx_messageInfo_SingleBan.Size(m)
+}
+func (m *SingleBan) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleBan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleBan proto.InternalMessageInfo
+
+func (m *SingleBan) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleBan) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *SingleBan) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+func (m *SingleBan) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SingleBan) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *SingleBan) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type UnbanUserRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	ModeratorUid         string   `protobuf:"bytes,3,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnbanUserRequest) Reset()         { *m = UnbanUserRequest{} }
+func (m *UnbanUserRequest) String() string { return proto.CompactTextString(m) }
+func (*UnbanUserRequest) ProtoMessage()    {}
+func (*UnbanUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{33}
+}
+func (m *UnbanUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnbanUserRequest.Unmarshal(m, b)
+}
+func (m *UnbanUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnbanUserRequest.Marshal(b, m, deterministic)
+}
+func (dst *UnbanUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbanUserRequest.Merge(dst, src)
+}
+func (m *UnbanUserRequest) XXX_Size() int {
+	return xxx_messageInfo_UnbanUserRequest.Size(m)
+}
+func (m *UnbanUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbanUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbanUserRequest proto.InternalMessageInfo
+
+func (m *UnbanUserRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *UnbanUserRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *UnbanUserRequest) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+type UnbanUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnbanUserResponse) Reset()         { *m = UnbanUserResponse{} }
+func (m *UnbanUserResponse) String() string { return proto.CompactTextString(m) }
+func (*UnbanUserResponse) ProtoMessage()    {}
+func (*UnbanUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{34}
+}
+func (m *UnbanUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnbanUserResponse.Unmarshal(m, b)
+}
+func (m *UnbanUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnbanUserResponse.Marshal(b, m, deterministic)
+}
+func (dst *UnbanUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbanUserResponse.Merge(dst, src)
+}
+func (m *UnbanUserResponse) XXX_Size() int {
+	return xxx_messageInfo_UnbanUserResponse.Size(m)
+}
+func (m *UnbanUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbanUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbanUserResponse proto.InternalMessageInfo
+
+type ListBansRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	ModeratorUid         string   `protobuf:"bytes,4,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBansRequest) Reset()         { *m = ListBansRequest{} }
+func (m *ListBansRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBansRequest) ProtoMessage()    {}
+func (*ListBansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{35}
+}
+func (m *ListBansRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBansRequest.Unmarshal(m, b)
+}
+func (m *ListBansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBansRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListBansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBansRequest.Merge(dst, src)
+}
+func (m *ListBansRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBansRequest.Size(m)
+}
+func (m *ListBansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBansRequest proto.InternalMessageInfo
+
+func (m *ListBansRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ListBansRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListBansRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+func (m *ListBansRequest) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+type ListBansResponse struct {
+	Bans                 []*SingleBan `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	PageSize             int32        `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32        `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListBansResponse) Reset()         { *m = ListBansResponse{} }
+func (m *ListBansResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBansResponse) ProtoMessage()    {}
+func (*ListBansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{36}
+}
+func (m *ListBansResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBansResponse.Unmarshal(m, b)
+}
+func (m *ListBansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBansResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListBansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBansResponse.Merge(dst, src)
+}
+func (m *ListBansResponse) XXX_Size() int {
+	return xxx_messageInfo_ListBansResponse.Size(m)
+}
+func (m *ListBansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBansResponse proto.InternalMessageInfo
+
+func (m *ListBansResponse) GetBans() []*SingleBan {
+	if m != nil {
+		return m.Bans
+	}
+	return nil
+}
+
+func (m *ListBansResponse) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListBansResponse) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type IsBannedRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IsBannedRequest) Reset()         { *m = IsBannedRequest{} }
+func (m *IsBannedRequest) String() string { return proto.CompactTextString(m) }
+func (*IsBannedRequest) ProtoMessage()    {}
+func (*IsBannedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{37}
+}
+func (m *IsBannedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsBannedRequest.Unmarshal(m, b)
+}
+func (m *IsBannedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsBannedRequest.Marshal(b, m, deterministic)
+}
+func (dst *IsBannedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsBannedRequest.Merge(dst, src)
+}
+func (m *IsBannedRequest) XXX_Size() int {
+	return xxx_messageInfo_IsBannedRequest.Size(m)
+}
+func (m *IsBannedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsBannedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsBannedRequest proto.InternalMessageInfo
+
+func (m *IsBannedRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *IsBannedRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type IsBannedResponse struct {
+	Banned               bool       `protobuf:"varint,1,opt,name=banned,proto3" json:"banned,omitempty"`
+	Ban                  *SingleBan `protobuf:"bytes,2,opt,name=ban,proto3" json:"ban,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *IsBannedResponse) Reset()         { *m = IsBannedResponse{} }
+func (m *IsBannedResponse) String() string { return proto.CompactTextString(m) }
+func (*IsBannedResponse) ProtoMessage()    {}
+func (*IsBannedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{38}
+}
+func (m *IsBannedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsBannedResponse.Unmarshal(m, b)
+}
+func (m *IsBannedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsBannedResponse.Marshal(b, m, deterministic)
+}
+func (dst *IsBannedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsBannedResponse.Merge(dst, src)
+}
+func (m *IsBannedResponse) XXX_Size() int {
+	return xxx_messageInfo_IsBannedResponse.Size(m)
+}
+func (m *IsBannedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsBannedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsBannedResponse proto.InternalMessageInfo
+
+func (m *IsBannedResponse) GetBanned() bool {
+	if m != nil {
+		return m.Banned
+	}
+	return false
+}
+
+func (m *IsBannedResponse) GetBan() *SingleBan {
+	if m != nil {
+		return m.Ban
+	}
+	return nil
+}
+
+type AddStrikeRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	ModeratorUid         string   `protobuf:"bytes,3,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	ReportUid            string   `protobuf:"bytes,4,opt,name=reportUid,proto3" json:"reportUid,omitempty"`
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddStrikeRequest) Reset()         { *m = AddStrikeRequest{} }
+func (m *AddStrikeRequest) String() string { return proto.CompactTextString(m) }
+func (*AddStrikeRequest) ProtoMessage()    {}
+func (*AddStrikeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{39}
+}
+func (m *AddStrikeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddStrikeRequest.Unmarshal(m, b)
+}
+func (m *AddStrikeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddStrikeRequest.Marshal(b, m, deterministic)
+}
+func (dst *AddStrikeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddStrikeRequest.Merge(dst, src)
+}
+func (m *AddStrikeRequest) XXX_Size() int {
+	return xxx_messageInfo_AddStrikeRequest.Size(m)
+}
+func (m *AddStrikeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddStrikeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddStrikeRequest proto.InternalMessageInfo
+
+func (m *AddStrikeRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *AddStrikeRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *AddStrikeRequest) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+func (m *AddStrikeRequest) GetReportUid() string {
+	if m != nil {
+		return m.ReportUid
+	}
+	return ""
+}
+
+func (m *AddStrikeRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type SingleStrike struct {
+	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CategoryUid          string               `protobuf:"bytes,2,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string               `protobuf:"bytes,3,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	ModeratorUid         string               `protobuf:"bytes,4,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	ReportUid            string               `protobuf:"bytes,5,opt,name=reportUid,proto3" json:"reportUid,omitempty"`
+	Reason               string               `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleStrike) Reset()         { *m = SingleStrike{} }
+func (m *SingleStrike) String() string { return proto.CompactTextString(m) }
+func (*SingleStrike) ProtoMessage()    {}
+func (*SingleStrike) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{40}
+}
+func (m *SingleStrike) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleStrike.Unmarshal(m, b)
+}
+func (m *SingleStrike) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleStrike.Marshal(b, m, deterministic)
+}
+func (dst *SingleStrike) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleStrike.Merge(dst, src)
+}
+func (m *SingleStrike) XXX_Size() int {
+	return xxx_messageInfo_SingleStrike.Size(m)
+}
+func (m *SingleStrike) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleStrike.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleStrike proto.InternalMessageInfo
+
+func (m *SingleStrike) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SingleStrike) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleStrike) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *SingleStrike) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+func (m *SingleStrike) GetReportUid() string {
+	if m != nil {
+		return m.ReportUid
+	}
+	return ""
+}
+
+func (m *SingleStrike) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SingleStrike) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *SingleStrike) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+type SingleSuspension struct {
+	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CategoryUid          string               `protobuf:"bytes,2,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string               `protobuf:"bytes,3,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	StrikeUid            string               `protobuf:"bytes,4,opt,name=strikeUid,proto3" json:"strikeUid,omitempty"`
+	StartsAt             *timestamp.Timestamp `protobuf:"bytes,5,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt               *timestamp.Timestamp `protobuf:"bytes,6,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleSuspension) Reset()         { *m = SingleSuspension{} }
+func (m *SingleSuspension) String() string { return proto.CompactTextString(m) }
+func (*SingleSuspension) ProtoMessage()    {}
+func (*SingleSuspension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{41}
+}
+func (m *SingleSuspension) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleSuspension.Unmarshal(m, b)
+}
+func (m *SingleSuspension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleSuspension.Marshal(b, m, deterministic)
+}
+func (dst *SingleSuspension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleSuspension.Merge(dst, src)
+}
+func (m *SingleSuspension) XXX_Size() int {
+	return xxx_messageInfo_SingleSuspension.Size(m)
+}
+func (m *SingleSuspension) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleSuspension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleSuspension proto.InternalMessageInfo
+
+func (m *SingleSuspension) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SingleSuspension) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleSuspension) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *SingleSuspension) GetStrikeUid() string {
+	if m != nil {
+		return m.StrikeUid
+	}
+	return ""
+}
+
+func (m *SingleSuspension) GetStartsAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartsAt
+	}
+	return nil
+}
+
+func (m *SingleSuspension) GetEndsAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndsAt
+	}
+	return nil
+}
+
+type AddStrikeResponse struct {
+	Strike               *SingleStrike     `protobuf:"bytes,1,opt,name=strike,proto3" json:"strike,omitempty"`
+	Suspension           *SingleSuspension `protobuf:"bytes,2,opt,name=suspension,proto3" json:"suspension,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AddStrikeResponse) Reset()         { *m = AddStrikeResponse{} }
+func (m *AddStrikeResponse) String() string { return proto.CompactTextString(m) }
+func (*AddStrikeResponse) ProtoMessage()    {}
+func (*AddStrikeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{42}
+}
+func (m *AddStrikeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddStrikeResponse.Unmarshal(m, b)
+}
+func (m *AddStrikeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddStrikeResponse.Marshal(b, m, deterministic)
+}
+func (dst *AddStrikeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddStrikeResponse.Merge(dst, src)
+}
+func (m *AddStrikeResponse) XXX_Size() int {
+	return xxx_messageInfo_AddStrikeResponse.Size(m)
+}
+func (m *AddStrikeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddStrikeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddStrikeResponse proto.InternalMessageInfo
+
+func (m *AddStrikeResponse) GetStrike() *SingleStrike {
+	if m != nil {
+		return m.Strike
+	}
+	return nil
+}
+
+func (m *AddStrikeResponse) GetSuspension() *SingleSuspension {
+	if m != nil {
+		return m.Suspension
+	}
+	return nil
+}
+
+type ListStrikesRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	IncludeExpired       bool     `protobuf:"varint,3,opt,name=includeExpired,proto3" json:"includeExpired,omitempty"`
+	PageSize             int32    `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,5,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListStrikesRequest) Reset()         { *m = ListStrikesRequest{} }
+func (m *ListStrikesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListStrikesRequest) ProtoMessage()    {}
+func (*ListStrikesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{43}
+}
+func (m *ListStrikesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListStrikesRequest.Unmarshal(m, b)
+}
+func (m *ListStrikesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListStrikesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListStrikesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStrikesRequest.Merge(dst, src)
+}
+func (m *ListStrikesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListStrikesRequest.Size(m)
+}
+func (m *ListStrikesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStrikesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListStrikesRequest proto.InternalMessageInfo
+
+func (m *ListStrikesRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ListStrikesRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *ListStrikesRequest) GetIncludeExpired() bool {
+	if m != nil {
+		return m.IncludeExpired
+	}
+	return false
+}
+
+func (m *ListStrikesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListStrikesRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type ListStrikesResponse struct {
+	Strikes              []*SingleStrike `protobuf:"bytes,1,rep,name=strikes,proto3" json:"strikes,omitempty"`
+	PageSize             int32           `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32           `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListStrikesResponse) Reset()         { *m = ListStrikesResponse{} }
+func (m *ListStrikesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListStrikesResponse) ProtoMessage()    {}
+func (*ListStrikesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{44}
+}
+func (m *ListStrikesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListStrikesResponse.Unmarshal(m, b)
+}
+func (m *ListStrikesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListStrikesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListStrikesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStrikesResponse.Merge(dst, src)
+}
+func (m *ListStrikesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListStrikesResponse.Size(m)
+}
+func (m *ListStrikesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStrikesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListStrikesResponse proto.InternalMessageInfo
+
+func (m *ListStrikesResponse) GetStrikes() []*SingleStrike {
+	if m != nil {
+		return m.Strikes
+	}
+	return nil
+}
+
+func (m *ListStrikesResponse) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListStrikesResponse) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type IsSuspendedRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IsSuspendedRequest) Reset()         { *m = IsSuspendedRequest{} }
+func (m *IsSuspendedRequest) String() string { return proto.CompactTextString(m) }
+func (*IsSuspendedRequest) ProtoMessage()    {}
+func (*IsSuspendedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{45}
+}
+func (m *IsSuspendedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsSuspendedRequest.Unmarshal(m, b)
+}
+func (m *IsSuspendedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsSuspendedRequest.Marshal(b, m, deterministic)
+}
+func (dst *IsSuspendedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsSuspendedRequest.Merge(dst, src)
+}
+func (m *IsSuspendedRequest) XXX_Size() int {
+	return xxx_messageInfo_IsSuspendedRequest.Size(m)
+}
+func (m *IsSuspendedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsSuspendedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsSuspendedRequest proto.InternalMessageInfo
+
+func (m *IsSuspendedRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *IsSuspendedRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type IsSuspendedResponse struct {
+	Suspended            bool              `protobuf:"varint,1,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	Suspension           *SingleSuspension `protobuf:"bytes,2,opt,name=suspension,proto3" json:"suspension,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *IsSuspendedResponse) Reset()         { *m = IsSuspendedResponse{} }
+func (m *IsSuspendedResponse) String() string { return proto.CompactTextString(m) }
+func (*IsSuspendedResponse) ProtoMessage()    {}
+func (*IsSuspendedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{46}
+}
+func (m *IsSuspendedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsSuspendedResponse.Unmarshal(m, b)
+}
+func (m *IsSuspendedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsSuspendedResponse.Marshal(b, m, deterministic)
+}
+func (dst *IsSuspendedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsSuspendedResponse.Merge(dst, src)
+}
+func (m *IsSuspendedResponse) XXX_Size() int {
+	return xxx_messageInfo_IsSuspendedResponse.Size(m)
+}
+func (m *IsSuspendedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsSuspendedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsSuspendedResponse proto.InternalMessageInfo
+
+func (m *IsSuspendedResponse) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func (m *IsSuspendedResponse) GetSuspension() *SingleSuspension {
+	if m != nil {
+		return m.Suspension
+	}
+	return nil
+}
+
+type GetEscalationPolicyRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEscalationPolicyRequest) Reset()         { *m = GetEscalationPolicyRequest{} }
+func (m *GetEscalationPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEscalationPolicyRequest) ProtoMessage()    {}
+func (*GetEscalationPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{47}
+}
+func (m *GetEscalationPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEscalationPolicyRequest.Unmarshal(m, b)
+}
+func (m *GetEscalationPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEscalationPolicyRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetEscalationPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEscalationPolicyRequest.Merge(dst, src)
+}
+func (m *GetEscalationPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEscalationPolicyRequest.Size(m)
+}
+func (m *GetEscalationPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEscalationPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEscalationPolicyRequest proto.InternalMessageInfo
+
+func (m *GetEscalationPolicyRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+type SingleEscalationPolicy struct {
+	CategoryUid          string             `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	StrikeLimit          int32              `protobuf:"varint,2,opt,name=strikeLimit,proto3" json:"strikeLimit,omitempty"`
+	Window               *duration.Duration `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	SuspensionDuration   *duration.Duration `protobuf:"bytes,4,opt,name=suspensionDuration,proto3" json:"suspensionDuration,omitempty"`
+	StrikeLifetime       *duration.Duration `protobuf:"bytes,5,opt,name=strikeLifetime,proto3" json:"strikeLifetime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SingleEscalationPolicy) Reset()         { *m = SingleEscalationPolicy{} }
+func (m *SingleEscalationPolicy) String() string { return proto.CompactTextString(m) }
+func (*SingleEscalationPolicy) ProtoMessage()    {}
+func (*SingleEscalationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{48}
+}
+func (m *SingleEscalationPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleEscalationPolicy.Unmarshal(m, b)
+}
+func (m *SingleEscalationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleEscalationPolicy.Marshal(b, m, deterministic)
+}
+func (dst *SingleEscalationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleEscalationPolicy.Merge(dst, src)
+}
+func (m *SingleEscalationPolicy) XXX_Size() int {
+	return xxx_messageInfo_SingleEscalationPolicy.Size(m)
+}
+func (m *SingleEscalationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleEscalationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleEscalationPolicy proto.InternalMessageInfo
+
+func (m *SingleEscalationPolicy) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleEscalationPolicy) GetStrikeLimit() int32 {
+	if m != nil {
+		return m.StrikeLimit
+	}
+	return 0
+}
+
+func (m *SingleEscalationPolicy) GetWindow() *duration.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *SingleEscalationPolicy) GetSuspensionDuration() *duration.Duration {
+	if m != nil {
+		return m.SuspensionDuration
+	}
+	return nil
+}
+
+func (m *SingleEscalationPolicy) GetStrikeLifetime() *duration.Duration {
+	if m != nil {
+		return m.StrikeLifetime
+	}
+	return nil
+}
+
+type SetEscalationPolicyRequest struct {
+	CategoryUid          string             `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string             `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	StrikeLimit          int32              `protobuf:"varint,3,opt,name=strikeLimit,proto3" json:"strikeLimit,omitempty"`
+	Window               *duration.Duration `protobuf:"bytes,4,opt,name=window,proto3" json:"window,omitempty"`
+	SuspensionDuration   *duration.Duration `protobuf:"bytes,5,opt,name=suspensionDuration,proto3" json:"suspensionDuration,omitempty"`
+	StrikeLifetime       *duration.Duration `protobuf:"bytes,6,opt,name=strikeLifetime,proto3" json:"strikeLifetime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SetEscalationPolicyRequest) Reset()         { *m = SetEscalationPolicyRequest{} }
+func (m *SetEscalationPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetEscalationPolicyRequest) ProtoMessage()    {}
+func (*SetEscalationPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{49}
+}
+func (m *SetEscalationPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetEscalationPolicyRequest.Unmarshal(m, b)
+}
+func (m *SetEscalationPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetEscalationPolicyRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetEscalationPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetEscalationPolicyRequest.Merge(dst, src)
+}
+func (m *SetEscalationPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetEscalationPolicyRequest.Size(m)
+}
+func (m *SetEscalationPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetEscalationPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetEscalationPolicyRequest proto.InternalMessageInfo
+
+func (m *SetEscalationPolicyRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SetEscalationPolicyRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *SetEscalationPolicyRequest) GetStrikeLimit() int32 {
+	if m != nil {
+		return m.StrikeLimit
+	}
+	return 0
+}
+
+func (m *SetEscalationPolicyRequest) GetWindow() *duration.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *SetEscalationPolicyRequest) GetSuspensionDuration() *duration.Duration {
+	if m != nil {
+		return m.SuspensionDuration
+	}
+	return nil
+}
+
+func (m *SetEscalationPolicyRequest) GetStrikeLifetime() *duration.Duration {
+	if m != nil {
+		return m.StrikeLifetime
+	}
+	return nil
+}
+
+type NoteAccessRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	GranteeUid           string   `protobuf:"bytes,3,opt,name=granteeUid,proto3" json:"granteeUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NoteAccessRequest) Reset()         { *m = NoteAccessRequest{} }
+func (m *NoteAccessRequest) String() string { return proto.CompactTextString(m) }
+func (*NoteAccessRequest) ProtoMessage()    {}
+func (*NoteAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{50}
+}
+func (m *NoteAccessRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NoteAccessRequest.Unmarshal(m, b)
+}
+func (m *NoteAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NoteAccessRequest.Marshal(b, m, deterministic)
+}
+func (dst *NoteAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoteAccessRequest.Merge(dst, src)
+}
+func (m *NoteAccessRequest) XXX_Size() int {
+	return xxx_messageInfo_NoteAccessRequest.Size(m)
+}
+func (m *NoteAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoteAccessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoteAccessRequest proto.InternalMessageInfo
+
+func (m *NoteAccessRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *NoteAccessRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *NoteAccessRequest) GetGranteeUid() string {
+	if m != nil {
+		return m.GranteeUid
+	}
+	return ""
+}
+
+type SingleNoteAccessGrant struct {
+	CategoryUid          string               `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string               `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleNoteAccessGrant) Reset()         { *m = SingleNoteAccessGrant{} }
+func (m *SingleNoteAccessGrant) String() string { return proto.CompactTextString(m) }
+func (*SingleNoteAccessGrant) ProtoMessage()    {}
+func (*SingleNoteAccessGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{51}
+}
+func (m *SingleNoteAccessGrant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleNoteAccessGrant.Unmarshal(m, b)
+}
+func (m *SingleNoteAccessGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleNoteAccessGrant.Marshal(b, m, deterministic)
+}
+func (dst *SingleNoteAccessGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleNoteAccessGrant.Merge(dst, src)
+}
+func (m *SingleNoteAccessGrant) XXX_Size() int {
+	return xxx_messageInfo_SingleNoteAccessGrant.Size(m)
+}
+func (m *SingleNoteAccessGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleNoteAccessGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleNoteAccessGrant proto.InternalMessageInfo
+
+func (m *SingleNoteAccessGrant) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleNoteAccessGrant) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *SingleNoteAccessGrant) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type RevokeNoteAccessResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeNoteAccessResponse) Reset()         { *m = RevokeNoteAccessResponse{} }
+func (m *RevokeNoteAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeNoteAccessResponse) ProtoMessage()    {}
+func (*RevokeNoteAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{52}
+}
+func (m *RevokeNoteAccessResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeNoteAccessResponse.Unmarshal(m, b)
+}
+func (m *RevokeNoteAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeNoteAccessResponse.Marshal(b, m, deterministic)
+}
+func (dst *RevokeNoteAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeNoteAccessResponse.Merge(dst, src)
+}
+func (m *RevokeNoteAccessResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeNoteAccessResponse.Size(m)
+}
+func (m *RevokeNoteAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeNoteAccessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeNoteAccessResponse proto.InternalMessageInfo
+
+type ListNoteAccessGrantsRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListNoteAccessGrantsRequest) Reset()         { *m = ListNoteAccessGrantsRequest{} }
+func (m *ListNoteAccessGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListNoteAccessGrantsRequest) ProtoMessage()    {}
+func (*ListNoteAccessGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{53}
+}
+func (m *ListNoteAccessGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNoteAccessGrantsRequest.Unmarshal(m, b)
+}
+func (m *ListNoteAccessGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNoteAccessGrantsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListNoteAccessGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNoteAccessGrantsRequest.Merge(dst, src)
+}
+func (m *ListNoteAccessGrantsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListNoteAccessGrantsRequest.Size(m)
+}
+func (m *ListNoteAccessGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNoteAccessGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNoteAccessGrantsRequest proto.InternalMessageInfo
+
+func (m *ListNoteAccessGrantsRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ListNoteAccessGrantsRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type ListNoteAccessGrantsResponse struct {
+	Grants               []*SingleNoteAccessGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ListNoteAccessGrantsResponse) Reset()         { *m = ListNoteAccessGrantsResponse{} }
+func (m *ListNoteAccessGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListNoteAccessGrantsResponse) ProtoMessage()    {}
+func (*ListNoteAccessGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{54}
+}
+func (m *ListNoteAccessGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNoteAccessGrantsResponse.Unmarshal(m, b)
+}
+func (m *ListNoteAccessGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNoteAccessGrantsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListNoteAccessGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNoteAccessGrantsResponse.Merge(dst, src)
+}
+func (m *ListNoteAccessGrantsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListNoteAccessGrantsResponse.Size(m)
+}
+func (m *ListNoteAccessGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNoteAccessGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNoteAccessGrantsResponse proto.InternalMessageInfo
+
+func (m *ListNoteAccessGrantsResponse) GetGrants() []*SingleNoteAccessGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+type CreateUserNoteRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	AuthorUid            string   `protobuf:"bytes,3,opt,name=authorUid,proto3" json:"authorUid,omitempty"`
+	Text                 string   `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	ReportUid            string   `protobuf:"bytes,5,opt,name=reportUid,proto3" json:"reportUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateUserNoteRequest) Reset()         { *m = CreateUserNoteRequest{} }
+func (m *CreateUserNoteRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateUserNoteRequest) ProtoMessage()    {}
+func (*CreateUserNoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{55}
+}
+func (m *CreateUserNoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateUserNoteRequest.Unmarshal(m, b)
+}
+func (m *CreateUserNoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateUserNoteRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateUserNoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUserNoteRequest.Merge(dst, src)
+}
+func (m *CreateUserNoteRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateUserNoteRequest.Size(m)
+}
+func (m *CreateUserNoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUserNoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateUserNoteRequest proto.InternalMessageInfo
+
+func (m *CreateUserNoteRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *CreateUserNoteRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *CreateUserNoteRequest) GetAuthorUid() string {
+	if m != nil {
+		return m.AuthorUid
+	}
+	return ""
+}
+
+func (m *CreateUserNoteRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *CreateUserNoteRequest) GetReportUid() string {
+	if m != nil {
+		return m.ReportUid
+	}
+	return ""
+}
+
+type SingleUserNote struct {
+	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CategoryUid          string               `protobuf:"bytes,2,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string               `protobuf:"bytes,3,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	AuthorUid            string               `protobuf:"bytes,4,opt,name=authorUid,proto3" json:"authorUid,omitempty"`
+	Text                 string               `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	ReportUid            string               `protobuf:"bytes,6,opt,name=reportUid,proto3" json:"reportUid,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleUserNote) Reset()         { *m = SingleUserNote{} }
+func (m *SingleUserNote) String() string { return proto.CompactTextString(m) }
+func (*SingleUserNote) ProtoMessage()    {}
+func (*SingleUserNote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{56}
+}
+func (m *SingleUserNote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleUserNote.Unmarshal(m, b)
+}
+func (m *SingleUserNote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleUserNote.Marshal(b, m, deterministic)
+}
+func (dst *SingleUserNote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleUserNote.Merge(dst, src)
+}
+func (m *SingleUserNote) XXX_Size() int {
+	return xxx_messageInfo_SingleUserNote.Size(m)
+}
+func (m *SingleUserNote) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleUserNote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleUserNote proto.InternalMessageInfo
+
+func (m *SingleUserNote) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SingleUserNote) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleUserNote) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *SingleUserNote) GetAuthorUid() string {
+	if m != nil {
+		return m.AuthorUid
+	}
+	return ""
+}
+
+func (m *SingleUserNote) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *SingleUserNote) GetReportUid() string {
+	if m != nil {
+		return m.ReportUid
+	}
+	return ""
+}
+
+func (m *SingleUserNote) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ListUserNotesRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	ViewerUid            string   `protobuf:"bytes,3,opt,name=viewerUid,proto3" json:"viewerUid,omitempty"`
+	PageSize             int32    `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,5,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUserNotesRequest) Reset()         { *m = ListUserNotesRequest{} }
+func (m *ListUserNotesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUserNotesRequest) ProtoMessage()    {}
+func (*ListUserNotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{57}
+}
+func (m *ListUserNotesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUserNotesRequest.Unmarshal(m, b)
+}
+func (m *ListUserNotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUserNotesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListUserNotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUserNotesRequest.Merge(dst, src)
+}
+func (m *ListUserNotesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUserNotesRequest.Size(m)
+}
+func (m *ListUserNotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUserNotesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUserNotesRequest proto.InternalMessageInfo
+
+func (m *ListUserNotesRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ListUserNotesRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *ListUserNotesRequest) GetViewerUid() string {
+	if m != nil {
+		return m.ViewerUid
+	}
+	return ""
+}
+
+func (m *ListUserNotesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListUserNotesRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type ListUserNotesResponse struct {
+	Notes                []*SingleUserNote `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	PageSize             int32             `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32             `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListUserNotesResponse) Reset()         { *m = ListUserNotesResponse{} }
+func (m *ListUserNotesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUserNotesResponse) ProtoMessage()    {}
+func (*ListUserNotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{58}
+}
+func (m *ListUserNotesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUserNotesResponse.Unmarshal(m, b)
+}
+func (m *ListUserNotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUserNotesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListUserNotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUserNotesResponse.Merge(dst, src)
+}
+func (m *ListUserNotesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUserNotesResponse.Size(m)
+}
+func (m *ListUserNotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUserNotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUserNotesResponse proto.InternalMessageInfo
+
+func (m *ListUserNotesResponse) GetNotes() []*SingleUserNote {
+	if m != nil {
+		return m.Notes
+	}
+	return nil
+}
+
+func (m *ListUserNotesResponse) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListUserNotesResponse) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type DeleteUserNoteRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ViewerUid            string   `protobuf:"bytes,2,opt,name=viewerUid,proto3" json:"viewerUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserNoteRequest) Reset()         { *m = DeleteUserNoteRequest{} }
+func (m *DeleteUserNoteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserNoteRequest) ProtoMessage()    {}
+func (*DeleteUserNoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{59}
+}
+func (m *DeleteUserNoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserNoteRequest.Unmarshal(m, b)
+}
+func (m *DeleteUserNoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserNoteRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteUserNoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserNoteRequest.Merge(dst, src)
+}
+func (m *DeleteUserNoteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserNoteRequest.Size(m)
+}
+func (m *DeleteUserNoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserNoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserNoteRequest proto.InternalMessageInfo
+
+func (m *DeleteUserNoteRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *DeleteUserNoteRequest) GetViewerUid() string {
+	if m != nil {
+		return m.ViewerUid
+	}
+	return ""
+}
+
+type DeleteUserNoteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserNoteResponse) Reset()         { *m = DeleteUserNoteResponse{} }
+func (m *DeleteUserNoteResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserNoteResponse) ProtoMessage()    {}
+func (*DeleteUserNoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{60}
+}
+func (m *DeleteUserNoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserNoteResponse.Unmarshal(m, b)
+}
+func (m *DeleteUserNoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserNoteResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteUserNoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserNoteResponse.Merge(dst, src)
+}
+func (m *DeleteUserNoteResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserNoteResponse.Size(m)
+}
+func (m *DeleteUserNoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserNoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserNoteResponse proto.InternalMessageInfo
+
+type SearchCategoriesRequest struct {
+	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Language             string   `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	PageSize             int32    `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,4,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	UserUid              string   `protobuf:"bytes,5,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchCategoriesRequest) Reset()         { *m = SearchCategoriesRequest{} }
+func (m *SearchCategoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchCategoriesRequest) ProtoMessage()    {}
+func (*SearchCategoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{61}
+}
+func (m *SearchCategoriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchCategoriesRequest.Unmarshal(m, b)
+}
+func (m *SearchCategoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchCategoriesRequest.Marshal(b, m, deterministic)
+}
+func (dst *SearchCategoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchCategoriesRequest.Merge(dst, src)
+}
+func (m *SearchCategoriesRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchCategoriesRequest.Size(m)
+}
+func (m *SearchCategoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchCategoriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchCategoriesRequest proto.InternalMessageInfo
+
+func (m *SearchCategoriesRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchCategoriesRequest) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *SearchCategoriesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *SearchCategoriesRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+func (m *SearchCategoriesRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type CategorySearchResult struct {
+	Category             *SingleCategory `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Score                float32         `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	NameHighlight        string          `protobuf:"bytes,3,opt,name=nameHighlight,proto3" json:"nameHighlight,omitempty"`
+	DescriptionHighlight string          `protobuf:"bytes,4,opt,name=descriptionHighlight,proto3" json:"descriptionHighlight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CategorySearchResult) Reset()         { *m = CategorySearchResult{} }
+func (m *CategorySearchResult) String() string { return proto.CompactTextString(m) }
+func (*CategorySearchResult) ProtoMessage()    {}
+func (*CategorySearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{62}
+}
+func (m *CategorySearchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CategorySearchResult.Unmarshal(m, b)
+}
+func (m *CategorySearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CategorySearchResult.Marshal(b, m, deterministic)
+}
+func (dst *CategorySearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CategorySearchResult.Merge(dst, src)
+}
+func (m *CategorySearchResult) XXX_Size() int {
+	return xxx_messageInfo_CategorySearchResult.Size(m)
+}
+func (m *CategorySearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CategorySearchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CategorySearchResult proto.InternalMessageInfo
+
+func (m *CategorySearchResult) GetCategory() *SingleCategory {
+	if m != nil {
+		return m.Category
+	}
+	return nil
+}
+
+func (m *CategorySearchResult) GetScore() float32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *CategorySearchResult) GetNameHighlight() string {
+	if m != nil {
+		return m.NameHighlight
+	}
+	return ""
+}
+
+func (m *CategorySearchResult) GetDescriptionHighlight() string {
+	if m != nil {
+		return m.DescriptionHighlight
+	}
+	return ""
+}
+
+type SearchCategoriesResponse struct {
+	Results              []*CategorySearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	PageSize             int32                   `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32                   `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *SearchCategoriesResponse) Reset()         { *m = SearchCategoriesResponse{} }
+func (m *SearchCategoriesResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchCategoriesResponse) ProtoMessage()    {}
+func (*SearchCategoriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{63}
+}
+func (m *SearchCategoriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchCategoriesResponse.Unmarshal(m, b)
+}
+func (m *SearchCategoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchCategoriesResponse.Marshal(b, m, deterministic)
+}
+func (dst *SearchCategoriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchCategoriesResponse.Merge(dst, src)
+}
+func (m *SearchCategoriesResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchCategoriesResponse.Size(m)
+}
+func (m *SearchCategoriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchCategoriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchCategoriesResponse proto.InternalMessageInfo
+
+func (m *SearchCategoriesResponse) GetResults() []*CategorySearchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SearchCategoriesResponse) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *SearchCategoriesResponse) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type SuggestCategoriesRequest struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	UserUid              string   `protobuf:"bytes,3,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuggestCategoriesRequest) Reset()         { *m = SuggestCategoriesRequest{} }
+func (m *SuggestCategoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*SuggestCategoriesRequest) ProtoMessage()    {}
+func (*SuggestCategoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{64}
+}
+func (m *SuggestCategoriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuggestCategoriesRequest.Unmarshal(m, b)
+}
+func (m *SuggestCategoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuggestCategoriesRequest.Marshal(b, m, deterministic)
+}
+func (dst *SuggestCategoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestCategoriesRequest.Merge(dst, src)
+}
+func (m *SuggestCategoriesRequest) XXX_Size() int {
+	return xxx_messageInfo_SuggestCategoriesRequest.Size(m)
+}
+func (m *SuggestCategoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestCategoriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestCategoriesRequest proto.InternalMessageInfo
+
+func (m *SuggestCategoriesRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *SuggestCategoriesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SuggestCategoriesRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type SuggestCategoriesResponse struct {
+	Categories           []*SingleCategory `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SuggestCategoriesResponse) Reset()         { *m = SuggestCategoriesResponse{} }
+func (m *SuggestCategoriesResponse) String() string { return proto.CompactTextString(m) }
+func (*SuggestCategoriesResponse) ProtoMessage()    {}
+func (*SuggestCategoriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{65}
+}
+func (m *SuggestCategoriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuggestCategoriesResponse.Unmarshal(m, b)
+}
+func (m *SuggestCategoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuggestCategoriesResponse.Marshal(b, m, deterministic)
+}
+func (dst *SuggestCategoriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestCategoriesResponse.Merge(dst, src)
+}
+func (m *SuggestCategoriesResponse) XXX_Size() int {
+	return xxx_messageInfo_SuggestCategoriesResponse.Size(m)
+}
+func (m *SuggestCategoriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestCategoriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestCategoriesResponse proto.InternalMessageInfo
+
+func (m *SuggestCategoriesResponse) GetCategories() []*SingleCategory {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+type GetCategoryInfoRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCategoryInfoRequest) Reset()         { *m = GetCategoryInfoRequest{} }
+func (m *GetCategoryInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCategoryInfoRequest) ProtoMessage()    {}
+func (*GetCategoryInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{66}
+}
+func (m *GetCategoryInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCategoryInfoRequest.Unmarshal(m, b)
+}
+func (m *GetCategoryInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCategoryInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetCategoryInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCategoryInfoRequest.Merge(dst, src)
+}
+func (m *GetCategoryInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCategoryInfoRequest.Size(m)
+}
+func (m *GetCategoryInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCategoryInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCategoryInfoRequest proto.InternalMessageInfo
+
+func (m *GetCategoryInfoRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *GetCategoryInfoRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type GetCategoryBySlugRequest struct {
+	Slug                 string   `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCategoryBySlugRequest) Reset()         { *m = GetCategoryBySlugRequest{} }
+func (m *GetCategoryBySlugRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCategoryBySlugRequest) ProtoMessage()    {}
+func (*GetCategoryBySlugRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{67}
+}
+func (m *GetCategoryBySlugRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCategoryBySlugRequest.Unmarshal(m, b)
+}
+func (m *GetCategoryBySlugRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCategoryBySlugRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetCategoryBySlugRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCategoryBySlugRequest.Merge(dst, src)
+}
+func (m *GetCategoryBySlugRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCategoryBySlugRequest.Size(m)
+}
+func (m *GetCategoryBySlugRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCategoryBySlugRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCategoryBySlugRequest proto.InternalMessageInfo
+
+func (m *GetCategoryBySlugRequest) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *GetCategoryBySlugRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type CreateRuleRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	Title                string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRuleRequest) Reset()         { *m = CreateRuleRequest{} }
+func (m *CreateRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRuleRequest) ProtoMessage()    {}
+func (*CreateRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{68}
+}
+func (m *CreateRuleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRuleRequest.Unmarshal(m, b)
+}
+func (m *CreateRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRuleRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRuleRequest.Merge(dst, src)
+}
+func (m *CreateRuleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRuleRequest.Size(m)
+}
+func (m *CreateRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRuleRequest proto.InternalMessageInfo
+
+func (m *CreateRuleRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type SingleRule struct {
+	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CategoryUid          string               `protobuf:"bytes,2,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	Position             int32                `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Title                string               `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string               `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleRule) Reset()         { *m = SingleRule{} }
+func (m *SingleRule) String() string { return proto.CompactTextString(m) }
+func (*SingleRule) ProtoMessage()    {}
+func (*SingleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{69}
+}
+func (m *SingleRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleRule.Unmarshal(m, b)
+}
+func (m *SingleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleRule.Marshal(b, m, deterministic)
+}
+func (dst *SingleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleRule.Merge(dst, src)
+}
+func (m *SingleRule) XXX_Size() int {
+	return xxx_messageInfo_SingleRule.Size(m)
+}
+func (m *SingleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleRule proto.InternalMessageInfo
+
+func (m *SingleRule) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SingleRule) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleRule) GetPosition() int32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *SingleRule) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SingleRule) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SingleRule) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type UpdateRuleRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	Title                string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateRuleRequest) Reset()         { *m = UpdateRuleRequest{} }
+func (m *UpdateRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRuleRequest) ProtoMessage()    {}
+func (*UpdateRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{70}
+}
+func (m *UpdateRuleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRuleRequest.Unmarshal(m, b)
+}
+func (m *UpdateRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRuleRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRuleRequest.Merge(dst, src)
+}
+func (m *UpdateRuleRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateRuleRequest.Size(m)
+}
+func (m *UpdateRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRuleRequest proto.InternalMessageInfo
+
+func (m *UpdateRuleRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type ReorderRulesRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	RuleUids             []string `protobuf:"bytes,3,rep,name=ruleUids,proto3" json:"ruleUids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReorderRulesRequest) Reset()         { *m = ReorderRulesRequest{} }
+func (m *ReorderRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ReorderRulesRequest) ProtoMessage()    {}
+func (*ReorderRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{71}
+}
+func (m *ReorderRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReorderRulesRequest.Unmarshal(m, b)
+}
+func (m *ReorderRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReorderRulesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReorderRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorderRulesRequest.Merge(dst, src)
+}
+func (m *ReorderRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_ReorderRulesRequest.Size(m)
+}
+func (m *ReorderRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorderRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorderRulesRequest proto.InternalMessageInfo
+
+func (m *ReorderRulesRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ReorderRulesRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *ReorderRulesRequest) GetRuleUids() []string {
+	if m != nil {
+		return m.RuleUids
+	}
+	return nil
+}
+
+type ListRulesRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRulesRequest) Reset()         { *m = ListRulesRequest{} }
+func (m *ListRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRulesRequest) ProtoMessage()    {}
+func (*ListRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{72}
+}
+func (m *ListRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRulesRequest.Unmarshal(m, b)
+}
+func (m *ListRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRulesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRulesRequest.Merge(dst, src)
+}
+func (m *ListRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRulesRequest.Size(m)
+}
+func (m *ListRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRulesRequest proto.InternalMessageInfo
+
+func (m *ListRulesRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ListRulesRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type ListRulesResponse struct {
+	Rules                []*SingleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListRulesResponse) Reset()         { *m = ListRulesResponse{} }
+func (m *ListRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRulesResponse) ProtoMessage()    {}
+func (*ListRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{73}
+}
+func (m *ListRulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRulesResponse.Unmarshal(m, b)
+}
+func (m *ListRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRulesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRulesResponse.Merge(dst, src)
+}
+func (m *ListRulesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRulesResponse.Size(m)
+}
+func (m *ListRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRulesResponse proto.InternalMessageInfo
+
+func (m *ListRulesResponse) GetRules() []*SingleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type ListReportsRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	IncludeDescendants   bool     `protobuf:"varint,4,opt,name=includeDescendants,proto3" json:"includeDescendants,omitempty"`
+	RuleUid              string   `protobuf:"bytes,5,opt,name=ruleUid,proto3" json:"ruleUid,omitempty"`
+	GroupByRule          bool     `protobuf:"varint,6,opt,name=groupByRule,proto3" json:"groupByRule,omitempty"`
+	AssigneeUid          string   `protobuf:"bytes,7,opt,name=assigneeUid,proto3" json:"assigneeUid,omitempty"`
+	UnassignedOnly       bool     `protobuf:"varint,8,opt,name=unassignedOnly,proto3" json:"unassignedOnly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReportsRequest) Reset()         { *m = ListReportsRequest{} }
+func (m *ListReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReportsRequest) ProtoMessage()    {}
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{74}
+}
+func (m *ListReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReportsRequest.Unmarshal(m, b)
+}
+func (m *ListReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReportsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReportsRequest.Merge(dst, src)
+}
+func (m *ListReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReportsRequest.Size(m)
+}
+func (m *ListReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReportsRequest proto.InternalMessageInfo
+
+func (m *ListReportsRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ListReportsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListReportsRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+func (m *ListReportsRequest) GetIncludeDescendants() bool {
+	if m != nil {
+		return m.IncludeDescendants
+	}
+	return false
+}
+
+func (m *ListReportsRequest) GetRuleUid() string {
+	if m != nil {
+		return m.RuleUid
+	}
+	return ""
+}
+
+func (m *ListReportsRequest) GetGroupByRule() bool {
+	if m != nil {
+		return m.GroupByRule
+	}
+	return false
+}
+
+func (m *ListReportsRequest) GetAssigneeUid() string {
+	if m != nil {
+		return m.AssigneeUid
+	}
+	return ""
+}
+
+func (m *ListReportsRequest) GetUnassignedOnly() bool {
+	if m != nil {
+		return m.UnassignedOnly
+	}
+	return false
+}
+
+type RuleReportCount struct {
+	RuleUid              string   `protobuf:"bytes,1,opt,name=ruleUid,proto3" json:"ruleUid,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuleReportCount) Reset()         { *m = RuleReportCount{} }
+func (m *RuleReportCount) String() string { return proto.CompactTextString(m) }
+func (*RuleReportCount) ProtoMessage()    {}
+func (*RuleReportCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{75}
+}
+func (m *RuleReportCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuleReportCount.Unmarshal(m, b)
+}
+func (m *RuleReportCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuleReportCount.Marshal(b, m, deterministic)
+}
+func (dst *RuleReportCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleReportCount.Merge(dst, src)
+}
+func (m *RuleReportCount) XXX_Size() int {
+	return xxx_messageInfo_RuleReportCount.Size(m)
+}
+func (m *RuleReportCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleReportCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleReportCount proto.InternalMessageInfo
+
+func (m *RuleReportCount) GetRuleUid() string {
+	if m != nil {
+		return m.RuleUid
+	}
+	return ""
+}
+
+func (m *RuleReportCount) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RuleReportCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ListReportsResponse struct {
+	Reports              []*SingleReport    `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	PageSize             int32              `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32              `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	RuleCounts           []*RuleReportCount `protobuf:"bytes,4,rep,name=ruleCounts,proto3" json:"ruleCounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListReportsResponse) Reset()         { *m = ListReportsResponse{} }
+func (m *ListReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReportsResponse) ProtoMessage()    {}
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{76}
+}
+func (m *ListReportsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReportsResponse.Unmarshal(m, b)
+}
+func (m *ListReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReportsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReportsResponse.Merge(dst, src)
+}
+func (m *ListReportsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListReportsResponse.Size(m)
+}
+func (m *ListReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReportsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReportsResponse proto.InternalMessageInfo
+
+func (m *ListReportsResponse) GetReports() []*SingleReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+func (m *ListReportsResponse) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListReportsResponse) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+func (m *ListReportsResponse) GetRuleCounts() []*RuleReportCount {
+	if m != nil {
+		return m.RuleCounts
+	}
+	return nil
+}
+
+type CreateReportRequest struct {
+	CategoryUid          string     `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	PostUid              string     `protobuf:"bytes,2,opt,name=postUid,proto3" json:"postUid,omitempty"`
+	CommentUid           string     `protobuf:"bytes,3,opt,name=commentUid,proto3" json:"commentUid,omitempty"`
+	Reason               string     `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RuleUid              string     `protobuf:"bytes,5,opt,name=ruleUid,proto3" json:"ruleUid,omitempty"`
+	ReasonCode           ReasonCode `protobuf:"varint,6,opt,name=reasonCode,proto3,enum=category.ReasonCode" json:"reasonCode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CreateReportRequest) Reset()         { *m = CreateReportRequest{} }
+func (m *CreateReportRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateReportRequest) ProtoMessage()    {}
+func (*CreateReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{77}
+}
+func (m *CreateReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateReportRequest.Unmarshal(m, b)
+}
+func (m *CreateReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReportRequest.Merge(dst, src)
+}
+func (m *CreateReportRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateReportRequest.Size(m)
+}
+func (m *CreateReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateReportRequest proto.InternalMessageInfo
+
+func (m *CreateReportRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *CreateReportRequest) GetPostUid() string {
+	if m != nil {
+		return m.PostUid
+	}
+	return ""
+}
+
+func (m *CreateReportRequest) GetCommentUid() string {
+	if m != nil {
+		return m.CommentUid
+	}
+	return ""
+}
+
+func (m *CreateReportRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CreateReportRequest) GetRuleUid() string {
+	if m != nil {
+		return m.RuleUid
+	}
+	return ""
+}
+
+func (m *CreateReportRequest) GetReasonCode() ReasonCode {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return ReasonCode_OTHER
+}
+
+type SingleReport struct {
+	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CategoryUid          string               `protobuf:"bytes,2,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	PostUid              string               `protobuf:"bytes,3,opt,name=postUid,proto3" json:"postUid,omitempty"`
+	CommentUid           string               `protobuf:"bytes,4,opt,name=commentUid,proto3" json:"commentUid,omitempty"`
+	Reason               string               `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	RuleUid              string               `protobuf:"bytes,7,opt,name=ruleUid,proto3" json:"ruleUid,omitempty"`
+	ReasonCode           ReasonCode           `protobuf:"varint,8,opt,name=reasonCode,proto3,enum=category.ReasonCode" json:"reasonCode,omitempty"`
+	Severity             Severity             `protobuf:"varint,9,opt,name=severity,proto3,enum=category.Severity" json:"severity,omitempty"`
+	Routing              Routing              `protobuf:"varint,10,opt,name=routing,proto3,enum=category.Routing" json:"routing,omitempty"`
+	AssigneeUid          string               `protobuf:"bytes,11,opt,name=assigneeUid,proto3" json:"assigneeUid,omitempty"`
+	ClaimExpiresAt       *timestamp.Timestamp `protobuf:"bytes,12,opt,name=claimExpiresAt,proto3" json:"claimExpiresAt,omitempty"`
+	NoteCount            int32                `protobuf:"varint,13,opt,name=noteCount,proto3" json:"noteCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleReport) Reset()         { *m = SingleReport{} }
+func (m *SingleReport) String() string { return proto.CompactTextString(m) }
+func (*SingleReport) ProtoMessage()    {}
+func (*SingleReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{78}
+}
+func (m *SingleReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleReport.Unmarshal(m, b)
+}
+func (m *SingleReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleReport.Marshal(b, m, deterministic)
+}
+func (dst *SingleReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleReport.Merge(dst, src)
+}
+func (m *SingleReport) XXX_Size() int {
+	return xxx_messageInfo_SingleReport.Size(m)
+}
+func (m *SingleReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleReport proto.InternalMessageInfo
+
+func (m *SingleReport) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SingleReport) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleReport) GetPostUid() string {
+	if m != nil {
+		return m.PostUid
+	}
+	return ""
+}
+
+func (m *SingleReport) GetCommentUid() string {
+	if m != nil {
+		return m.CommentUid
+	}
+	return ""
+}
+
+func (m *SingleReport) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SingleReport) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *SingleReport) GetRuleUid() string {
+	if m != nil {
+		return m.RuleUid
+	}
+	return ""
+}
+
+func (m *SingleReport) GetReasonCode() ReasonCode {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return ReasonCode_OTHER
+}
+
+func (m *SingleReport) GetSeverity() Severity {
+	if m != nil {
+		return m.Severity
+	}
+	return Severity_LOW
+}
+
+func (m *SingleReport) GetRouting() Routing {
+	if m != nil {
+		return m.Routing
+	}
+	return Routing_CATEGORY_OWNER
+}
+
+func (m *SingleReport) GetAssigneeUid() string {
+	if m != nil {
+		return m.AssigneeUid
+	}
+	return ""
+}
+
+func (m *SingleReport) GetClaimExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ClaimExpiresAt
+	}
+	return nil
+}
+
+func (m *SingleReport) GetNoteCount() int32 {
+	if m != nil {
+		return m.NoteCount
+	}
+	return 0
+}
+
+type DeleteReportRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ModeratorUid         string   `protobuf:"bytes,2,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteReportRequest) Reset()         { *m = DeleteReportRequest{} }
+func (m *DeleteReportRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteReportRequest) ProtoMessage()    {}
+func (*DeleteReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{79}
+}
+func (m *DeleteReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteReportRequest.Unmarshal(m, b)
+}
+func (m *DeleteReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReportRequest.Merge(dst, src)
+}
+func (m *DeleteReportRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteReportRequest.Size(m)
+}
+func (m *DeleteReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteReportRequest proto.InternalMessageInfo
+
+func (m *DeleteReportRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *DeleteReportRequest) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+type DeleteReportResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteReportResponse) Reset()         { *m = DeleteReportResponse{} }
+func (m *DeleteReportResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteReportResponse) ProtoMessage()    {}
+func (*DeleteReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{80}
+}
+func (m *DeleteReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteReportResponse.Unmarshal(m, b)
+}
+func (m *DeleteReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteReportResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReportResponse.Merge(dst, src)
+}
+func (m *DeleteReportResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteReportResponse.Size(m)
+}
+func (m *DeleteReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteReportResponse proto.InternalMessageInfo
+
+type ListReasonCodesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReasonCodesRequest) Reset()         { *m = ListReasonCodesRequest{} }
+func (m *ListReasonCodesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReasonCodesRequest) ProtoMessage()    {}
+func (*ListReasonCodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{81}
+}
+func (m *ListReasonCodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReasonCodesRequest.Unmarshal(m, b)
+}
+func (m *ListReasonCodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReasonCodesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListReasonCodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReasonCodesRequest.Merge(dst, src)
+}
+func (m *ListReasonCodesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReasonCodesRequest.Size(m)
+}
+func (m *ListReasonCodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReasonCodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReasonCodesRequest proto.InternalMessageInfo
+
+type SingleReasonCode struct {
+	Code                 ReasonCode `protobuf:"varint,1,opt,name=code,proto3,enum=category.ReasonCode" json:"code,omitempty"`
+	Severity             Severity   `protobuf:"varint,2,opt,name=severity,proto3,enum=category.Severity" json:"severity,omitempty"`
+	Routing              Routing    `protobuf:"varint,3,opt,name=routing,proto3,enum=category.Routing" json:"routing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SingleReasonCode) Reset()         { *m = SingleReasonCode{} }
+func (m *SingleReasonCode) String() string { return proto.CompactTextString(m) }
+func (*SingleReasonCode) ProtoMessage()    {}
+func (*SingleReasonCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{82}
+}
+func (m *SingleReasonCode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleReasonCode.Unmarshal(m, b)
+}
+func (m *SingleReasonCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleReasonCode.Marshal(b, m, deterministic)
+}
+func (dst *SingleReasonCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleReasonCode.Merge(dst, src)
+}
+func (m *SingleReasonCode) XXX_Size() int {
+	return xxx_messageInfo_SingleReasonCode.Size(m)
+}
+func (m *SingleReasonCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleReasonCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleReasonCode proto.InternalMessageInfo
+
+func (m *SingleReasonCode) GetCode() ReasonCode {
+	if m != nil {
+		return m.Code
+	}
+	return ReasonCode_OTHER
+}
+
+func (m *SingleReasonCode) GetSeverity() Severity {
+	if m != nil {
+		return m.Severity
+	}
+	return Severity_LOW
+}
+
+func (m *SingleReasonCode) GetRouting() Routing {
+	if m != nil {
+		return m.Routing
+	}
+	return Routing_CATEGORY_OWNER
+}
+
+type ListReasonCodesResponse struct {
+	ReasonCodes          []*SingleReasonCode `protobuf:"bytes,1,rep,name=reasonCodes,proto3" json:"reasonCodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListReasonCodesResponse) Reset()         { *m = ListReasonCodesResponse{} }
+func (m *ListReasonCodesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReasonCodesResponse) ProtoMessage()    {}
+func (*ListReasonCodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{83}
+}
+func (m *ListReasonCodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReasonCodesResponse.Unmarshal(m, b)
+}
+func (m *ListReasonCodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReasonCodesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListReasonCodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReasonCodesResponse.Merge(dst, src)
+}
+func (m *ListReasonCodesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListReasonCodesResponse.Size(m)
+}
+func (m *ListReasonCodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReasonCodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReasonCodesResponse proto.InternalMessageInfo
+
+func (m *ListReasonCodesResponse) GetReasonCodes() []*SingleReasonCode {
+	if m != nil {
+		return m.ReasonCodes
+	}
+	return nil
+}
+
+type ListAdminReportsRequest struct {
+	PageSize             int32    `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,2,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAdminReportsRequest) Reset()         { *m = ListAdminReportsRequest{} }
+func (m *ListAdminReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAdminReportsRequest) ProtoMessage()    {}
+func (*ListAdminReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{84}
+}
+func (m *ListAdminReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAdminReportsRequest.Unmarshal(m, b)
+}
+func (m *ListAdminReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAdminReportsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListAdminReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAdminReportsRequest.Merge(dst, src)
+}
+func (m *ListAdminReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAdminReportsRequest.Size(m)
+}
+func (m *ListAdminReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAdminReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAdminReportsRequest proto.InternalMessageInfo
+
+func (m *ListAdminReportsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListAdminReportsRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type ListAllReportsRequest struct {
+	PageSize             int32                `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32                `protobuf:"varint,2,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	CreatedAfter         *timestamp.Timestamp `protobuf:"bytes,3,opt,name=createdAfter,proto3" json:"createdAfter,omitempty"`
+	CreatedBefore        *timestamp.Timestamp `protobuf:"bytes,4,opt,name=createdBefore,proto3" json:"createdBefore,omitempty"`
+	ReasonText           string               `protobuf:"bytes,5,opt,name=reasonText,proto3" json:"reasonText,omitempty"`
+	CategoryUids         []string             `protobuf:"bytes,6,rep,name=categoryUids,proto3" json:"categoryUids,omitempty"`
+	PostUid              string               `protobuf:"bytes,7,opt,name=postUid,proto3" json:"postUid,omitempty"`
+	Order                ReportOrder          `protobuf:"varint,8,opt,name=order,proto3,enum=category.ReportOrder" json:"order,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListAllReportsRequest) Reset()         { *m = ListAllReportsRequest{} }
+func (m *ListAllReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAllReportsRequest) ProtoMessage()    {}
+func (*ListAllReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{85}
+}
+func (m *ListAllReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAllReportsRequest.Unmarshal(m, b)
+}
+func (m *ListAllReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAllReportsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListAllReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAllReportsRequest.Merge(dst, src)
+}
+func (m *ListAllReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAllReportsRequest.Size(m)
+}
+func (m *ListAllReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAllReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAllReportsRequest proto.InternalMessageInfo
+
+func (m *ListAllReportsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListAllReportsRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+func (m *ListAllReportsRequest) GetCreatedAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return nil
+}
+
+func (m *ListAllReportsRequest) GetCreatedBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
+func (m *ListAllReportsRequest) GetReasonText() string {
+	if m != nil {
+		return m.ReasonText
+	}
+	return ""
+}
+
+func (m *ListAllReportsRequest) GetCategoryUids() []string {
+	if m != nil {
+		return m.CategoryUids
+	}
+	return nil
+}
+
+func (m *ListAllReportsRequest) GetPostUid() string {
+	if m != nil {
+		return m.PostUid
+	}
+	return ""
+}
+
+func (m *ListAllReportsRequest) GetOrder() ReportOrder {
+	if m != nil {
+		return m.Order
+	}
+	return ReportOrder_NEWEST
+}
+
+type ClaimReportRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ModeratorUid         string   `protobuf:"bytes,2,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimReportRequest) Reset()         { *m = ClaimReportRequest{} }
+func (m *ClaimReportRequest) String() string { return proto.CompactTextString(m) }
+func (*ClaimReportRequest) ProtoMessage()    {}
+func (*ClaimReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{86}
+}
+func (m *ClaimReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClaimReportRequest.Unmarshal(m, b)
+}
+func (m *ClaimReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClaimReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *ClaimReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimReportRequest.Merge(dst, src)
+}
+func (m *ClaimReportRequest) XXX_Size() int {
+	return xxx_messageInfo_ClaimReportRequest.Size(m)
+}
+func (m *ClaimReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimReportRequest proto.InternalMessageInfo
+
+func (m *ClaimReportRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ClaimReportRequest) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+type ReleaseReportRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ModeratorUid         string   `protobuf:"bytes,2,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseReportRequest) Reset()         { *m = ReleaseReportRequest{} }
+func (m *ReleaseReportRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseReportRequest) ProtoMessage()    {}
+func (*ReleaseReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{87}
+}
+func (m *ReleaseReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseReportRequest.Unmarshal(m, b)
+}
+func (m *ReleaseReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReleaseReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseReportRequest.Merge(dst, src)
+}
+func (m *ReleaseReportRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseReportRequest.Size(m)
+}
+func (m *ReleaseReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseReportRequest proto.InternalMessageInfo
+
+func (m *ReleaseReportRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ReleaseReportRequest) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+type AssignReportRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	AssigneeUid          string   `protobuf:"bytes,2,opt,name=assigneeUid,proto3" json:"assigneeUid,omitempty"`
+	ExpectedAssigneeUid  string   `protobuf:"bytes,3,opt,name=expectedAssigneeUid,proto3" json:"expectedAssigneeUid,omitempty"`
+	ModeratorUid         string   `protobuf:"bytes,4,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssignReportRequest) Reset()         { *m = AssignReportRequest{} }
+func (m *AssignReportRequest) String() string { return proto.CompactTextString(m) }
+func (*AssignReportRequest) ProtoMessage()    {}
+func (*AssignReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{88}
+}
+func (m *AssignReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssignReportRequest.Unmarshal(m, b)
+}
+func (m *AssignReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssignReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *AssignReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignReportRequest.Merge(dst, src)
+}
+func (m *AssignReportRequest) XXX_Size() int {
+	return xxx_messageInfo_AssignReportRequest.Size(m)
+}
+func (m *AssignReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignReportRequest proto.InternalMessageInfo
+
+func (m *AssignReportRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *AssignReportRequest) GetAssigneeUid() string {
+	if m != nil {
+		return m.AssigneeUid
+	}
+	return ""
+}
+
+func (m *AssignReportRequest) GetExpectedAssigneeUid() string {
+	if m != nil {
+		return m.ExpectedAssigneeUid
+	}
+	return ""
+}
+
+func (m *AssignReportRequest) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+type SetAssignmentStrategyRequest struct {
+	CategoryUid          string             `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string             `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	Strategy             AssignmentStrategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=category.AssignmentStrategy" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SetAssignmentStrategyRequest) Reset()         { *m = SetAssignmentStrategyRequest{} }
+func (m *SetAssignmentStrategyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAssignmentStrategyRequest) ProtoMessage()    {}
+func (*SetAssignmentStrategyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{89}
+}
+func (m *SetAssignmentStrategyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAssignmentStrategyRequest.Unmarshal(m, b)
+}
+func (m *SetAssignmentStrategyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAssignmentStrategyRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetAssignmentStrategyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAssignmentStrategyRequest.Merge(dst, src)
+}
+func (m *SetAssignmentStrategyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAssignmentStrategyRequest.Size(m)
+}
+func (m *SetAssignmentStrategyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAssignmentStrategyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAssignmentStrategyRequest proto.InternalMessageInfo
+
+func (m *SetAssignmentStrategyRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SetAssignmentStrategyRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *SetAssignmentStrategyRequest) GetStrategy() AssignmentStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return AssignmentStrategy_MANUAL
+}
+
+type SetAssignmentStrategyResponse struct {
+	Strategy             AssignmentStrategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=category.AssignmentStrategy" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SetAssignmentStrategyResponse) Reset()         { *m = SetAssignmentStrategyResponse{} }
+func (m *SetAssignmentStrategyResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAssignmentStrategyResponse) ProtoMessage()    {}
+func (*SetAssignmentStrategyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{90}
+}
+func (m *SetAssignmentStrategyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAssignmentStrategyResponse.Unmarshal(m, b)
+}
+func (m *SetAssignmentStrategyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAssignmentStrategyResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetAssignmentStrategyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAssignmentStrategyResponse.Merge(dst, src)
+}
+func (m *SetAssignmentStrategyResponse) XXX_Size() int {
+	return xxx_messageInfo_SetAssignmentStrategyResponse.Size(m)
+}
+func (m *SetAssignmentStrategyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAssignmentStrategyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAssignmentStrategyResponse proto.InternalMessageInfo
+
+func (m *SetAssignmentStrategyResponse) GetStrategy() AssignmentStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return AssignmentStrategy_MANUAL
+}
+
+type AddReportHandlerRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	HandlerUid           string   `protobuf:"bytes,3,opt,name=handlerUid,proto3" json:"handlerUid,omitempty"`
+	Keywords             []string `protobuf:"bytes,4,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddReportHandlerRequest) Reset()         { *m = AddReportHandlerRequest{} }
+func (m *AddReportHandlerRequest) String() string { return proto.CompactTextString(m) }
+func (*AddReportHandlerRequest) ProtoMessage()    {}
+func (*AddReportHandlerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{91}
+}
+func (m *AddReportHandlerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddReportHandlerRequest.Unmarshal(m, b)
+}
+func (m *AddReportHandlerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddReportHandlerRequest.Marshal(b, m, deterministic)
+}
+func (dst *AddReportHandlerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddReportHandlerRequest.Merge(dst, src)
+}
+func (m *AddReportHandlerRequest) XXX_Size() int {
+	return xxx_messageInfo_AddReportHandlerRequest.Size(m)
+}
+func (m *AddReportHandlerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddReportHandlerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddReportHandlerRequest proto.InternalMessageInfo
+
+func (m *AddReportHandlerRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *AddReportHandlerRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *AddReportHandlerRequest) GetHandlerUid() string {
+	if m != nil {
+		return m.HandlerUid
+	}
+	return ""
+}
+
+func (m *AddReportHandlerRequest) GetKeywords() []string {
+	if m != nil {
+		return m.Keywords
+	}
+	return nil
+}
+
+type SingleReportHandler struct {
+	CategoryUid          string               `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string               `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	Away                 bool                 `protobuf:"varint,3,opt,name=away,proto3" json:"away,omitempty"`
+	Keywords             []string             `protobuf:"bytes,4,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	LastAssignedAt       *timestamp.Timestamp `protobuf:"bytes,5,opt,name=lastAssignedAt,proto3" json:"lastAssignedAt,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Load                 int64                `protobuf:"varint,7,opt,name=load,proto3" json:"load,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleReportHandler) Reset()         { *m = SingleReportHandler{} }
+func (m *SingleReportHandler) String() string { return proto.CompactTextString(m) }
+func (*SingleReportHandler) ProtoMessage()    {}
+func (*SingleReportHandler) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{92}
+}
+func (m *SingleReportHandler) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleReportHandler.Unmarshal(m, b)
+}
+func (m *SingleReportHandler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleReportHandler.Marshal(b, m, deterministic)
+}
+func (dst *SingleReportHandler) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleReportHandler.Merge(dst, src)
+}
+func (m *SingleReportHandler) XXX_Size() int {
+	return xxx_messageInfo_SingleReportHandler.Size(m)
+}
+func (m *SingleReportHandler) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleReportHandler.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleReportHandler proto.InternalMessageInfo
+
+func (m *SingleReportHandler) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleReportHandler) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *SingleReportHandler) GetAway() bool {
+	if m != nil {
+		return m.Away
+	}
+	return false
+}
+
+func (m *SingleReportHandler) GetKeywords() []string {
+	if m != nil {
+		return m.Keywords
+	}
+	return nil
+}
+
+func (m *SingleReportHandler) GetLastAssignedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastAssignedAt
+	}
+	return nil
+}
+
+func (m *SingleReportHandler) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *SingleReportHandler) GetLoad() int64 {
+	if m != nil {
+		return m.Load
+	}
+	return 0
+}
+
+type RemoveReportHandlerRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	HandlerUid           string   `protobuf:"bytes,3,opt,name=handlerUid,proto3" json:"handlerUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveReportHandlerRequest) Reset()         { *m = RemoveReportHandlerRequest{} }
+func (m *RemoveReportHandlerRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveReportHandlerRequest) ProtoMessage()    {}
+func (*RemoveReportHandlerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{93}
+}
+func (m *RemoveReportHandlerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveReportHandlerRequest.Unmarshal(m, b)
+}
+func (m *RemoveReportHandlerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveReportHandlerRequest.Marshal(b, m, deterministic)
+}
+func (dst *RemoveReportHandlerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveReportHandlerRequest.Merge(dst, src)
+}
+func (m *RemoveReportHandlerRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveReportHandlerRequest.Size(m)
+}
+func (m *RemoveReportHandlerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveReportHandlerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveReportHandlerRequest proto.InternalMessageInfo
+
+func (m *RemoveReportHandlerRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *RemoveReportHandlerRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *RemoveReportHandlerRequest) GetHandlerUid() string {
+	if m != nil {
+		return m.HandlerUid
+	}
+	return ""
+}
+
+type RemoveReportHandlerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveReportHandlerResponse) Reset()         { *m = RemoveReportHandlerResponse{} }
+func (m *RemoveReportHandlerResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveReportHandlerResponse) ProtoMessage()    {}
+func (*RemoveReportHandlerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{94}
+}
+func (m *RemoveReportHandlerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveReportHandlerResponse.Unmarshal(m, b)
+}
+func (m *RemoveReportHandlerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveReportHandlerResponse.Marshal(b, m, deterministic)
+}
+func (dst *RemoveReportHandlerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveReportHandlerResponse.Merge(dst, src)
+}
+func (m *RemoveReportHandlerResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveReportHandlerResponse.Size(m)
+}
+func (m *RemoveReportHandlerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveReportHandlerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveReportHandlerResponse proto.InternalMessageInfo
+
+type SetReportHandlerAwayRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	HandlerUid           string   `protobuf:"bytes,3,opt,name=handlerUid,proto3" json:"handlerUid,omitempty"`
+	Away                 bool     `protobuf:"varint,4,opt,name=away,proto3" json:"away,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetReportHandlerAwayRequest) Reset()         { *m = SetReportHandlerAwayRequest{} }
+func (m *SetReportHandlerAwayRequest) String() string { return proto.CompactTextString(m) }
+func (*SetReportHandlerAwayRequest) ProtoMessage()    {}
+func (*SetReportHandlerAwayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{95}
+}
+func (m *SetReportHandlerAwayRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetReportHandlerAwayRequest.Unmarshal(m, b)
+}
+func (m *SetReportHandlerAwayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetReportHandlerAwayRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetReportHandlerAwayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetReportHandlerAwayRequest.Merge(dst, src)
+}
+func (m *SetReportHandlerAwayRequest) XXX_Size() int {
+	return xxx_messageInfo_SetReportHandlerAwayRequest.Size(m)
+}
+func (m *SetReportHandlerAwayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetReportHandlerAwayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetReportHandlerAwayRequest proto.InternalMessageInfo
+
+func (m *SetReportHandlerAwayRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SetReportHandlerAwayRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *SetReportHandlerAwayRequest) GetHandlerUid() string {
+	if m != nil {
+		return m.HandlerUid
+	}
+	return ""
+}
+
+func (m *SetReportHandlerAwayRequest) GetAway() bool {
+	if m != nil {
+		return m.Away
+	}
+	return false
+}
+
+type ListReportHandlersRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReportHandlersRequest) Reset()         { *m = ListReportHandlersRequest{} }
+func (m *ListReportHandlersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReportHandlersRequest) ProtoMessage()    {}
+func (*ListReportHandlersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{96}
+}
+func (m *ListReportHandlersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReportHandlersRequest.Unmarshal(m, b)
+}
+func (m *ListReportHandlersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReportHandlersRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListReportHandlersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReportHandlersRequest.Merge(dst, src)
+}
+func (m *ListReportHandlersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReportHandlersRequest.Size(m)
+}
+func (m *ListReportHandlersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReportHandlersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReportHandlersRequest proto.InternalMessageInfo
+
+func (m *ListReportHandlersRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ListReportHandlersRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type ListReportHandlersResponse struct {
+	Handlers             []*SingleReportHandler `protobuf:"bytes,1,rep,name=handlers,proto3" json:"handlers,omitempty"`
+	Strategy             AssignmentStrategy     `protobuf:"varint,2,opt,name=strategy,proto3,enum=category.AssignmentStrategy" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ListReportHandlersResponse) Reset()         { *m = ListReportHandlersResponse{} }
+func (m *ListReportHandlersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReportHandlersResponse) ProtoMessage()    {}
+func (*ListReportHandlersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{97}
+}
+func (m *ListReportHandlersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReportHandlersResponse.Unmarshal(m, b)
+}
+func (m *ListReportHandlersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReportHandlersResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListReportHandlersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReportHandlersResponse.Merge(dst, src)
+}
+func (m *ListReportHandlersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListReportHandlersResponse.Size(m)
+}
+func (m *ListReportHandlersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReportHandlersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReportHandlersResponse proto.InternalMessageInfo
+
+func (m *ListReportHandlersResponse) GetHandlers() []*SingleReportHandler {
+	if m != nil {
+		return m.Handlers
+	}
+	return nil
+}
+
+func (m *ListReportHandlersResponse) GetStrategy() AssignmentStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return AssignmentStrategy_MANUAL
+}
+
+type CreateThresholdPolicyRequest struct {
+	CategoryUid          string             `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string             `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	ReportCount          int32              `protobuf:"varint,3,opt,name=reportCount,proto3" json:"reportCount,omitempty"`
+	Window               *duration.Duration `protobuf:"bytes,4,opt,name=window,proto3" json:"window,omitempty"`
+	ReasonCodes          []ReasonCode       `protobuf:"varint,5,rep,packed,name=reasonCodes,proto3,enum=category.ReasonCode" json:"reasonCodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CreateThresholdPolicyRequest) Reset()         { *m = CreateThresholdPolicyRequest{} }
+func (m *CreateThresholdPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateThresholdPolicyRequest) ProtoMessage()    {}
+func (*CreateThresholdPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{98}
+}
+func (m *CreateThresholdPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateThresholdPolicyRequest.Unmarshal(m, b)
+}
+func (m *CreateThresholdPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateThresholdPolicyRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateThresholdPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateThresholdPolicyRequest.Merge(dst, src)
+}
+func (m *CreateThresholdPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateThresholdPolicyRequest.Size(m)
+}
+func (m *CreateThresholdPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateThresholdPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateThresholdPolicyRequest proto.InternalMessageInfo
+
+func (m *CreateThresholdPolicyRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *CreateThresholdPolicyRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *CreateThresholdPolicyRequest) GetReportCount() int32 {
+	if m != nil {
+		return m.ReportCount
+	}
+	return 0
+}
+
+func (m *CreateThresholdPolicyRequest) GetWindow() *duration.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *CreateThresholdPolicyRequest) GetReasonCodes() []ReasonCode {
+	if m != nil {
+		return m.ReasonCodes
+	}
+	return nil
+}
+
+type SingleThresholdPolicy struct {
+	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CategoryUid          string               `protobuf:"bytes,2,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	ReportCount          int32                `protobuf:"varint,3,opt,name=reportCount,proto3" json:"reportCount,omitempty"`
+	Window               *duration.Duration   `protobuf:"bytes,4,opt,name=window,proto3" json:"window,omitempty"`
+	ReasonCodes          []ReasonCode         `protobuf:"varint,5,rep,packed,name=reasonCodes,proto3,enum=category.ReasonCode" json:"reasonCodes,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleThresholdPolicy) Reset()         { *m = SingleThresholdPolicy{} }
+func (m *SingleThresholdPolicy) String() string { return proto.CompactTextString(m) }
+func (*SingleThresholdPolicy) ProtoMessage()    {}
+func (*SingleThresholdPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{99}
+}
+func (m *SingleThresholdPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleThresholdPolicy.Unmarshal(m, b)
+}
+func (m *SingleThresholdPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleThresholdPolicy.Marshal(b, m, deterministic)
+}
+func (dst *SingleThresholdPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleThresholdPolicy.Merge(dst, src)
+}
+func (m *SingleThresholdPolicy) XXX_Size() int {
+	return xxx_messageInfo_SingleThresholdPolicy.Size(m)
+}
+func (m *SingleThresholdPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleThresholdPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleThresholdPolicy proto.InternalMessageInfo
+
+func (m *SingleThresholdPolicy) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SingleThresholdPolicy) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleThresholdPolicy) GetReportCount() int32 {
+	if m != nil {
+		return m.ReportCount
+	}
+	return 0
+}
+
+func (m *SingleThresholdPolicy) GetWindow() *duration.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *SingleThresholdPolicy) GetReasonCodes() []ReasonCode {
+	if m != nil {
+		return m.ReasonCodes
+	}
+	return nil
+}
+
+func (m *SingleThresholdPolicy) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type DeleteThresholdPolicyRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteThresholdPolicyRequest) Reset()         { *m = DeleteThresholdPolicyRequest{} }
+func (m *DeleteThresholdPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteThresholdPolicyRequest) ProtoMessage()    {}
+func (*DeleteThresholdPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{100}
+}
+func (m *DeleteThresholdPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteThresholdPolicyRequest.Unmarshal(m, b)
+}
+func (m *DeleteThresholdPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteThresholdPolicyRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteThresholdPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteThresholdPolicyRequest.Merge(dst, src)
+}
+func (m *DeleteThresholdPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteThresholdPolicyRequest.Size(m)
+}
+func (m *DeleteThresholdPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteThresholdPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteThresholdPolicyRequest proto.InternalMessageInfo
+
+func (m *DeleteThresholdPolicyRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *DeleteThresholdPolicyRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type DeleteThresholdPolicyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteThresholdPolicyResponse) Reset()         { *m = DeleteThresholdPolicyResponse{} }
+func (m *DeleteThresholdPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteThresholdPolicyResponse) ProtoMessage()    {}
+func (*DeleteThresholdPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{101}
+}
+func (m *DeleteThresholdPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteThresholdPolicyResponse.Unmarshal(m, b)
+}
+func (m *DeleteThresholdPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteThresholdPolicyResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteThresholdPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteThresholdPolicyResponse.Merge(dst, src)
+}
+func (m *DeleteThresholdPolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteThresholdPolicyResponse.Size(m)
+}
+func (m *DeleteThresholdPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteThresholdPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteThresholdPolicyResponse proto.InternalMessageInfo
+
+type ListThresholdPoliciesRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListThresholdPoliciesRequest) Reset()         { *m = ListThresholdPoliciesRequest{} }
+func (m *ListThresholdPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListThresholdPoliciesRequest) ProtoMessage()    {}
+func (*ListThresholdPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{102}
+}
+func (m *ListThresholdPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListThresholdPoliciesRequest.Unmarshal(m, b)
+}
+func (m *ListThresholdPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListThresholdPoliciesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListThresholdPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListThresholdPoliciesRequest.Merge(dst, src)
+}
+func (m *ListThresholdPoliciesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListThresholdPoliciesRequest.Size(m)
+}
+func (m *ListThresholdPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListThresholdPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListThresholdPoliciesRequest proto.InternalMessageInfo
+
+func (m *ListThresholdPoliciesRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ListThresholdPoliciesRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type ListThresholdPoliciesResponse struct {
+	Policies             []*SingleThresholdPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ListThresholdPoliciesResponse) Reset()         { *m = ListThresholdPoliciesResponse{} }
+func (m *ListThresholdPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListThresholdPoliciesResponse) ProtoMessage()    {}
+func (*ListThresholdPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{103}
+}
+func (m *ListThresholdPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListThresholdPoliciesResponse.Unmarshal(m, b)
+}
+func (m *ListThresholdPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListThresholdPoliciesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListThresholdPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListThresholdPoliciesResponse.Merge(dst, src)
+}
+func (m *ListThresholdPoliciesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListThresholdPoliciesResponse.Size(m)
+}
+func (m *ListThresholdPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListThresholdPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListThresholdPoliciesResponse proto.InternalMessageInfo
+
+func (m *ListThresholdPoliciesResponse) GetPolicies() []*SingleThresholdPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type ListAutoActionsRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	UserUid              string   `protobuf:"bytes,4,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAutoActionsRequest) Reset()         { *m = ListAutoActionsRequest{} }
+func (m *ListAutoActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAutoActionsRequest) ProtoMessage()    {}
+func (*ListAutoActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{104}
+}
+func (m *ListAutoActionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAutoActionsRequest.Unmarshal(m, b)
+}
+func (m *ListAutoActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAutoActionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListAutoActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAutoActionsRequest.Merge(dst, src)
+}
+func (m *ListAutoActionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAutoActionsRequest.Size(m)
+}
+func (m *ListAutoActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAutoActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAutoActionsRequest proto.InternalMessageInfo
+
+func (m *ListAutoActionsRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ListAutoActionsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListAutoActionsRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+func (m *ListAutoActionsRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type SingleAutoAction struct {
+	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CategoryUid          string               `protobuf:"bytes,2,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	PostUid              string               `protobuf:"bytes,3,opt,name=postUid,proto3" json:"postUid,omitempty"`
+	PolicyUid            string               `protobuf:"bytes,4,opt,name=policyUid,proto3" json:"policyUid,omitempty"`
+	ReportCount          int32                `protobuf:"varint,5,opt,name=reportCount,proto3" json:"reportCount,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ReviewedAt           *timestamp.Timestamp `protobuf:"bytes,7,opt,name=reviewedAt,proto3" json:"reviewedAt,omitempty"`
+	ReviewerUid          string               `protobuf:"bytes,8,opt,name=reviewerUid,proto3" json:"reviewerUid,omitempty"`
+	KeptHidden           bool                 `protobuf:"varint,9,opt,name=keptHidden,proto3" json:"keptHidden,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleAutoAction) Reset()         { *m = SingleAutoAction{} }
+func (m *SingleAutoAction) String() string { return proto.CompactTextString(m) }
+func (*SingleAutoAction) ProtoMessage()    {}
+func (*SingleAutoAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{105}
+}
+func (m *SingleAutoAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleAutoAction.Unmarshal(m, b)
+}
+func (m *SingleAutoAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleAutoAction.Marshal(b, m, deterministic)
+}
+func (dst *SingleAutoAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleAutoAction.Merge(dst, src)
+}
+func (m *SingleAutoAction) XXX_Size() int {
+	return xxx_messageInfo_SingleAutoAction.Size(m)
+}
+func (m *SingleAutoAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleAutoAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleAutoAction proto.InternalMessageInfo
+
+func (m *SingleAutoAction) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SingleAutoAction) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleAutoAction) GetPostUid() string {
+	if m != nil {
+		return m.PostUid
+	}
+	return ""
+}
+
+func (m *SingleAutoAction) GetPolicyUid() string {
+	if m != nil {
+		return m.PolicyUid
+	}
+	return ""
+}
+
+func (m *SingleAutoAction) GetReportCount() int32 {
+	if m != nil {
+		return m.ReportCount
+	}
+	return 0
+}
+
+func (m *SingleAutoAction) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *SingleAutoAction) GetReviewedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReviewedAt
+	}
+	return nil
+}
+
+func (m *SingleAutoAction) GetReviewerUid() string {
+	if m != nil {
+		return m.ReviewerUid
+	}
+	return ""
+}
+
+func (m *SingleAutoAction) GetKeptHidden() bool {
+	if m != nil {
+		return m.KeptHidden
+	}
+	return false
+}
+
+type ListAutoActionsResponse struct {
+	AutoActions          []*SingleAutoAction `protobuf:"bytes,1,rep,name=autoActions,proto3" json:"autoActions,omitempty"`
+	PageSize             int32               `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32               `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListAutoActionsResponse) Reset()         { *m = ListAutoActionsResponse{} }
+func (m *ListAutoActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAutoActionsResponse) ProtoMessage()    {}
+func (*ListAutoActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{106}
+}
+func (m *ListAutoActionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAutoActionsResponse.Unmarshal(m, b)
+}
+func (m *ListAutoActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAutoActionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListAutoActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAutoActionsResponse.Merge(dst, src)
+}
+func (m *ListAutoActionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAutoActionsResponse.Size(m)
+}
+func (m *ListAutoActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAutoActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAutoActionsResponse proto.InternalMessageInfo
+
+func (m *ListAutoActionsResponse) GetAutoActions() []*SingleAutoAction {
+	if m != nil {
+		return m.AutoActions
+	}
+	return nil
+}
+
+func (m *ListAutoActionsResponse) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListAutoActionsResponse) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type ReviewAutoActionRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	KeepHidden           bool     `protobuf:"varint,3,opt,name=keepHidden,proto3" json:"keepHidden,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReviewAutoActionRequest) Reset()         { *m = ReviewAutoActionRequest{} }
+func (m *ReviewAutoActionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReviewAutoActionRequest) ProtoMessage()    {}
+func (*ReviewAutoActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{107}
+}
+func (m *ReviewAutoActionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviewAutoActionRequest.Unmarshal(m, b)
+}
+func (m *ReviewAutoActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviewAutoActionRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReviewAutoActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewAutoActionRequest.Merge(dst, src)
+}
+func (m *ReviewAutoActionRequest) XXX_Size() int {
+	return xxx_messageInfo_ReviewAutoActionRequest.Size(m)
+}
+func (m *ReviewAutoActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewAutoActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewAutoActionRequest proto.InternalMessageInfo
+
+func (m *ReviewAutoActionRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ReviewAutoActionRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *ReviewAutoActionRequest) GetKeepHidden() bool {
+	if m != nil {
+		return m.KeepHidden
+	}
+	return false
+}
+
+type ListEventsRequest struct {
+	AfterId              int64    `protobuf:"varint,1,opt,name=afterId,proto3" json:"afterId,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEventsRequest) Reset()         { *m = ListEventsRequest{} }
+func (m *ListEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEventsRequest) ProtoMessage()    {}
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{108}
+}
+func (m *ListEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsRequest.Unmarshal(m, b)
+}
+func (m *ListEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsRequest.Merge(dst, src)
+}
+func (m *ListEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEventsRequest.Size(m)
+}
+func (m *ListEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEventsRequest proto.InternalMessageInfo
+
+func (m *ListEventsRequest) GetAfterId() int64 {
+	if m != nil {
+		return m.AfterId
+	}
+	return 0
+}
+
+func (m *ListEventsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SingleEvent struct {
+	Id                   int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                 string               `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Payload              string               `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleEvent) Reset()         { *m = SingleEvent{} }
+func (m *SingleEvent) String() string { return proto.CompactTextString(m) }
+func (*SingleEvent) ProtoMessage()    {}
+func (*SingleEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{109}
+}
+func (m *SingleEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleEvent.Unmarshal(m, b)
+}
+func (m *SingleEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleEvent.Marshal(b, m, deterministic)
+}
+func (dst *SingleEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleEvent.Merge(dst, src)
+}
+func (m *SingleEvent) XXX_Size() int {
+	return xxx_messageInfo_SingleEvent.Size(m)
+}
+func (m *SingleEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleEvent proto.InternalMessageInfo
+
+func (m *SingleEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SingleEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *SingleEvent) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *SingleEvent) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ListEventsResponse struct {
+	Events               []*SingleEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEventsResponse) Reset()         { *m = ListEventsResponse{} }
+func (m *ListEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEventsResponse) ProtoMessage()    {}
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{110}
+}
+func (m *ListEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsResponse.Unmarshal(m, b)
+}
+func (m *ListEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsResponse.Merge(dst, src)
+}
+func (m *ListEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEventsResponse.Size(m)
+}
+func (m *ListEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEventsResponse proto.InternalMessageInfo
+
+func (m *ListEventsResponse) GetEvents() []*SingleEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type AddReportNoteRequest struct {
+	ReportUid            string   `protobuf:"bytes,1,opt,name=reportUid,proto3" json:"reportUid,omitempty"`
+	AuthorUid            string   `protobuf:"bytes,2,opt,name=authorUid,proto3" json:"authorUid,omitempty"`
+	ParentUid            string   `protobuf:"bytes,3,opt,name=parentUid,proto3" json:"parentUid,omitempty"`
+	Text                 string   `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddReportNoteRequest) Reset()         { *m = AddReportNoteRequest{} }
+func (m *AddReportNoteRequest) String() string { return proto.CompactTextString(m) }
+func (*AddReportNoteRequest) ProtoMessage()    {}
+func (*AddReportNoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{111}
+}
+func (m *AddReportNoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddReportNoteRequest.Unmarshal(m, b)
+}
+func (m *AddReportNoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddReportNoteRequest.Marshal(b, m, deterministic)
+}
+func (dst *AddReportNoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddReportNoteRequest.Merge(dst, src)
+}
+func (m *AddReportNoteRequest) XXX_Size() int {
+	return xxx_messageInfo_AddReportNoteRequest.Size(m)
+}
+func (m *AddReportNoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddReportNoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddReportNoteRequest proto.InternalMessageInfo
+
+func (m *AddReportNoteRequest) GetReportUid() string {
+	if m != nil {
+		return m.ReportUid
+	}
+	return ""
+}
+
+func (m *AddReportNoteRequest) GetAuthorUid() string {
+	if m != nil {
+		return m.AuthorUid
+	}
+	return ""
+}
+
+func (m *AddReportNoteRequest) GetParentUid() string {
+	if m != nil {
+		return m.ParentUid
+	}
+	return ""
+}
+
+func (m *AddReportNoteRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type SingleReportNote struct {
+	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ReportUid            string               `protobuf:"bytes,2,opt,name=reportUid,proto3" json:"reportUid,omitempty"`
+	ParentUid            string               `protobuf:"bytes,3,opt,name=parentUid,proto3" json:"parentUid,omitempty"`
+	AuthorUid            string               `protobuf:"bytes,4,opt,name=authorUid,proto3" json:"authorUid,omitempty"`
+	Text                 string               `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleReportNote) Reset()         { *m = SingleReportNote{} }
+func (m *SingleReportNote) String() string { return proto.CompactTextString(m) }
+func (*SingleReportNote) ProtoMessage()    {}
+func (*SingleReportNote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{112}
+}
+func (m *SingleReportNote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleReportNote.Unmarshal(m, b)
+}
+func (m *SingleReportNote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleReportNote.Marshal(b, m, deterministic)
+}
+func (dst *SingleReportNote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleReportNote.Merge(dst, src)
+}
+func (m *SingleReportNote) XXX_Size() int {
+	return xxx_messageInfo_SingleReportNote.Size(m)
+}
+func (m *SingleReportNote) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleReportNote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleReportNote proto.InternalMessageInfo
+
+func (m *SingleReportNote) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SingleReportNote) GetReportUid() string {
+	if m != nil {
+		return m.ReportUid
+	}
+	return ""
+}
+
+func (m *SingleReportNote) GetParentUid() string {
+	if m != nil {
+		return m.ParentUid
+	}
+	return ""
+}
+
+func (m *SingleReportNote) GetAuthorUid() string {
+	if m != nil {
+		return m.AuthorUid
+	}
+	return ""
+}
+
+func (m *SingleReportNote) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *SingleReportNote) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ListReportNotesRequest struct {
+	ReportUid            string   `protobuf:"bytes,1,opt,name=reportUid,proto3" json:"reportUid,omitempty"`
+	UserUid              string   `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReportNotesRequest) Reset()         { *m = ListReportNotesRequest{} }
+func (m *ListReportNotesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReportNotesRequest) ProtoMessage()    {}
+func (*ListReportNotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{113}
+}
+func (m *ListReportNotesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReportNotesRequest.Unmarshal(m, b)
+}
+func (m *ListReportNotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReportNotesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListReportNotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReportNotesRequest.Merge(dst, src)
+}
+func (m *ListReportNotesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReportNotesRequest.Size(m)
+}
+func (m *ListReportNotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReportNotesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReportNotesRequest proto.InternalMessageInfo
+
+func (m *ListReportNotesRequest) GetReportUid() string {
+	if m != nil {
+		return m.ReportUid
+	}
+	return ""
+}
+
+func (m *ListReportNotesRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type ListReportNotesResponse struct {
+	Notes                []*SingleReportNote `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	ReportDeleted        bool                `protobuf:"varint,2,opt,name=reportDeleted,proto3" json:"reportDeleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListReportNotesResponse) Reset()         { *m = ListReportNotesResponse{} }
+func (m *ListReportNotesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReportNotesResponse) ProtoMessage()    {}
+func (*ListReportNotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{114}
+}
+func (m *ListReportNotesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReportNotesResponse.Unmarshal(m, b)
+}
+func (m *ListReportNotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReportNotesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListReportNotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReportNotesResponse.Merge(dst, src)
+}
+func (m *ListReportNotesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListReportNotesResponse.Size(m)
+}
+func (m *ListReportNotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReportNotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReportNotesResponse proto.InternalMessageInfo
+
+func (m *ListReportNotesResponse) GetNotes() []*SingleReportNote {
+	if m != nil {
+		return m.Notes
+	}
+	return nil
+}
+
+func (m *ListReportNotesResponse) GetReportDeleted() bool {
+	if m != nil {
+		return m.ReportDeleted
+	}
+	return false
+}
+
+type ResolveReportRequest struct {
+	Uid                  string             `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ModeratorUid         string             `protobuf:"bytes,2,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	Outcome              Outcome            `protobuf:"varint,3,opt,name=outcome,proto3,enum=category.Outcome" json:"outcome,omitempty"`
+	BanDuration          *duration.Duration `protobuf:"bytes,4,opt,name=banDuration,proto3" json:"banDuration,omitempty"`
+	Justification        string             `protobuf:"bytes,5,opt,name=justification,proto3" json:"justification,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ResolveReportRequest) Reset()         { *m = ResolveReportRequest{} }
+func (m *ResolveReportRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveReportRequest) ProtoMessage()    {}
+func (*ResolveReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{115}
+}
+func (m *ResolveReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveReportRequest.Unmarshal(m, b)
+}
+func (m *ResolveReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *ResolveReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveReportRequest.Merge(dst, src)
+}
+func (m *ResolveReportRequest) XXX_Size() int {
+	return xxx_messageInfo_ResolveReportRequest.Size(m)
+}
+func (m *ResolveReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveReportRequest proto.InternalMessageInfo
+
+func (m *ResolveReportRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ResolveReportRequest) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+func (m *ResolveReportRequest) GetOutcome() Outcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return Outcome_NO_VIOLATION
+}
+
+func (m *ResolveReportRequest) GetBanDuration() *duration.Duration {
+	if m != nil {
+		return m.BanDuration
+	}
+	return nil
+}
+
+func (m *ResolveReportRequest) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
+}
+
+type SingleReportOutcome struct {
+	ReportUid            string               `protobuf:"bytes,1,opt,name=reportUid,proto3" json:"reportUid,omitempty"`
+	CategoryUid          string               `protobuf:"bytes,2,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	PostUid              string               `protobuf:"bytes,3,opt,name=postUid,proto3" json:"postUid,omitempty"`
+	CommentUid           string               `protobuf:"bytes,4,opt,name=commentUid,proto3" json:"commentUid,omitempty"`
+	ReasonCode           ReasonCode           `protobuf:"varint,5,opt,name=reasonCode,proto3,enum=category.ReasonCode" json:"reasonCode,omitempty"`
+	ModeratorUid         string               `protobuf:"bytes,6,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	Outcome              Outcome              `protobuf:"varint,7,opt,name=outcome,proto3,enum=category.Outcome" json:"outcome,omitempty"`
+	BanDuration          *duration.Duration   `protobuf:"bytes,8,opt,name=banDuration,proto3" json:"banDuration,omitempty"`
+	Justification        string               `protobuf:"bytes,9,opt,name=justification,proto3" json:"justification,omitempty"`
+	ReportedAt           *timestamp.Timestamp `protobuf:"bytes,10,opt,name=reportedAt,proto3" json:"reportedAt,omitempty"`
+	ResolvedAt           *timestamp.Timestamp `protobuf:"bytes,11,opt,name=resolvedAt,proto3" json:"resolvedAt,omitempty"`
+	AutoAction           *SingleAutoAction    `protobuf:"bytes,12,opt,name=autoAction,proto3" json:"autoAction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleReportOutcome) Reset()         { *m = SingleReportOutcome{} }
+func (m *SingleReportOutcome) String() string { return proto.CompactTextString(m) }
+func (*SingleReportOutcome) ProtoMessage()    {}
+func (*SingleReportOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{116}
+}
+func (m *SingleReportOutcome) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleReportOutcome.Unmarshal(m, b)
+}
+func (m *SingleReportOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleReportOutcome.Marshal(b, m, deterministic)
+}
+func (dst *SingleReportOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleReportOutcome.Merge(dst, src)
+}
+func (m *SingleReportOutcome) XXX_Size() int {
+	return xxx_messageInfo_SingleReportOutcome.Size(m)
+}
+func (m *SingleReportOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleReportOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleReportOutcome proto.InternalMessageInfo
+
+func (m *SingleReportOutcome) GetReportUid() string {
+	if m != nil {
+		return m.ReportUid
+	}
+	return ""
+}
+
+func (m *SingleReportOutcome) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleReportOutcome) GetPostUid() string {
+	if m != nil {
+		return m.PostUid
+	}
+	return ""
+}
+
+func (m *SingleReportOutcome) GetCommentUid() string {
+	if m != nil {
+		return m.CommentUid
+	}
+	return ""
+}
+
+func (m *SingleReportOutcome) GetReasonCode() ReasonCode {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return ReasonCode_OTHER
+}
+
+func (m *SingleReportOutcome) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+func (m *SingleReportOutcome) GetOutcome() Outcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return Outcome_NO_VIOLATION
+}
+
+func (m *SingleReportOutcome) GetBanDuration() *duration.Duration {
+	if m != nil {
+		return m.BanDuration
+	}
+	return nil
+}
+
+func (m *SingleReportOutcome) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
+}
+
+func (m *SingleReportOutcome) GetReportedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReportedAt
+	}
+	return nil
+}
+
+func (m *SingleReportOutcome) GetResolvedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ResolvedAt
+	}
+	return nil
+}
+
+func (m *SingleReportOutcome) GetAutoAction() *SingleAutoAction {
+	if m != nil {
+		return m.AutoAction
+	}
+	return nil
+}
+
+type ListReportOutcomesRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	UserUid              string   `protobuf:"bytes,4,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReportOutcomesRequest) Reset()         { *m = ListReportOutcomesRequest{} }
+func (m *ListReportOutcomesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReportOutcomesRequest) ProtoMessage()    {}
+func (*ListReportOutcomesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{117}
+}
+func (m *ListReportOutcomesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReportOutcomesRequest.Unmarshal(m, b)
+}
+func (m *ListReportOutcomesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReportOutcomesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListReportOutcomesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReportOutcomesRequest.Merge(dst, src)
+}
+func (m *ListReportOutcomesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReportOutcomesRequest.Size(m)
+}
+func (m *ListReportOutcomesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReportOutcomesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReportOutcomesRequest proto.InternalMessageInfo
+
+func (m *ListReportOutcomesRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ListReportOutcomesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListReportOutcomesRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+func (m *ListReportOutcomesRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type ListReportOutcomesResponse struct {
+	Outcomes             []*SingleReportOutcome `protobuf:"bytes,1,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	PageSize             int32                  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32                  `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ListReportOutcomesResponse) Reset()         { *m = ListReportOutcomesResponse{} }
+func (m *ListReportOutcomesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReportOutcomesResponse) ProtoMessage()    {}
+func (*ListReportOutcomesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{118}
+}
+func (m *ListReportOutcomesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReportOutcomesResponse.Unmarshal(m, b)
+}
+func (m *ListReportOutcomesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReportOutcomesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListReportOutcomesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReportOutcomesResponse.Merge(dst, src)
+}
+func (m *ListReportOutcomesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListReportOutcomesResponse.Size(m)
+}
+func (m *ListReportOutcomesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReportOutcomesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReportOutcomesResponse proto.InternalMessageInfo
+
+func (m *ListReportOutcomesResponse) GetOutcomes() []*SingleReportOutcome {
+	if m != nil {
+		return m.Outcomes
+	}
+	return nil
+}
+
+func (m *ListReportOutcomesResponse) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListReportOutcomesResponse) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type DeleteReportsRequest struct {
+	Uids                 []string `protobuf:"bytes,1,rep,name=uids,proto3" json:"uids,omitempty"`
+	DryRun               bool     `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	ModeratorUid         string   `protobuf:"bytes,3,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteReportsRequest) Reset()         { *m = DeleteReportsRequest{} }
+func (m *DeleteReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteReportsRequest) ProtoMessage()    {}
+func (*DeleteReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{119}
+}
+func (m *DeleteReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteReportsRequest.Unmarshal(m, b)
+}
+func (m *DeleteReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteReportsRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReportsRequest.Merge(dst, src)
+}
+func (m *DeleteReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteReportsRequest.Size(m)
+}
+func (m *DeleteReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteReportsRequest proto.InternalMessageInfo
+
+func (m *DeleteReportsRequest) GetUids() []string {
+	if m != nil {
+		return m.Uids
+	}
+	return nil
+}
+
+func (m *DeleteReportsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *DeleteReportsRequest) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+type DeleteReportsByPostRequest struct {
+	PostUid              string   `protobuf:"bytes,1,opt,name=postUid,proto3" json:"postUid,omitempty"`
+	DryRun               bool     `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	ModeratorUid         string   `protobuf:"bytes,3,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteReportsByPostRequest) Reset()         { *m = DeleteReportsByPostRequest{} }
+func (m *DeleteReportsByPostRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteReportsByPostRequest) ProtoMessage()    {}
+func (*DeleteReportsByPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{120}
+}
+func (m *DeleteReportsByPostRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteReportsByPostRequest.Unmarshal(m, b)
+}
+func (m *DeleteReportsByPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteReportsByPostRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteReportsByPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReportsByPostRequest.Merge(dst, src)
+}
+func (m *DeleteReportsByPostRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteReportsByPostRequest.Size(m)
+}
+func (m *DeleteReportsByPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReportsByPostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteReportsByPostRequest proto.InternalMessageInfo
+
+func (m *DeleteReportsByPostRequest) GetPostUid() string {
+	if m != nil {
+		return m.PostUid
+	}
+	return ""
+}
+
+func (m *DeleteReportsByPostRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *DeleteReportsByPostRequest) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+type DeleteReportsByFilterRequest struct {
+	CategoryUids         []string             `protobuf:"bytes,1,rep,name=categoryUids,proto3" json:"categoryUids,omitempty"`
+	CreatedAfter         *timestamp.Timestamp `protobuf:"bytes,2,opt,name=createdAfter,proto3" json:"createdAfter,omitempty"`
+	CreatedBefore        *timestamp.Timestamp `protobuf:"bytes,3,opt,name=createdBefore,proto3" json:"createdBefore,omitempty"`
+	ReasonText           string               `protobuf:"bytes,4,opt,name=reasonText,proto3" json:"reasonText,omitempty"`
+	DryRun               bool                 `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	ModeratorUid         string               `protobuf:"bytes,6,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeleteReportsByFilterRequest) Reset()         { *m = DeleteReportsByFilterRequest{} }
+func (m *DeleteReportsByFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteReportsByFilterRequest) ProtoMessage()    {}
+func (*DeleteReportsByFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{121}
+}
+func (m *DeleteReportsByFilterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteReportsByFilterRequest.Unmarshal(m, b)
+}
+func (m *DeleteReportsByFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteReportsByFilterRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteReportsByFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReportsByFilterRequest.Merge(dst, src)
+}
+func (m *DeleteReportsByFilterRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteReportsByFilterRequest.Size(m)
+}
+func (m *DeleteReportsByFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReportsByFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteReportsByFilterRequest proto.InternalMessageInfo
+
+func (m *DeleteReportsByFilterRequest) GetCategoryUids() []string {
+	if m != nil {
+		return m.CategoryUids
+	}
+	return nil
+}
+
+func (m *DeleteReportsByFilterRequest) GetCreatedAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return nil
+}
+
+func (m *DeleteReportsByFilterRequest) GetCreatedBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
+func (m *DeleteReportsByFilterRequest) GetReasonText() string {
+	if m != nil {
+		return m.ReasonText
+	}
+	return ""
+}
+
+func (m *DeleteReportsByFilterRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *DeleteReportsByFilterRequest) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+type BulkReportResult struct {
+	Uid                  string           `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Status               BulkReportStatus `protobuf:"varint,2,opt,name=status,proto3,enum=category.BulkReportStatus" json:"status,omitempty"`
+	Report               *SingleReport    `protobuf:"bytes,3,opt,name=report,proto3" json:"report,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BulkReportResult) Reset()         { *m = BulkReportResult{} }
+func (m *BulkReportResult) String() string { return proto.CompactTextString(m) }
+func (*BulkReportResult) ProtoMessage()    {}
+func (*BulkReportResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{122}
+}
+func (m *BulkReportResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkReportResult.Unmarshal(m, b)
+}
+func (m *BulkReportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkReportResult.Marshal(b, m, deterministic)
+}
+func (dst *BulkReportResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkReportResult.Merge(dst, src)
+}
+func (m *BulkReportResult) XXX_Size() int {
+	return xxx_messageInfo_BulkReportResult.Size(m)
+}
+func (m *BulkReportResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkReportResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkReportResult proto.InternalMessageInfo
+
+func (m *BulkReportResult) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *BulkReportResult) GetStatus() BulkReportStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BulkReportStatus_DELETED
+}
+
+func (m *BulkReportResult) GetReport() *SingleReport {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
+type BulkDeleteReportsResponse struct {
+	Results              []*BulkReportResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	DryRun               bool                `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	DeletedCount         int32               `protobuf:"varint,3,opt,name=deletedCount,proto3" json:"deletedCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *BulkDeleteReportsResponse) Reset()         { *m = BulkDeleteReportsResponse{} }
+func (m *BulkDeleteReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*BulkDeleteReportsResponse) ProtoMessage()    {}
+func (*BulkDeleteReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{123}
+}
+func (m *BulkDeleteReportsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkDeleteReportsResponse.Unmarshal(m, b)
+}
+func (m *BulkDeleteReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkDeleteReportsResponse.Marshal(b, m, deterministic)
+}
+func (dst *BulkDeleteReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkDeleteReportsResponse.Merge(dst, src)
+}
+func (m *BulkDeleteReportsResponse) XXX_Size() int {
+	return xxx_messageInfo_BulkDeleteReportsResponse.Size(m)
+}
+func (m *BulkDeleteReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkDeleteReportsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkDeleteReportsResponse proto.InternalMessageInfo
+
+func (m *BulkDeleteReportsResponse) GetResults() []*BulkReportResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *BulkDeleteReportsResponse) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *BulkDeleteReportsResponse) GetDeletedCount() int32 {
+	if m != nil {
+		return m.DeletedCount
+	}
+	return 0
+}
+
+type SetRetentionPolicyRequest struct {
+	CategoryUid          string             `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	Action               RetentionAction    `protobuf:"varint,2,opt,name=action,proto3,enum=category.RetentionAction" json:"action,omitempty"`
+	MaxAge               *duration.Duration `protobuf:"bytes,3,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SetRetentionPolicyRequest) Reset()         { *m = SetRetentionPolicyRequest{} }
+func (m *SetRetentionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRetentionPolicyRequest) ProtoMessage()    {}
+func (*SetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{124}
+}
+func (m *SetRetentionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRetentionPolicyRequest.Unmarshal(m, b)
+}
+func (m *SetRetentionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRetentionPolicyRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetRetentionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRetentionPolicyRequest.Merge(dst, src)
+}
+func (m *SetRetentionPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetRetentionPolicyRequest.Size(m)
+}
+func (m *SetRetentionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRetentionPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRetentionPolicyRequest proto.InternalMessageInfo
+
+func (m *SetRetentionPolicyRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SetRetentionPolicyRequest) GetAction() RetentionAction {
+	if m != nil {
+		return m.Action
+	}
+	return RetentionAction_PURGE
+}
+
+func (m *SetRetentionPolicyRequest) GetMaxAge() *duration.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return nil
+}
+
+type SingleRetentionPolicy struct {
+	CategoryUid          string               `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	Action               RetentionAction      `protobuf:"varint,2,opt,name=action,proto3,enum=category.RetentionAction" json:"action,omitempty"`
+	MaxAge               *duration.Duration   `protobuf:"bytes,3,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleRetentionPolicy) Reset()         { *m = SingleRetentionPolicy{} }
+func (m *SingleRetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*SingleRetentionPolicy) ProtoMessage()    {}
+func (*SingleRetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{125}
+}
+func (m *SingleRetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleRetentionPolicy.Unmarshal(m, b)
+}
+func (m *SingleRetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleRetentionPolicy.Marshal(b, m, deterministic)
+}
+func (dst *SingleRetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleRetentionPolicy.Merge(dst, src)
+}
+func (m *SingleRetentionPolicy) XXX_Size() int {
+	return xxx_messageInfo_SingleRetentionPolicy.Size(m)
+}
+func (m *SingleRetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleRetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleRetentionPolicy proto.InternalMessageInfo
+
+func (m *SingleRetentionPolicy) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleRetentionPolicy) GetAction() RetentionAction {
+	if m != nil {
+		return m.Action
+	}
+	return RetentionAction_PURGE
+}
+
+func (m *SingleRetentionPolicy) GetMaxAge() *duration.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return nil
+}
+
+func (m *SingleRetentionPolicy) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type DeleteRetentionPolicyRequest struct {
+	CategoryUid          string   `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRetentionPolicyRequest) Reset()         { *m = DeleteRetentionPolicyRequest{} }
+func (m *DeleteRetentionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRetentionPolicyRequest) ProtoMessage()    {}
+func (*DeleteRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{126}
+}
+func (m *DeleteRetentionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRetentionPolicyRequest.Unmarshal(m, b)
+}
+func (m *DeleteRetentionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRetentionPolicyRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteRetentionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRetentionPolicyRequest.Merge(dst, src)
+}
+func (m *DeleteRetentionPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRetentionPolicyRequest.Size(m)
+}
+func (m *DeleteRetentionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRetentionPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRetentionPolicyRequest proto.InternalMessageInfo
+
+func (m *DeleteRetentionPolicyRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+type DeleteRetentionPolicyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRetentionPolicyResponse) Reset()         { *m = DeleteRetentionPolicyResponse{} }
+func (m *DeleteRetentionPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteRetentionPolicyResponse) ProtoMessage()    {}
+func (*DeleteRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{127}
+}
+func (m *DeleteRetentionPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRetentionPolicyResponse.Unmarshal(m, b)
+}
+func (m *DeleteRetentionPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRetentionPolicyResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteRetentionPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRetentionPolicyResponse.Merge(dst, src)
+}
+func (m *DeleteRetentionPolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteRetentionPolicyResponse.Size(m)
+}
+func (m *DeleteRetentionPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRetentionPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRetentionPolicyResponse proto.InternalMessageInfo
+
+type ListRetentionPoliciesRequest struct {
+	PageSize             int32    `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32    `protobuf:"varint,2,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRetentionPoliciesRequest) Reset()         { *m = ListRetentionPoliciesRequest{} }
+func (m *ListRetentionPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRetentionPoliciesRequest) ProtoMessage()    {}
+func (*ListRetentionPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{128}
+}
+func (m *ListRetentionPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRetentionPoliciesRequest.Unmarshal(m, b)
+}
+func (m *ListRetentionPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRetentionPoliciesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListRetentionPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRetentionPoliciesRequest.Merge(dst, src)
+}
+func (m *ListRetentionPoliciesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRetentionPoliciesRequest.Size(m)
+}
+func (m *ListRetentionPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRetentionPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRetentionPoliciesRequest proto.InternalMessageInfo
+
+func (m *ListRetentionPoliciesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListRetentionPoliciesRequest) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type ListRetentionPoliciesResponse struct {
+	Policies             []*SingleRetentionPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	PageSize             int32                    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber           int32                    `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ListRetentionPoliciesResponse) Reset()         { *m = ListRetentionPoliciesResponse{} }
+func (m *ListRetentionPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRetentionPoliciesResponse) ProtoMessage()    {}
+func (*ListRetentionPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{129}
+}
+func (m *ListRetentionPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRetentionPoliciesResponse.Unmarshal(m, b)
+}
+func (m *ListRetentionPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRetentionPoliciesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListRetentionPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRetentionPoliciesResponse.Merge(dst, src)
+}
+func (m *ListRetentionPoliciesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRetentionPoliciesResponse.Size(m)
+}
+func (m *ListRetentionPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRetentionPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRetentionPoliciesResponse proto.InternalMessageInfo
+
+func (m *ListRetentionPoliciesResponse) GetPolicies() []*SingleRetentionPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *ListRetentionPoliciesResponse) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListRetentionPoliciesResponse) GetPageNumber() int32 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type PreviewRetentionRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreviewRetentionRequest) Reset()         { *m = PreviewRetentionRequest{} }
+func (m *PreviewRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*PreviewRetentionRequest) ProtoMessage()    {}
+func (*PreviewRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{130}
+}
+func (m *PreviewRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewRetentionRequest.Unmarshal(m, b)
+}
+func (m *PreviewRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewRetentionRequest.Marshal(b, m, deterministic)
+}
+func (dst *PreviewRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewRetentionRequest.Merge(dst, src)
+}
+func (m *PreviewRetentionRequest) XXX_Size() int {
+	return xxx_messageInfo_PreviewRetentionRequest.Size(m)
+}
+func (m *PreviewRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewRetentionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewRetentionRequest proto.InternalMessageInfo
+
+type SingleRetentionPreview struct {
+	CategoryUid          string               `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	Action               RetentionAction      `protobuf:"varint,2,opt,name=action,proto3,enum=category.RetentionAction" json:"action,omitempty"`
+	ReportCount          int64                `protobuf:"varint,3,opt,name=reportCount,proto3" json:"reportCount,omitempty"`
+	OldestCreatedAt      *timestamp.Timestamp `protobuf:"bytes,4,opt,name=oldestCreatedAt,proto3" json:"oldestCreatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SingleRetentionPreview) Reset()         { *m = SingleRetentionPreview{} }
+func (m *SingleRetentionPreview) String() string { return proto.CompactTextString(m) }
+func (*SingleRetentionPreview) ProtoMessage()    {}
+func (*SingleRetentionPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{131}
+}
+func (m *SingleRetentionPreview) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SingleRetentionPreview.Unmarshal(m, b)
+}
+func (m *SingleRetentionPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SingleRetentionPreview.Marshal(b, m, deterministic)
+}
+func (dst *SingleRetentionPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleRetentionPreview.Merge(dst, src)
+}
+func (m *SingleRetentionPreview) XXX_Size() int {
+	return xxx_messageInfo_SingleRetentionPreview.Size(m)
+}
+func (m *SingleRetentionPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleRetentionPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleRetentionPreview proto.InternalMessageInfo
+
+func (m *SingleRetentionPreview) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *SingleRetentionPreview) GetAction() RetentionAction {
+	if m != nil {
+		return m.Action
+	}
+	return RetentionAction_PURGE
+}
+
+func (m *SingleRetentionPreview) GetReportCount() int64 {
+	if m != nil {
+		return m.ReportCount
+	}
+	return 0
+}
+
+func (m *SingleRetentionPreview) GetOldestCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.OldestCreatedAt
+	}
+	return nil
+}
+
+type PreviewRetentionResponse struct {
+	Categories           []*SingleRetentionPreview `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	PurgeCount           int64                     `protobuf:"varint,2,opt,name=purgeCount,proto3" json:"purgeCount,omitempty"`
+	AnonymizeCount       int64                     `protobuf:"varint,3,opt,name=anonymizeCount,proto3" json:"anonymizeCount,omitempty"`
+	ArchivedNoteCount    int64                     `protobuf:"varint,4,opt,name=archivedNoteCount,proto3" json:"archivedNoteCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *PreviewRetentionResponse) Reset()         { *m = PreviewRetentionResponse{} }
+func (m *PreviewRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*PreviewRetentionResponse) ProtoMessage()    {}
+func (*PreviewRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{132}
+}
+func (m *PreviewRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewRetentionResponse.Unmarshal(m, b)
+}
+func (m *PreviewRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewRetentionResponse.Marshal(b, m, deterministic)
+}
+func (dst *PreviewRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewRetentionResponse.Merge(dst, src)
+}
+func (m *PreviewRetentionResponse) XXX_Size() int {
+	return xxx_messageInfo_PreviewRetentionResponse.Size(m)
+}
+func (m *PreviewRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewRetentionResponse proto.InternalMessageInfo
+
+func (m *PreviewRetentionResponse) GetCategories() []*SingleRetentionPreview {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *PreviewRetentionResponse) GetPurgeCount() int64 {
+	if m != nil {
+		return m.PurgeCount
+	}
+	return 0
+}
+
+func (m *PreviewRetentionResponse) GetAnonymizeCount() int64 {
+	if m != nil {
+		return m.AnonymizeCount
+	}
+	return 0
+}
+
+func (m *PreviewRetentionResponse) GetArchivedNoteCount() int64 {
+	if m != nil {
+		return m.ArchivedNoteCount
+	}
+	return 0
+}
+
+type ExportReportsRequest struct {
+	CategoryUid          string               `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	CreatedAfter         *timestamp.Timestamp `protobuf:"bytes,2,opt,name=createdAfter,proto3" json:"createdAfter,omitempty"`
+	CreatedBefore        *timestamp.Timestamp `protobuf:"bytes,3,opt,name=createdBefore,proto3" json:"createdBefore,omitempty"`
+	Format               ExportFormat         `protobuf:"varint,4,opt,name=format,proto3,enum=category.ExportFormat" json:"format,omitempty"`
+	UserUid              string               `protobuf:"bytes,5,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ExportReportsRequest) Reset()         { *m = ExportReportsRequest{} }
+func (m *ExportReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportReportsRequest) ProtoMessage()    {}
+func (*ExportReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{133}
+}
+func (m *ExportReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportReportsRequest.Unmarshal(m, b)
+}
+func (m *ExportReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportReportsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportReportsRequest.Merge(dst, src)
+}
+func (m *ExportReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportReportsRequest.Size(m)
+}
+func (m *ExportReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportReportsRequest proto.InternalMessageInfo
+
+func (m *ExportReportsRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ExportReportsRequest) GetCreatedAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return nil
+}
+
+func (m *ExportReportsRequest) GetCreatedBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
+func (m *ExportReportsRequest) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_CSV
+}
+
+func (m *ExportReportsRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+type ExportReportsChunk struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportReportsChunk) Reset()         { *m = ExportReportsChunk{} }
+func (m *ExportReportsChunk) String() string { return proto.CompactTextString(m) }
+func (*ExportReportsChunk) ProtoMessage()    {}
+func (*ExportReportsChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{134}
+}
+func (m *ExportReportsChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportReportsChunk.Unmarshal(m, b)
+}
+func (m *ExportReportsChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportReportsChunk.Marshal(b, m, deterministic)
+}
+func (dst *ExportReportsChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportReportsChunk.Merge(dst, src)
+}
+func (m *ExportReportsChunk) XXX_Size() int {
+	return xxx_messageInfo_ExportReportsChunk.Size(m)
+}
+func (m *ExportReportsChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportReportsChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportReportsChunk proto.InternalMessageInfo
+
+func (m *ExportReportsChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type WatchReportsRequest struct {
+	CategoryUids         []string `protobuf:"bytes,1,rep,name=categoryUids,proto3" json:"categoryUids,omitempty"`
+	ResumeToken          string   `protobuf:"bytes,2,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchReportsRequest) Reset()         { *m = WatchReportsRequest{} }
+func (m *WatchReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchReportsRequest) ProtoMessage()    {}
+func (*WatchReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{135}
+}
+func (m *WatchReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchReportsRequest.Unmarshal(m, b)
+}
+func (m *WatchReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchReportsRequest.Marshal(b, m, deterministic)
+}
+func (dst *WatchReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchReportsRequest.Merge(dst, src)
+}
+func (m *WatchReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchReportsRequest.Size(m)
+}
+func (m *WatchReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchReportsRequest proto.InternalMessageInfo
+
+func (m *WatchReportsRequest) GetCategoryUids() []string {
+	if m != nil {
+		return m.CategoryUids
+	}
+	return nil
+}
+
+func (m *WatchReportsRequest) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return ""
+}
+
+type ReportEvent struct {
+	ResumeToken          string               `protobuf:"bytes,1,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+	Type                 ReportEventType      `protobuf:"varint,2,opt,name=type,proto3,enum=category.ReportEventType" json:"type,omitempty"`
+	ReportUid            string               `protobuf:"bytes,3,opt,name=reportUid,proto3" json:"reportUid,omitempty"`
+	CategoryUid          string               `protobuf:"bytes,4,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	Report               *SingleReport        `protobuf:"bytes,5,opt,name=report,proto3" json:"report,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReportEvent) Reset()         { *m = ReportEvent{} }
+func (m *ReportEvent) String() string { return proto.CompactTextString(m) }
+func (*ReportEvent) ProtoMessage()    {}
+func (*ReportEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{136}
+}
+func (m *ReportEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportEvent.Unmarshal(m, b)
+}
+func (m *ReportEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportEvent.Marshal(b, m, deterministic)
+}
+func (dst *ReportEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportEvent.Merge(dst, src)
+}
+func (m *ReportEvent) XXX_Size() int {
+	return xxx_messageInfo_ReportEvent.Size(m)
+}
+func (m *ReportEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportEvent proto.InternalMessageInfo
+
+func (m *ReportEvent) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return ""
+}
+
+func (m *ReportEvent) GetType() ReportEventType {
+	if m != nil {
+		return m.Type
+	}
+	return ReportEventType_REPORT_CREATED
+}
+
+func (m *ReportEvent) GetReportUid() string {
+	if m != nil {
+		return m.ReportUid
+	}
+	return ""
+}
+
+func (m *ReportEvent) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *ReportEvent) GetReport() *SingleReport {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
+func (m *ReportEvent) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type GetModerationStatsRequest struct {
+	CategoryUid          string               `protobuf:"bytes,1,opt,name=categoryUid,proto3" json:"categoryUid,omitempty"`
+	UserUid              string               `protobuf:"bytes,2,opt,name=userUid,proto3" json:"userUid,omitempty"`
+	From                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetModerationStatsRequest) Reset()         { *m = GetModerationStatsRequest{} }
+func (m *GetModerationStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetModerationStatsRequest) ProtoMessage()    {}
+func (*GetModerationStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{137}
+}
+func (m *GetModerationStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetModerationStatsRequest.Unmarshal(m, b)
+}
+func (m *GetModerationStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetModerationStatsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetModerationStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetModerationStatsRequest.Merge(dst, src)
+}
+func (m *GetModerationStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetModerationStatsRequest.Size(m)
+}
+func (m *GetModerationStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetModerationStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetModerationStatsRequest proto.InternalMessageInfo
+
+func (m *GetModerationStatsRequest) GetCategoryUid() string {
+	if m != nil {
+		return m.CategoryUid
+	}
+	return ""
+}
+
+func (m *GetModerationStatsRequest) GetUserUid() string {
+	if m != nil {
+		return m.UserUid
+	}
+	return ""
+}
+
+func (m *GetModerationStatsRequest) GetFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *GetModerationStatsRequest) GetTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+type ModeratorStats struct {
+	ModeratorUid         string             `protobuf:"bytes,1,opt,name=moderatorUid,proto3" json:"moderatorUid,omitempty"`
+	HandledCount         int64              `protobuf:"varint,2,opt,name=handledCount,proto3" json:"handledCount,omitempty"`
+	MedianTimeToHandle   *duration.Duration `protobuf:"bytes,3,opt,name=medianTimeToHandle,proto3" json:"medianTimeToHandle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ModeratorStats) Reset()         { *m = ModeratorStats{} }
+func (m *ModeratorStats) String() string { return proto.CompactTextString(m) }
+func (*ModeratorStats) ProtoMessage()    {}
+func (*ModeratorStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{138}
+}
+func (m *ModeratorStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModeratorStats.Unmarshal(m, b)
+}
+func (m *ModeratorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModeratorStats.Marshal(b, m, deterministic)
+}
+func (dst *ModeratorStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModeratorStats.Merge(dst, src)
+}
+func (m *ModeratorStats) XXX_Size() int {
+	return xxx_messageInfo_ModeratorStats.Size(m)
+}
+func (m *ModeratorStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModeratorStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModeratorStats proto.InternalMessageInfo
+
+func (m *ModeratorStats) GetModeratorUid() string {
+	if m != nil {
+		return m.ModeratorUid
+	}
+	return ""
+}
+
+func (m *ModeratorStats) GetHandledCount() int64 {
+	if m != nil {
+		return m.HandledCount
+	}
+	return 0
+}
+
+func (m *ModeratorStats) GetMedianTimeToHandle() *duration.Duration {
+	if m != nil {
+		return m.MedianTimeToHandle
+	}
+	return nil
+}
+
+type ModerationStats struct {
+	OpenReportCount      int64              `protobuf:"varint,1,opt,name=openReportCount,proto3" json:"openReportCount,omitempty"`
+	HandledCount         int64              `protobuf:"varint,2,opt,name=handledCount,proto3" json:"handledCount,omitempty"`
+	MedianTimeToHandle   *duration.Duration `protobuf:"bytes,3,opt,name=medianTimeToHandle,proto3" json:"medianTimeToHandle,omitempty"`
+	P95TimeToHandle      *duration.Duration `protobuf:"bytes,4,opt,name=p95TimeToHandle,proto3" json:"p95TimeToHandle,omitempty"`
+	Moderators           []*ModeratorStats  `protobuf:"bytes,5,rep,name=moderators,proto3" json:"moderators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ModerationStats) Reset()         { *m = ModerationStats{} }
+func (m *ModerationStats) String() string { return proto.CompactTextString(m) }
+func (*ModerationStats) ProtoMessage()    {}
+func (*ModerationStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_category_be8b86b54344ed0a, []int{139}
+}
+func (m *ModerationStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModerationStats.Unmarshal(m, b)
+}
+func (m *ModerationStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModerationStats.Marshal(b, m, deterministic)
+}
+func (dst *ModerationStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModerationStats.Merge(dst, src)
+}
+func (m *ModerationStats) XXX_Size() int {
+	return xxx_messageInfo_ModerationStats.Size(m)
+}
+func (m *ModerationStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModerationStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModerationStats proto.InternalMessageInfo
+
+func (m *ModerationStats) GetOpenReportCount() int64 {
+	if m != nil {
+		return m.OpenReportCount
+	}
+	return 0
+}
+
+func (m *ModerationStats) GetHandledCount() int64 {
+	if m != nil {
+		return m.HandledCount
+	}
+	return 0
+}
+
+func (m *ModerationStats) GetMedianTimeToHandle() *duration.Duration {
+	if m != nil {
+		return m.MedianTimeToHandle
+	}
+	return nil
+}
+
+func (m *ModerationStats) GetP95TimeToHandle() *duration.Duration {
+	if m != nil {
+		return m.P95TimeToHandle
+	}
+	return nil
+}
+
+func (m *ModerationStats) GetModerators() []*ModeratorStats {
+	if m != nil {
+		return m.Moderators
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("category.Visibility", Visibility_name, Visibility_value)
+	proto.RegisterEnum("category.JoinRequestStatus", JoinRequestStatus_name, JoinRequestStatus_value)
+	proto.RegisterEnum("category.ReasonCode", ReasonCode_name, ReasonCode_value)
+	proto.RegisterEnum("category.Severity", Severity_name, Severity_value)
+	proto.RegisterEnum("category.Routing", Routing_name, Routing_value)
+	proto.RegisterEnum("category.ReportOrder", ReportOrder_name, ReportOrder_value)
+	proto.RegisterEnum("category.AssignmentStrategy", AssignmentStrategy_name, AssignmentStrategy_value)
+	proto.RegisterEnum("category.Outcome", Outcome_name, Outcome_value)
+	proto.RegisterEnum("category.BulkReportStatus", BulkReportStatus_name, BulkReportStatus_value)
+	proto.RegisterEnum("category.RetentionAction", RetentionAction_name, RetentionAction_value)
+	proto.RegisterEnum("category.ExportFormat", ExportFormat_name, ExportFormat_value)
+	proto.RegisterEnum("category.ReportEventType", ReportEventType_name, ReportEventType_value)
+	proto.RegisterType((*ListCategoriesRequest)(nil), "category.ListCategoriesRequest")
+	proto.RegisterType((*ListCategoriesResponse)(nil), "category.ListCategoriesResponse")
+	proto.RegisterType((*SingleCategory)(nil), "category.SingleCategory")
+	proto.RegisterType((*CreateCategoryRequest)(nil), "category.CreateCategoryRequest")
+	proto.RegisterType((*ListChildCategoriesRequest)(nil), "category.ListChildCategoriesRequest")
+	proto.RegisterType((*GetCategoryAncestorsRequest)(nil), "category.GetCategoryAncestorsRequest")
+	proto.RegisterType((*GetCategoryAncestorsResponse)(nil), "category.GetCategoryAncestorsResponse")
+	proto.RegisterType((*MoveCategoryRequest)(nil), "category.MoveCategoryRequest")
+	proto.RegisterType((*SetCategoryVisibilityRequest)(nil), "category.SetCategoryVisibilityRequest")
+	proto.RegisterType((*SubscribeRequest)(nil), "category.SubscribeRequest")
+	proto.RegisterType((*SubscribeResponse)(nil), "category.SubscribeResponse")
+	proto.RegisterType((*UnsubscribeRequest)(nil), "category.UnsubscribeRequest")
+	proto.RegisterType((*UnsubscribeResponse)(nil), "category.UnsubscribeResponse")
+	proto.RegisterType((*ListSubscribersRequest)(nil), "category.ListSubscribersRequest")
+	proto.RegisterType((*SingleSubscription)(nil), "category.SingleSubscription")
+	proto.RegisterType((*ListSubscribersResponse)(nil), "category.ListSubscribersResponse")
+	proto.RegisterType((*ListSubscriptionsRequest)(nil), "category.ListSubscriptionsRequest")
+	proto.RegisterType((*CreateJoinRequestRequest)(nil), "category.CreateJoinRequestRequest")
+	proto.RegisterType((*SingleJoinRequest)(nil), "category.SingleJoinRequest")
+	proto.RegisterType((*ListJoinRequestsRequest)(nil), "category.ListJoinRequestsRequest")
+	proto.RegisterType((*ListJoinRequestsResponse)(nil), "category.ListJoinRequestsResponse")
+	proto.RegisterType((*DecideJoinRequestRequest)(nil), "category.DecideJoinRequestRequest")
+	proto.RegisterType((*CheckMembershipRequest)(nil), "category.CheckMembershipRequest")
+	proto.RegisterType((*CheckMembershipResponse)(nil), "category.CheckMembershipResponse")
+	proto.RegisterType((*CreateInviteRequest)(nil), "category.CreateInviteRequest")
+	proto.RegisterType((*SingleInvite)(nil), "category.SingleInvite")
+	proto.RegisterType((*RedeemInviteRequest)(nil), "category.RedeemInviteRequest")
+	proto.RegisterType((*ListInvitesRequest)(nil), "category.ListInvitesRequest")
+	proto.RegisterType((*ListInvitesResponse)(nil), "category.ListInvitesResponse")
+	proto.RegisterType((*RevokeInviteRequest)(nil), "category.RevokeInviteRequest")
+	proto.RegisterType((*RevokeInviteResponse)(nil), "category.RevokeInviteResponse")
+	proto.RegisterType((*BanUserRequest)(nil), "category.BanUserRequest")
+	proto.RegisterType((*SingleBan)(nil), "category.SingleBan")
+	proto.RegisterType((*UnbanUserRequest)(nil), "category.UnbanUserRequest")
+	proto.RegisterType((*UnbanUserResponse)(nil), "category.UnbanUserResponse")
+	proto.RegisterType((*ListBansRequest)(nil), "category.ListBansRequest")
+	proto.RegisterType((*ListBansResponse)(nil), "category.ListBansResponse")
+	proto.RegisterType((*IsBannedRequest)(nil), "category.IsBannedRequest")
+	proto.RegisterType((*IsBannedResponse)(nil), "category.IsBannedResponse")
+	proto.RegisterType((*AddStrikeRequest)(nil), "category.AddStrikeRequest")
+	proto.RegisterType((*SingleStrike)(nil), "category.SingleStrike")
+	proto.RegisterType((*SingleSuspension)(nil), "category.SingleSuspension")
+	proto.RegisterType((*AddStrikeResponse)(nil), "category.AddStrikeResponse")
+	proto.RegisterType((*ListStrikesRequest)(nil), "category.ListStrikesRequest")
+	proto.RegisterType((*ListStrikesResponse)(nil), "category.ListStrikesResponse")
+	proto.RegisterType((*IsSuspendedRequest)(nil), "category.IsSuspendedRequest")
+	proto.RegisterType((*IsSuspendedResponse)(nil), "category.IsSuspendedResponse")
+	proto.RegisterType((*GetEscalationPolicyRequest)(nil), "category.GetEscalationPolicyRequest")
+	proto.RegisterType((*SingleEscalationPolicy)(nil), "category.SingleEscalationPolicy")
+	proto.RegisterType((*SetEscalationPolicyRequest)(nil), "category.SetEscalationPolicyRequest")
+	proto.RegisterType((*NoteAccessRequest)(nil), "category.NoteAccessRequest")
+	proto.RegisterType((*SingleNoteAccessGrant)(nil), "category.SingleNoteAccessGrant")
+	proto.RegisterType((*RevokeNoteAccessResponse)(nil), "category.RevokeNoteAccessResponse")
+	proto.RegisterType((*ListNoteAccessGrantsRequest)(nil), "category.ListNoteAccessGrantsRequest")
+	proto.RegisterType((*ListNoteAccessGrantsResponse)(nil), "category.ListNoteAccessGrantsResponse")
+	proto.RegisterType((*CreateUserNoteRequest)(nil), "category.CreateUserNoteRequest")
+	proto.RegisterType((*SingleUserNote)(nil), "category.SingleUserNote")
+	proto.RegisterType((*ListUserNotesRequest)(nil), "category.ListUserNotesRequest")
+	proto.RegisterType((*ListUserNotesResponse)(nil), "category.ListUserNotesResponse")
+	proto.RegisterType((*DeleteUserNoteRequest)(nil), "category.DeleteUserNoteRequest")
+	proto.RegisterType((*DeleteUserNoteResponse)(nil), "category.DeleteUserNoteResponse")
+	proto.RegisterType((*SearchCategoriesRequest)(nil), "category.SearchCategoriesRequest")
+	proto.RegisterType((*CategorySearchResult)(nil), "category.CategorySearchResult")
+	proto.RegisterType((*SearchCategoriesResponse)(nil), "category.SearchCategoriesResponse")
+	proto.RegisterType((*SuggestCategoriesRequest)(nil), "category.SuggestCategoriesRequest")
+	proto.RegisterType((*SuggestCategoriesResponse)(nil), "category.SuggestCategoriesResponse")
+	proto.RegisterType((*GetCategoryInfoRequest)(nil), "category.GetCategoryInfoRequest")
+	proto.RegisterType((*GetCategoryBySlugRequest)(nil), "category.GetCategoryBySlugRequest")
+	proto.RegisterType((*CreateRuleRequest)(nil), "category.CreateRuleRequest")
+	proto.RegisterType((*SingleRule)(nil), "category.SingleRule")
+	proto.RegisterType((*UpdateRuleRequest)(nil), "category.UpdateRuleRequest")
+	proto.RegisterType((*ReorderRulesRequest)(nil), "category.ReorderRulesRequest")
+	proto.RegisterType((*ListRulesRequest)(nil), "category.ListRulesRequest")
+	proto.RegisterType((*ListRulesResponse)(nil), "category.ListRulesResponse")
+	proto.RegisterType((*ListReportsRequest)(nil), "category.ListReportsRequest")
+	proto.RegisterType((*RuleReportCount)(nil), "category.RuleReportCount")
+	proto.RegisterType((*ListReportsResponse)(nil), "category.ListReportsResponse")
+	proto.RegisterType((*CreateReportRequest)(nil), "category.CreateReportRequest")
+	proto.RegisterType((*SingleReport)(nil), "category.SingleReport")
+	proto.RegisterType((*DeleteReportRequest)(nil), "category.DeleteReportRequest")
+	proto.RegisterType((*DeleteReportResponse)(nil), "category.DeleteReportResponse")
+	proto.RegisterType((*ListReasonCodesRequest)(nil), "category.ListReasonCodesRequest")
+	proto.RegisterType((*SingleReasonCode)(nil), "category.SingleReasonCode")
+	proto.RegisterType((*ListReasonCodesResponse)(nil), "category.ListReasonCodesResponse")
+	proto.RegisterType((*ListAdminReportsRequest)(nil), "category.ListAdminReportsRequest")
+	proto.RegisterType((*ListAllReportsRequest)(nil), "category.ListAllReportsRequest")
+	proto.RegisterType((*ClaimReportRequest)(nil), "category.ClaimReportRequest")
+	proto.RegisterType((*ReleaseReportRequest)(nil), "category.ReleaseReportRequest")
+	proto.RegisterType((*AssignReportRequest)(nil), "category.AssignReportRequest")
+	proto.RegisterType((*SetAssignmentStrategyRequest)(nil), "category.SetAssignmentStrategyRequest")
+	proto.RegisterType((*SetAssignmentStrategyResponse)(nil), "category.SetAssignmentStrategyResponse")
+	proto.RegisterType((*AddReportHandlerRequest)(nil), "category.AddReportHandlerRequest")
+	proto.RegisterType((*SingleReportHandler)(nil), "category.SingleReportHandler")
+	proto.RegisterType((*RemoveReportHandlerRequest)(nil), "category.RemoveReportHandlerRequest")
+	proto.RegisterType((*RemoveReportHandlerResponse)(nil), "category.RemoveReportHandlerResponse")
+	proto.RegisterType((*SetReportHandlerAwayRequest)(nil), "category.SetReportHandlerAwayRequest")
+	proto.RegisterType((*ListReportHandlersRequest)(nil), "category.ListReportHandlersRequest")
+	proto.RegisterType((*ListReportHandlersResponse)(nil), "category.ListReportHandlersResponse")
+	proto.RegisterType((*CreateThresholdPolicyRequest)(nil), "category.CreateThresholdPolicyRequest")
+	proto.RegisterType((*SingleThresholdPolicy)(nil), "category.SingleThresholdPolicy")
+	proto.RegisterType((*DeleteThresholdPolicyRequest)(nil), "category.DeleteThresholdPolicyRequest")
+	proto.RegisterType((*DeleteThresholdPolicyResponse)(nil), "category.DeleteThresholdPolicyResponse")
+	proto.RegisterType((*ListThresholdPoliciesRequest)(nil), "category.ListThresholdPoliciesRequest")
+	proto.RegisterType((*ListThresholdPoliciesResponse)(nil), "category.ListThresholdPoliciesResponse")
+	proto.RegisterType((*ListAutoActionsRequest)(nil), "category.ListAutoActionsRequest")
+	proto.RegisterType((*SingleAutoAction)(nil), "category.SingleAutoAction")
+	proto.RegisterType((*ListAutoActionsResponse)(nil), "category.ListAutoActionsResponse")
+	proto.RegisterType((*ReviewAutoActionRequest)(nil), "category.ReviewAutoActionRequest")
+	proto.RegisterType((*ListEventsRequest)(nil), "category.ListEventsRequest")
+	proto.RegisterType((*SingleEvent)(nil), "category.SingleEvent")
+	proto.RegisterType((*ListEventsResponse)(nil), "category.ListEventsResponse")
+	proto.RegisterType((*AddReportNoteRequest)(nil), "category.AddReportNoteRequest")
+	proto.RegisterType((*SingleReportNote)(nil), "category.SingleReportNote")
+	proto.RegisterType((*ListReportNotesRequest)(nil), "category.ListReportNotesRequest")
+	proto.RegisterType((*ListReportNotesResponse)(nil), "category.ListReportNotesResponse")
+	proto.RegisterType((*ResolveReportRequest)(nil), "category.ResolveReportRequest")
+	proto.RegisterType((*SingleReportOutcome)(nil), "category.SingleReportOutcome")
+	proto.RegisterType((*ListReportOutcomesRequest)(nil), "category.ListReportOutcomesRequest")
+	proto.RegisterType((*ListReportOutcomesResponse)(nil), "category.ListReportOutcomesResponse")
+	proto.RegisterType((*DeleteReportsRequest)(nil), "category.DeleteReportsRequest")
+	proto.RegisterType((*DeleteReportsByPostRequest)(nil), "category.DeleteReportsByPostRequest")
+	proto.RegisterType((*DeleteReportsByFilterRequest)(nil), "category.DeleteReportsByFilterRequest")
+	proto.RegisterType((*BulkReportResult)(nil), "category.BulkReportResult")
+	proto.RegisterType((*BulkDeleteReportsResponse)(nil), "category.BulkDeleteReportsResponse")
+	proto.RegisterType((*SetRetentionPolicyRequest)(nil), "category.SetRetentionPolicyRequest")
+	proto.RegisterType((*SingleRetentionPolicy)(nil), "category.SingleRetentionPolicy")
+	proto.RegisterType((*DeleteRetentionPolicyRequest)(nil), "category.DeleteRetentionPolicyRequest")
+	proto.RegisterType((*DeleteRetentionPolicyResponse)(nil), "category.DeleteRetentionPolicyResponse")
+	proto.RegisterType((*ListRetentionPoliciesRequest)(nil), "category.ListRetentionPoliciesRequest")
+	proto.RegisterType((*ListRetentionPoliciesResponse)(nil), "category.ListRetentionPoliciesResponse")
+	proto.RegisterType((*PreviewRetentionRequest)(nil), "category.PreviewRetentionRequest")
+	proto.RegisterType((*SingleRetentionPreview)(nil), "category.SingleRetentionPreview")
+	proto.RegisterType((*PreviewRetentionResponse)(nil), "category.PreviewRetentionResponse")
+	proto.RegisterType((*ExportReportsRequest)(nil), "category.ExportReportsRequest")
+	proto.RegisterType((*ExportReportsChunk)(nil), "category.ExportReportsChunk")
+	proto.RegisterType((*WatchReportsRequest)(nil), "category.WatchReportsRequest")
+	proto.RegisterType((*ReportEvent)(nil), "category.ReportEvent")
+	proto.RegisterType((*GetModerationStatsRequest)(nil), "category.GetModerationStatsRequest")
+	proto.RegisterType((*ModeratorStats)(nil), "category.ModeratorStats")
+	proto.RegisterType((*ModerationStats)(nil), "category.ModerationStats")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CategoryClient is the client API for Category service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CategoryClient interface {
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	GetCategoryInfo(ctx context.Context, in *GetCategoryInfoRequest, opts ...grpc.CallOption) (*SingleCategory, error)
+	GetCategoryBySlug(ctx context.Context, in *GetCategoryBySlugRequest, opts ...grpc.CallOption) (*SingleCategory, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*SingleCategory, error)
+	SearchCategories(ctx context.Context, in *SearchCategoriesRequest, opts ...grpc.CallOption) (*SearchCategoriesResponse, error)
+	SuggestCategories(ctx context.Context, in *SuggestCategoriesRequest, opts ...grpc.CallOption) (*SuggestCategoriesResponse, error)
+	ListChildCategories(ctx context.Context, in *ListChildCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	GetCategoryAncestors(ctx context.Context, in *GetCategoryAncestorsRequest, opts ...grpc.CallOption) (*GetCategoryAncestorsResponse, error)
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*SingleCategory, error)
+	SetCategoryVisibility(ctx context.Context, in *SetCategoryVisibilityRequest, opts ...grpc.CallOption) (*SingleCategory, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
+	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
+	ListSubscribers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error)
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	CreateJoinRequest(ctx context.Context, in *CreateJoinRequestRequest, opts ...grpc.CallOption) (*SingleJoinRequest, error)
+	ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error)
+	ApproveJoinRequest(ctx context.Context, in *DecideJoinRequestRequest, opts ...grpc.CallOption) (*SingleJoinRequest, error)
+	DenyJoinRequest(ctx context.Context, in *DecideJoinRequestRequest, opts ...grpc.CallOption) (*SingleJoinRequest, error)
+	CheckMembership(ctx context.Context, in *CheckMembershipRequest, opts ...grpc.CallOption) (*CheckMembershipResponse, error)
+	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*SingleInvite, error)
+	RedeemInvite(ctx context.Context, in *RedeemInviteRequest, opts ...grpc.CallOption) (*SingleCategory, error)
+	ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error)
+	RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteResponse, error)
+	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*SingleBan, error)
+	UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*UnbanUserResponse, error)
+	ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error)
+	IsBanned(ctx context.Context, in *IsBannedRequest, opts ...grpc.CallOption) (*IsBannedResponse, error)
+	AddStrike(ctx context.Context, in *AddStrikeRequest, opts ...grpc.CallOption) (*AddStrikeResponse, error)
+	ListStrikes(ctx context.Context, in *ListStrikesRequest, opts ...grpc.CallOption) (*ListStrikesResponse, error)
+	IsSuspended(ctx context.Context, in *IsSuspendedRequest, opts ...grpc.CallOption) (*IsSuspendedResponse, error)
+	GetEscalationPolicy(ctx context.Context, in *GetEscalationPolicyRequest, opts ...grpc.CallOption) (*SingleEscalationPolicy, error)
+	SetEscalationPolicy(ctx context.Context, in *SetEscalationPolicyRequest, opts ...grpc.CallOption) (*SingleEscalationPolicy, error)
+	GrantNoteAccess(ctx context.Context, in *NoteAccessRequest, opts ...grpc.CallOption) (*SingleNoteAccessGrant, error)
+	RevokeNoteAccess(ctx context.Context, in *NoteAccessRequest, opts ...grpc.CallOption) (*RevokeNoteAccessResponse, error)
+	ListNoteAccessGrants(ctx context.Context, in *ListNoteAccessGrantsRequest, opts ...grpc.CallOption) (*ListNoteAccessGrantsResponse, error)
+	CreateUserNote(ctx context.Context, in *CreateUserNoteRequest, opts ...grpc.CallOption) (*SingleUserNote, error)
+	ListUserNotes(ctx context.Context, in *ListUserNotesRequest, opts ...grpc.CallOption) (*ListUserNotesResponse, error)
+	DeleteUserNote(ctx context.Context, in *DeleteUserNoteRequest, opts ...grpc.CallOption) (*DeleteUserNoteResponse, error)
+	CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*SingleRule, error)
+	UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*SingleRule, error)
+	ReorderRules(ctx context.Context, in *ReorderRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
+	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
+	CreateReport(ctx context.Context, in *CreateReportRequest, opts ...grpc.CallOption) (*SingleReport, error)
+	DeleteReport(ctx context.Context, in *DeleteReportRequest, opts ...grpc.CallOption) (*DeleteReportResponse, error)
+	DeleteReports(ctx context.Context, in *DeleteReportsRequest, opts ...grpc.CallOption) (*BulkDeleteReportsResponse, error)
+	DeleteReportsByPost(ctx context.Context, in *DeleteReportsByPostRequest, opts ...grpc.CallOption) (*BulkDeleteReportsResponse, error)
+	DeleteReportsByFilter(ctx context.Context, in *DeleteReportsByFilterRequest, opts ...grpc.CallOption) (*BulkDeleteReportsResponse, error)
+	ExportReports(ctx context.Context, in *ExportReportsRequest, opts ...grpc.CallOption) (Category_ExportReportsClient, error)
+	WatchReports(ctx context.Context, in *WatchReportsRequest, opts ...grpc.CallOption) (Category_WatchReportsClient, error)
+	GetModerationStats(ctx context.Context, in *GetModerationStatsRequest, opts ...grpc.CallOption) (*ModerationStats, error)
+	ListReasonCodes(ctx context.Context, in *ListReasonCodesRequest, opts ...grpc.CallOption) (*ListReasonCodesResponse, error)
+	ListAdminReports(ctx context.Context, in *ListAdminReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
+	ListAllReports(ctx context.Context, in *ListAllReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
+	ClaimReport(ctx context.Context, in *ClaimReportRequest, opts ...grpc.CallOption) (*SingleReport, error)
+	ReleaseReport(ctx context.Context, in *ReleaseReportRequest, opts ...grpc.CallOption) (*SingleReport, error)
+	AssignReport(ctx context.Context, in *AssignReportRequest, opts ...grpc.CallOption) (*SingleReport, error)
+	AddReportNote(ctx context.Context, in *AddReportNoteRequest, opts ...grpc.CallOption) (*SingleReportNote, error)
+	ListReportNotes(ctx context.Context, in *ListReportNotesRequest, opts ...grpc.CallOption) (*ListReportNotesResponse, error)
+	ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*SingleReportOutcome, error)
+	ListReportOutcomes(ctx context.Context, in *ListReportOutcomesRequest, opts ...grpc.CallOption) (*ListReportOutcomesResponse, error)
+	SetAssignmentStrategy(ctx context.Context, in *SetAssignmentStrategyRequest, opts ...grpc.CallOption) (*SetAssignmentStrategyResponse, error)
+	AddReportHandler(ctx context.Context, in *AddReportHandlerRequest, opts ...grpc.CallOption) (*SingleReportHandler, error)
+	RemoveReportHandler(ctx context.Context, in *RemoveReportHandlerRequest, opts ...grpc.CallOption) (*RemoveReportHandlerResponse, error)
+	SetReportHandlerAway(ctx context.Context, in *SetReportHandlerAwayRequest, opts ...grpc.CallOption) (*SingleReportHandler, error)
+	ListReportHandlers(ctx context.Context, in *ListReportHandlersRequest, opts ...grpc.CallOption) (*ListReportHandlersResponse, error)
+	CreateThresholdPolicy(ctx context.Context, in *CreateThresholdPolicyRequest, opts ...grpc.CallOption) (*SingleThresholdPolicy, error)
+	DeleteThresholdPolicy(ctx context.Context, in *DeleteThresholdPolicyRequest, opts ...grpc.CallOption) (*DeleteThresholdPolicyResponse, error)
+	ListThresholdPolicies(ctx context.Context, in *ListThresholdPoliciesRequest, opts ...grpc.CallOption) (*ListThresholdPoliciesResponse, error)
+	ListAutoActions(ctx context.Context, in *ListAutoActionsRequest, opts ...grpc.CallOption) (*ListAutoActionsResponse, error)
+	ReviewAutoAction(ctx context.Context, in *ReviewAutoActionRequest, opts ...grpc.CallOption) (*SingleAutoAction, error)
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SingleRetentionPolicy, error)
+	DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*DeleteRetentionPolicyResponse, error)
+	ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error)
+	PreviewRetention(ctx context.Context, in *PreviewRetentionRequest, opts ...grpc.CallOption) (*PreviewRetentionResponse, error)
+}
+
+type categoryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCategoryClient(cc *grpc.ClientConn) CategoryClient {
+	return &categoryClient{cc}
+}
+
+func (c *categoryClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) GetCategoryInfo(ctx context.Context, in *GetCategoryInfoRequest, opts ...grpc.CallOption) (*SingleCategory, error) {
+	out := new(SingleCategory)
+	err := c.cc.Invoke(ctx, "/category.Category/GetCategoryInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) GetCategoryBySlug(ctx context.Context, in *GetCategoryBySlugRequest, opts ...grpc.CallOption) (*SingleCategory, error) {
+	out := new(SingleCategory)
+	err := c.cc.Invoke(ctx, "/category.Category/GetCategoryBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*SingleCategory, error) {
+	out := new(SingleCategory)
+	err := c.cc.Invoke(ctx, "/category.Category/CreateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) SearchCategories(ctx context.Context, in *SearchCategoriesRequest, opts ...grpc.CallOption) (*SearchCategoriesResponse, error) {
+	out := new(SearchCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/SearchCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) SuggestCategories(ctx context.Context, in *SuggestCategoriesRequest, opts ...grpc.CallOption) (*SuggestCategoriesResponse, error) {
+	out := new(SuggestCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/SuggestCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListChildCategories(ctx context.Context, in *ListChildCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListChildCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) GetCategoryAncestors(ctx context.Context, in *GetCategoryAncestorsRequest, opts ...grpc.CallOption) (*GetCategoryAncestorsResponse, error) {
+	out := new(GetCategoryAncestorsResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/GetCategoryAncestors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*SingleCategory, error) {
+	out := new(SingleCategory)
+	err := c.cc.Invoke(ctx, "/category.Category/MoveCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) SetCategoryVisibility(ctx context.Context, in *SetCategoryVisibilityRequest, opts ...grpc.CallOption) (*SingleCategory, error) {
+	out := new(SingleCategory)
+	err := c.cc.Invoke(ctx, "/category.Category/SetCategoryVisibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error) {
+	out := new(SubscribeResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/Subscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error) {
+	out := new(UnsubscribeResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/Unsubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListSubscribers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error) {
+	out := new(ListSubscribersResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListSubscribers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) CreateJoinRequest(ctx context.Context, in *CreateJoinRequestRequest, opts ...grpc.CallOption) (*SingleJoinRequest, error) {
+	out := new(SingleJoinRequest)
+	err := c.cc.Invoke(ctx, "/category.Category/CreateJoinRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error) {
+	out := new(ListJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListJoinRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ApproveJoinRequest(ctx context.Context, in *DecideJoinRequestRequest, opts ...grpc.CallOption) (*SingleJoinRequest, error) {
+	out := new(SingleJoinRequest)
+	err := c.cc.Invoke(ctx, "/category.Category/ApproveJoinRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) DenyJoinRequest(ctx context.Context, in *DecideJoinRequestRequest, opts ...grpc.CallOption) (*SingleJoinRequest, error) {
+	out := new(SingleJoinRequest)
+	err := c.cc.Invoke(ctx, "/category.Category/DenyJoinRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) CheckMembership(ctx context.Context, in *CheckMembershipRequest, opts ...grpc.CallOption) (*CheckMembershipResponse, error) {
+	out := new(CheckMembershipResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/CheckMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*SingleInvite, error) {
+	out := new(SingleInvite)
+	err := c.cc.Invoke(ctx, "/category.Category/CreateInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) RedeemInvite(ctx context.Context, in *RedeemInviteRequest, opts ...grpc.CallOption) (*SingleCategory, error) {
+	out := new(SingleCategory)
+	err := c.cc.Invoke(ctx, "/category.Category/RedeemInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error) {
+	out := new(ListInvitesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteResponse, error) {
+	out := new(RevokeInviteResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/RevokeInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*SingleBan, error) {
+	out := new(SingleBan)
+	err := c.cc.Invoke(ctx, "/category.Category/BanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*UnbanUserResponse, error) {
+	out := new(UnbanUserResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/UnbanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error) {
+	out := new(ListBansResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListBans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) IsBanned(ctx context.Context, in *IsBannedRequest, opts ...grpc.CallOption) (*IsBannedResponse, error) {
+	out := new(IsBannedResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/IsBanned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) AddStrike(ctx context.Context, in *AddStrikeRequest, opts ...grpc.CallOption) (*AddStrikeResponse, error) {
+	out := new(AddStrikeResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/AddStrike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListStrikes(ctx context.Context, in *ListStrikesRequest, opts ...grpc.CallOption) (*ListStrikesResponse, error) {
+	out := new(ListStrikesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListStrikes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) IsSuspended(ctx context.Context, in *IsSuspendedRequest, opts ...grpc.CallOption) (*IsSuspendedResponse, error) {
+	out := new(IsSuspendedResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/IsSuspended", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) GetEscalationPolicy(ctx context.Context, in *GetEscalationPolicyRequest, opts ...grpc.CallOption) (*SingleEscalationPolicy, error) {
+	out := new(SingleEscalationPolicy)
+	err := c.cc.Invoke(ctx, "/category.Category/GetEscalationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) SetEscalationPolicy(ctx context.Context, in *SetEscalationPolicyRequest, opts ...grpc.CallOption) (*SingleEscalationPolicy, error) {
+	out := new(SingleEscalationPolicy)
+	err := c.cc.Invoke(ctx, "/category.Category/SetEscalationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) GrantNoteAccess(ctx context.Context, in *NoteAccessRequest, opts ...grpc.CallOption) (*SingleNoteAccessGrant, error) {
+	out := new(SingleNoteAccessGrant)
+	err := c.cc.Invoke(ctx, "/category.Category/GrantNoteAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) RevokeNoteAccess(ctx context.Context, in *NoteAccessRequest, opts ...grpc.CallOption) (*RevokeNoteAccessResponse, error) {
+	out := new(RevokeNoteAccessResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/RevokeNoteAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListNoteAccessGrants(ctx context.Context, in *ListNoteAccessGrantsRequest, opts ...grpc.CallOption) (*ListNoteAccessGrantsResponse, error) {
+	out := new(ListNoteAccessGrantsResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListNoteAccessGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) CreateUserNote(ctx context.Context, in *CreateUserNoteRequest, opts ...grpc.CallOption) (*SingleUserNote, error) {
+	out := new(SingleUserNote)
+	err := c.cc.Invoke(ctx, "/category.Category/CreateUserNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListUserNotes(ctx context.Context, in *ListUserNotesRequest, opts ...grpc.CallOption) (*ListUserNotesResponse, error) {
+	out := new(ListUserNotesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListUserNotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) DeleteUserNote(ctx context.Context, in *DeleteUserNoteRequest, opts ...grpc.CallOption) (*DeleteUserNoteResponse, error) {
+	out := new(DeleteUserNoteResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/DeleteUserNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*SingleRule, error) {
+	out := new(SingleRule)
+	err := c.cc.Invoke(ctx, "/category.Category/CreateRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*SingleRule, error) {
+	out := new(SingleRule)
+	err := c.cc.Invoke(ctx, "/category.Category/UpdateRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ReorderRules(ctx context.Context, in *ReorderRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error) {
+	out := new(ListRulesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ReorderRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error) {
+	out := new(ListRulesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) CreateReport(ctx context.Context, in *CreateReportRequest, opts ...grpc.CallOption) (*SingleReport, error) {
+	out := new(SingleReport)
+	err := c.cc.Invoke(ctx, "/category.Category/CreateReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) DeleteReport(ctx context.Context, in *DeleteReportRequest, opts ...grpc.CallOption) (*DeleteReportResponse, error) {
+	out := new(DeleteReportResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/DeleteReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) DeleteReports(ctx context.Context, in *DeleteReportsRequest, opts ...grpc.CallOption) (*BulkDeleteReportsResponse, error) {
+	out := new(BulkDeleteReportsResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/DeleteReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) DeleteReportsByPost(ctx context.Context, in *DeleteReportsByPostRequest, opts ...grpc.CallOption) (*BulkDeleteReportsResponse, error) {
+	out := new(BulkDeleteReportsResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/DeleteReportsByPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) DeleteReportsByFilter(ctx context.Context, in *DeleteReportsByFilterRequest, opts ...grpc.CallOption) (*BulkDeleteReportsResponse, error) {
+	out := new(BulkDeleteReportsResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/DeleteReportsByFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ExportReports(ctx context.Context, in *ExportReportsRequest, opts ...grpc.CallOption) (Category_ExportReportsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Category_serviceDesc.Streams[0], "/category.Category/ExportReports", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &categoryExportReportsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Category_ExportReportsClient interface {
+	Recv() (*ExportReportsChunk, error)
+	grpc.ClientStream
+}
+
+type categoryExportReportsClient struct {
+	grpc.ClientStream
+}
+
+func (x *categoryExportReportsClient) Recv() (*ExportReportsChunk, error) {
+	m := new(ExportReportsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *categoryClient) WatchReports(ctx context.Context, in *WatchReportsRequest, opts ...grpc.CallOption) (Category_WatchReportsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Category_serviceDesc.Streams[1], "/category.Category/WatchReports", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &categoryWatchReportsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Category_WatchReportsClient interface {
+	Recv() (*ReportEvent, error)
+	grpc.ClientStream
+}
+
+type categoryWatchReportsClient struct {
+	grpc.ClientStream
+}
+
+func (x *categoryWatchReportsClient) Recv() (*ReportEvent, error) {
+	m := new(ReportEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *categoryClient) GetModerationStats(ctx context.Context, in *GetModerationStatsRequest, opts ...grpc.CallOption) (*ModerationStats, error) {
+	out := new(ModerationStats)
+	err := c.cc.Invoke(ctx, "/category.Category/GetModerationStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListReasonCodes(ctx context.Context, in *ListReasonCodesRequest, opts ...grpc.CallOption) (*ListReasonCodesResponse, error) {
+	out := new(ListReasonCodesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListReasonCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListAdminReports(ctx context.Context, in *ListAdminReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListAdminReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListAllReports(ctx context.Context, in *ListAllReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListAllReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ClaimReport(ctx context.Context, in *ClaimReportRequest, opts ...grpc.CallOption) (*SingleReport, error) {
+	out := new(SingleReport)
+	err := c.cc.Invoke(ctx, "/category.Category/ClaimReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ReleaseReport(ctx context.Context, in *ReleaseReportRequest, opts ...grpc.CallOption) (*SingleReport, error) {
+	out := new(SingleReport)
+	err := c.cc.Invoke(ctx, "/category.Category/ReleaseReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) AssignReport(ctx context.Context, in *AssignReportRequest, opts ...grpc.CallOption) (*SingleReport, error) {
+	out := new(SingleReport)
+	err := c.cc.Invoke(ctx, "/category.Category/AssignReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) AddReportNote(ctx context.Context, in *AddReportNoteRequest, opts ...grpc.CallOption) (*SingleReportNote, error) {
+	out := new(SingleReportNote)
+	err := c.cc.Invoke(ctx, "/category.Category/AddReportNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListReportNotes(ctx context.Context, in *ListReportNotesRequest, opts ...grpc.CallOption) (*ListReportNotesResponse, error) {
+	out := new(ListReportNotesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListReportNotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*SingleReportOutcome, error) {
+	out := new(SingleReportOutcome)
+	err := c.cc.Invoke(ctx, "/category.Category/ResolveReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListReportOutcomes(ctx context.Context, in *ListReportOutcomesRequest, opts ...grpc.CallOption) (*ListReportOutcomesResponse, error) {
+	out := new(ListReportOutcomesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListReportOutcomes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) SetAssignmentStrategy(ctx context.Context, in *SetAssignmentStrategyRequest, opts ...grpc.CallOption) (*SetAssignmentStrategyResponse, error) {
+	out := new(SetAssignmentStrategyResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/SetAssignmentStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) AddReportHandler(ctx context.Context, in *AddReportHandlerRequest, opts ...grpc.CallOption) (*SingleReportHandler, error) {
+	out := new(SingleReportHandler)
+	err := c.cc.Invoke(ctx, "/category.Category/AddReportHandler", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) RemoveReportHandler(ctx context.Context, in *RemoveReportHandlerRequest, opts ...grpc.CallOption) (*RemoveReportHandlerResponse, error) {
+	out := new(RemoveReportHandlerResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/RemoveReportHandler", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) SetReportHandlerAway(ctx context.Context, in *SetReportHandlerAwayRequest, opts ...grpc.CallOption) (*SingleReportHandler, error) {
+	out := new(SingleReportHandler)
+	err := c.cc.Invoke(ctx, "/category.Category/SetReportHandlerAway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListReportHandlers(ctx context.Context, in *ListReportHandlersRequest, opts ...grpc.CallOption) (*ListReportHandlersResponse, error) {
+	out := new(ListReportHandlersResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListReportHandlers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) CreateThresholdPolicy(ctx context.Context, in *CreateThresholdPolicyRequest, opts ...grpc.CallOption) (*SingleThresholdPolicy, error) {
+	out := new(SingleThresholdPolicy)
+	err := c.cc.Invoke(ctx, "/category.Category/CreateThresholdPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) DeleteThresholdPolicy(ctx context.Context, in *DeleteThresholdPolicyRequest, opts ...grpc.CallOption) (*DeleteThresholdPolicyResponse, error) {
+	out := new(DeleteThresholdPolicyResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/DeleteThresholdPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListThresholdPolicies(ctx context.Context, in *ListThresholdPoliciesRequest, opts ...grpc.CallOption) (*ListThresholdPoliciesResponse, error) {
+	out := new(ListThresholdPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListThresholdPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListAutoActions(ctx context.Context, in *ListAutoActionsRequest, opts ...grpc.CallOption) (*ListAutoActionsResponse, error) {
+	out := new(ListAutoActionsResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListAutoActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ReviewAutoAction(ctx context.Context, in *ReviewAutoActionRequest, opts ...grpc.CallOption) (*SingleAutoAction, error) {
+	out := new(SingleAutoAction)
+	err := c.cc.Invoke(ctx, "/category.Category/ReviewAutoAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SingleRetentionPolicy, error) {
+	out := new(SingleRetentionPolicy)
+	err := c.cc.Invoke(ctx, "/category.Category/SetRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*DeleteRetentionPolicyResponse, error) {
+	out := new(DeleteRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/DeleteRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error) {
+	out := new(ListRetentionPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/ListRetentionPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) PreviewRetention(ctx context.Context, in *PreviewRetentionRequest, opts ...grpc.CallOption) (*PreviewRetentionResponse, error) {
+	out := new(PreviewRetentionResponse)
+	err := c.cc.Invoke(ctx, "/category.Category/PreviewRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CategoryServer is the server API for Category service.
+type CategoryServer interface {
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	GetCategoryInfo(context.Context, *GetCategoryInfoRequest) (*SingleCategory, error)
+	GetCategoryBySlug(context.Context, *GetCategoryBySlugRequest) (*SingleCategory, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*SingleCategory, error)
+	SearchCategories(context.Context, *SearchCategoriesRequest) (*SearchCategoriesResponse, error)
+	SuggestCategories(context.Context, *SuggestCategoriesRequest) (*SuggestCategoriesResponse, error)
+	ListChildCategories(context.Context, *ListChildCategoriesRequest) (*ListCategoriesResponse, error)
+	GetCategoryAncestors(context.Context, *GetCategoryAncestorsRequest) (*GetCategoryAncestorsResponse, error)
+	MoveCategory(context.Context, *MoveCategoryRequest) (*SingleCategory, error)
+	SetCategoryVisibility(context.Context, *SetCategoryVisibilityRequest) (*SingleCategory, error)
+	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
+	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
+	ListSubscribers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error)
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListCategoriesResponse, error)
+	CreateJoinRequest(context.Context, *CreateJoinRequestRequest) (*SingleJoinRequest, error)
+	ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error)
+	ApproveJoinRequest(context.Context, *DecideJoinRequestRequest) (*SingleJoinRequest, error)
+	DenyJoinRequest(context.Context, *DecideJoinRequestRequest) (*SingleJoinRequest, error)
+	CheckMembership(context.Context, *CheckMembershipRequest) (*CheckMembershipResponse, error)
+	CreateInvite(context.Context, *CreateInviteRequest) (*SingleInvite, error)
+	RedeemInvite(context.Context, *RedeemInviteRequest) (*SingleCategory, error)
+	ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error)
+	RevokeInvite(context.Context, *RevokeInviteRequest) (*RevokeInviteResponse, error)
+	BanUser(context.Context, *BanUserRequest) (*SingleBan, error)
+	UnbanUser(context.Context, *UnbanUserRequest) (*UnbanUserResponse, error)
+	ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error)
+	IsBanned(context.Context, *IsBannedRequest) (*IsBannedResponse, error)
+	AddStrike(context.Context, *AddStrikeRequest) (*AddStrikeResponse, error)
+	ListStrikes(context.Context, *ListStrikesRequest) (*ListStrikesResponse, error)
+	IsSuspended(context.Context, *IsSuspendedRequest) (*IsSuspendedResponse, error)
+	GetEscalationPolicy(context.Context, *GetEscalationPolicyRequest) (*SingleEscalationPolicy, error)
+	SetEscalationPolicy(context.Context, *SetEscalationPolicyRequest) (*SingleEscalationPolicy, error)
+	GrantNoteAccess(context.Context, *NoteAccessRequest) (*SingleNoteAccessGrant, error)
+	RevokeNoteAccess(context.Context, *NoteAccessRequest) (*RevokeNoteAccessResponse, error)
+	ListNoteAccessGrants(context.Context, *ListNoteAccessGrantsRequest) (*ListNoteAccessGrantsResponse, error)
+	CreateUserNote(context.Context, *CreateUserNoteRequest) (*SingleUserNote, error)
+	ListUserNotes(context.Context, *ListUserNotesRequest) (*ListUserNotesResponse, error)
+	DeleteUserNote(context.Context, *DeleteUserNoteRequest) (*DeleteUserNoteResponse, error)
+	CreateRule(context.Context, *CreateRuleRequest) (*SingleRule, error)
+	UpdateRule(context.Context, *UpdateRuleRequest) (*SingleRule, error)
+	ReorderRules(context.Context, *ReorderRulesRequest) (*ListRulesResponse, error)
+	ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error)
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
+	CreateReport(context.Context, *CreateReportRequest) (*SingleReport, error)
+	DeleteReport(context.Context, *DeleteReportRequest) (*DeleteReportResponse, error)
+	DeleteReports(context.Context, *DeleteReportsRequest) (*BulkDeleteReportsResponse, error)
+	DeleteReportsByPost(context.Context, *DeleteReportsByPostRequest) (*BulkDeleteReportsResponse, error)
+	DeleteReportsByFilter(context.Context, *DeleteReportsByFilterRequest) (*BulkDeleteReportsResponse, error)
+	ExportReports(*ExportReportsRequest, Category_ExportReportsServer) error
+	WatchReports(*WatchReportsRequest, Category_WatchReportsServer) error
+	GetModerationStats(context.Context, *GetModerationStatsRequest) (*ModerationStats, error)
+	ListReasonCodes(context.Context, *ListReasonCodesRequest) (*ListReasonCodesResponse, error)
+	ListAdminReports(context.Context, *ListAdminReportsRequest) (*ListReportsResponse, error)
+	ListAllReports(context.Context, *ListAllReportsRequest) (*ListReportsResponse, error)
+	ClaimReport(context.Context, *ClaimReportRequest) (*SingleReport, error)
+	ReleaseReport(context.Context, *ReleaseReportRequest) (*SingleReport, error)
+	AssignReport(context.Context, *AssignReportRequest) (*SingleReport, error)
+	AddReportNote(context.Context, *AddReportNoteRequest) (*SingleReportNote, error)
+	ListReportNotes(context.Context, *ListReportNotesRequest) (*ListReportNotesResponse, error)
+	ResolveReport(context.Context, *ResolveReportRequest) (*SingleReportOutcome, error)
+	ListReportOutcomes(context.Context, *ListReportOutcomesRequest) (*ListReportOutcomesResponse, error)
+	SetAssignmentStrategy(context.Context, *SetAssignmentStrategyRequest) (*SetAssignmentStrategyResponse, error)
+	AddReportHandler(context.Context, *AddReportHandlerRequest) (*SingleReportHandler, error)
+	RemoveReportHandler(context.Context, *RemoveReportHandlerRequest) (*RemoveReportHandlerResponse, error)
+	SetReportHandlerAway(context.Context, *SetReportHandlerAwayRequest) (*SingleReportHandler, error)
+	ListReportHandlers(context.Context, *ListReportHandlersRequest) (*ListReportHandlersResponse, error)
+	CreateThresholdPolicy(context.Context, *CreateThresholdPolicyRequest) (*SingleThresholdPolicy, error)
+	DeleteThresholdPolicy(context.Context, *DeleteThresholdPolicyRequest) (*DeleteThresholdPolicyResponse, error)
+	ListThresholdPolicies(context.Context, *ListThresholdPoliciesRequest) (*ListThresholdPoliciesResponse, error)
+	ListAutoActions(context.Context, *ListAutoActionsRequest) (*ListAutoActionsResponse, error)
+	ReviewAutoAction(context.Context, *ReviewAutoActionRequest) (*SingleAutoAction, error)
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SingleRetentionPolicy, error)
+	DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyRequest) (*DeleteRetentionPolicyResponse, error)
+	ListRetentionPolicies(context.Context, *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error)
+	PreviewRetention(context.Context, *PreviewRetentionRequest) (*PreviewRetentionResponse, error)
+}
+
+func RegisterCategoryServer(s *grpc.Server, srv CategoryServer) {
+	s.RegisterService(&_Category_serviceDesc, srv)
+}
+
+func _Category_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_GetCategoryInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).GetCategoryInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/GetCategoryInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).GetCategoryInfo(ctx, req.(*GetCategoryInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_GetCategoryBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).GetCategoryBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/GetCategoryBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).GetCategoryBySlug(ctx, req.(*GetCategoryBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/CreateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_SearchCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).SearchCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/SearchCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).SearchCategories(ctx, req.(*SearchCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_SuggestCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).SuggestCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/SuggestCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).SuggestCategories(ctx, req.(*SuggestCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListChildCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListChildCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListChildCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListChildCategories(ctx, req.(*ListChildCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_GetCategoryAncestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryAncestorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).GetCategoryAncestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/GetCategoryAncestors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).GetCategoryAncestors(ctx, req.(*GetCategoryAncestorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).MoveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/MoveCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).MoveCategory(ctx, req.(*MoveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_SetCategoryVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategoryVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).SetCategoryVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/SetCategoryVisibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).SetCategoryVisibility(ctx, req.(*SetCategoryVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).Subscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/Subscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).Subscribe(ctx, req.(*SubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/Unsubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).Unsubscribe(ctx, req.(*UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscribersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListSubscribers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListSubscribers(ctx, req.(*ListSubscribersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_CreateJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).CreateJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/CreateJoinRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).CreateJoinRequest(ctx, req.(*CreateJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListJoinRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListJoinRequests(ctx, req.(*ListJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ApproveJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ApproveJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ApproveJoinRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ApproveJoinRequest(ctx, req.(*DecideJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_DenyJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).DenyJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/DenyJoinRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).DenyJoinRequest(ctx, req.(*DecideJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_CheckMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).CheckMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/CheckMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).CheckMembership(ctx, req.(*CheckMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/CreateInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).CreateInvite(ctx, req.(*CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_RedeemInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).RedeemInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/RedeemInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).RedeemInvite(ctx, req.(*RedeemInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListInvites(ctx, req.(*ListInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/RevokeInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).RevokeInvite(ctx, req.(*RevokeInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/BanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).BanUser(ctx, req.(*BanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).UnbanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/UnbanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).UnbanUser(ctx, req.(*UnbanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListBans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListBans(ctx, req.(*ListBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_IsBanned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsBannedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).IsBanned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/IsBanned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).IsBanned(ctx, req.(*IsBannedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_AddStrike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStrikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).AddStrike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/AddStrike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).AddStrike(ctx, req.(*AddStrikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListStrikes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStrikesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListStrikes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListStrikes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListStrikes(ctx, req.(*ListStrikesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_IsSuspended_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsSuspendedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).IsSuspended(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/IsSuspended",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).IsSuspended(ctx, req.(*IsSuspendedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_GetEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).GetEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/GetEscalationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).GetEscalationPolicy(ctx, req.(*GetEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_SetEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).SetEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/SetEscalationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).SetEscalationPolicy(ctx, req.(*SetEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_GrantNoteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).GrantNoteAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/GrantNoteAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).GrantNoteAccess(ctx, req.(*NoteAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_RevokeNoteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).RevokeNoteAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/RevokeNoteAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).RevokeNoteAccess(ctx, req.(*NoteAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListNoteAccessGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNoteAccessGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListNoteAccessGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListNoteAccessGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListNoteAccessGrants(ctx, req.(*ListNoteAccessGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_CreateUserNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).CreateUserNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/CreateUserNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).CreateUserNote(ctx, req.(*CreateUserNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListUserNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListUserNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListUserNotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListUserNotes(ctx, req.(*ListUserNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_DeleteUserNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).DeleteUserNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/DeleteUserNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).DeleteUserNote(ctx, req.(*DeleteUserNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_CreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).CreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/CreateRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).CreateRule(ctx, req.(*CreateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).UpdateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/UpdateRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).UpdateRule(ctx, req.(*UpdateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ReorderRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ReorderRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ReorderRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ReorderRules(ctx, req.(*ReorderRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListRules(ctx, req.(*ListRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListReports(ctx, req.(*ListReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_CreateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).CreateReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/CreateReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).CreateReport(ctx, req.(*CreateReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_DeleteReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).DeleteReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/DeleteReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).DeleteReport(ctx, req.(*DeleteReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_DeleteReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).DeleteReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/DeleteReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).DeleteReports(ctx, req.(*DeleteReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_DeleteReportsByPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReportsByPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).DeleteReportsByPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/DeleteReportsByPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).DeleteReportsByPost(ctx, req.(*DeleteReportsByPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_DeleteReportsByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReportsByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).DeleteReportsByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/DeleteReportsByFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).DeleteReportsByFilter(ctx, req.(*DeleteReportsByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ExportReports_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportReportsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CategoryServer).ExportReports(m, &categoryExportReportsServer{stream})
+}
+
+type Category_ExportReportsServer interface {
+	Send(*ExportReportsChunk) error
+	grpc.ServerStream
+}
+
+type categoryExportReportsServer struct {
+	grpc.ServerStream
+}
+
+func (x *categoryExportReportsServer) Send(m *ExportReportsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Category_WatchReports_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReportsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CategoryServer).WatchReports(m, &categoryWatchReportsServer{stream})
+}
+
+type Category_WatchReportsServer interface {
+	Send(*ReportEvent) error
+	grpc.ServerStream
+}
+
+type categoryWatchReportsServer struct {
+	grpc.ServerStream
+}
+
+func (x *categoryWatchReportsServer) Send(m *ReportEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Category_GetModerationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).GetModerationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/GetModerationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).GetModerationStats(ctx, req.(*GetModerationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListReasonCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReasonCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListReasonCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListReasonCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListReasonCodes(ctx, req.(*ListReasonCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListAdminReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListAdminReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListAdminReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListAdminReports(ctx, req.(*ListAdminReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListAllReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListAllReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListAllReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListAllReports(ctx, req.(*ListAllReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ClaimReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ClaimReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ClaimReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ClaimReport(ctx, req.(*ClaimReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ReleaseReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ReleaseReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ReleaseReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ReleaseReport(ctx, req.(*ReleaseReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_AssignReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).AssignReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/AssignReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).AssignReport(ctx, req.(*AssignReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_AddReportNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReportNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).AddReportNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/AddReportNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).AddReportNote(ctx, req.(*AddReportNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListReportNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListReportNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListReportNotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListReportNotes(ctx, req.(*ListReportNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ResolveReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ResolveReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ResolveReport(ctx, req.(*ResolveReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_ListReportOutcomes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportOutcomesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListReportOutcomes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListReportOutcomes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListReportOutcomes(ctx, req.(*ListReportOutcomesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_SetAssignmentStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAssignmentStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).SetAssignmentStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/SetAssignmentStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).SetAssignmentStrategy(ctx, req.(*SetAssignmentStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_AddReportHandler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReportHandlerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).AddReportHandler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/AddReportHandler",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).AddReportHandler(ctx, req.(*AddReportHandlerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_RemoveReportHandler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReportHandlerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).RemoveReportHandler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/RemoveReportHandler",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).RemoveReportHandler(ctx, req.(*RemoveReportHandlerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_SetReportHandlerAway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReportHandlerAwayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).SetReportHandlerAway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/SetReportHandlerAway",
 	}
-	return out, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).SetReportHandlerAway(ctx, req.(*SetReportHandlerAwayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *categoryClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*SingleCategory, error) {
-	out := new(SingleCategory)
-	err := c.cc.Invoke(ctx, "/category.Category/CreateCategory", in, out, opts...)
-	if err != nil {
+func _Category_ListReportHandlers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportHandlersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CategoryServer).ListReportHandlers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListReportHandlers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListReportHandlers(ctx, req.(*ListReportHandlersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *categoryClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
-	out := new(ListReportsResponse)
-	err := c.cc.Invoke(ctx, "/category.Category/ListReports", in, out, opts...)
-	if err != nil {
+func _Category_CreateThresholdPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateThresholdPolicyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CategoryServer).CreateThresholdPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/CreateThresholdPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).CreateThresholdPolicy(ctx, req.(*CreateThresholdPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *categoryClient) CreateReport(ctx context.Context, in *CreateReportRequest, opts ...grpc.CallOption) (*SingleReport, error) {
-	out := new(SingleReport)
-	err := c.cc.Invoke(ctx, "/category.Category/CreateReport", in, out, opts...)
-	if err != nil {
+func _Category_DeleteThresholdPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteThresholdPolicyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CategoryServer).DeleteThresholdPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/DeleteThresholdPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).DeleteThresholdPolicy(ctx, req.(*DeleteThresholdPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *categoryClient) DeleteReport(ctx context.Context, in *DeleteReportRequest, opts ...grpc.CallOption) (*DeleteReportResponse, error) {
-	out := new(DeleteReportResponse)
-	err := c.cc.Invoke(ctx, "/category.Category/DeleteReport", in, out, opts...)
-	if err != nil {
+func _Category_ListThresholdPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListThresholdPoliciesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// CategoryServer is the server API for Category service.
-type CategoryServer interface {
-	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
-	GetCategoryInfo(context.Context, *GetCategoryInfoRequest) (*SingleCategory, error)
-	CreateCategory(context.Context, *CreateCategoryRequest) (*SingleCategory, error)
-	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
-	CreateReport(context.Context, *CreateReportRequest) (*SingleReport, error)
-	DeleteReport(context.Context, *DeleteReportRequest) (*DeleteReportResponse, error)
+	if interceptor == nil {
+		return srv.(CategoryServer).ListThresholdPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListThresholdPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListThresholdPolicies(ctx, req.(*ListThresholdPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCategoryServer(s *grpc.Server, srv CategoryServer) {
-	s.RegisterService(&_Category_serviceDesc, srv)
+func _Category_ListAutoActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAutoActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).ListAutoActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/ListAutoActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).ListAutoActions(ctx, req.(*ListAutoActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Category_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCategoriesRequest)
+func _Category_ReviewAutoAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewAutoActionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CategoryServer).ListCategories(ctx, in)
+		return srv.(CategoryServer).ReviewAutoAction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/category.Category/ListCategories",
+		FullMethod: "/category.Category/ReviewAutoAction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CategoryServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+		return srv.(CategoryServer).ReviewAutoAction(ctx, req.(*ReviewAutoActionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Category_GetCategoryInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCategoryInfoRequest)
+func _Category_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CategoryServer).GetCategoryInfo(ctx, in)
+		return srv.(CategoryServer).ListEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/category.Category/GetCategoryInfo",
+		FullMethod: "/category.Category/ListEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CategoryServer).GetCategoryInfo(ctx, req.(*GetCategoryInfoRequest))
+		return srv.(CategoryServer).ListEvents(ctx, req.(*ListEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Category_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCategoryRequest)
+func _Category_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CategoryServer).CreateCategory(ctx, in)
+		return srv.(CategoryServer).SetRetentionPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/category.Category/CreateCategory",
+		FullMethod: "/category.Category/SetRetentionPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CategoryServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+		return srv.(CategoryServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Category_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReportsRequest)
+func _Category_DeleteRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRetentionPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CategoryServer).ListReports(ctx, in)
+		return srv.(CategoryServer).DeleteRetentionPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/category.Category/ListReports",
+		FullMethod: "/category.Category/DeleteRetentionPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CategoryServer).ListReports(ctx, req.(*ListReportsRequest))
+		return srv.(CategoryServer).DeleteRetentionPolicy(ctx, req.(*DeleteRetentionPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Category_CreateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReportRequest)
+func _Category_ListRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRetentionPoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CategoryServer).CreateReport(ctx, in)
+		return srv.(CategoryServer).ListRetentionPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/category.Category/CreateReport",
+		FullMethod: "/category.Category/ListRetentionPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CategoryServer).CreateReport(ctx, req.(*CreateReportRequest))
+		return srv.(CategoryServer).ListRetentionPolicies(ctx, req.(*ListRetentionPoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Category_DeleteReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReportRequest)
+func _Category_PreviewRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRetentionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CategoryServer).DeleteReport(ctx, in)
+		return srv.(CategoryServer).PreviewRetention(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/category.Category/DeleteReport",
+		FullMethod: "/category.Category/PreviewRetention",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CategoryServer).DeleteReport(ctx, req.(*DeleteReportRequest))
+		return srv.(CategoryServer).PreviewRetention(ctx, req.(*PreviewRetentionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -824,10 +10776,166 @@ var _Category_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCategoryInfo",
 			Handler:    _Category_GetCategoryInfo_Handler,
 		},
+		{
+			MethodName: "GetCategoryBySlug",
+			Handler:    _Category_GetCategoryBySlug_Handler,
+		},
 		{
 			MethodName: "CreateCategory",
 			Handler:    _Category_CreateCategory_Handler,
 		},
+		{
+			MethodName: "SearchCategories",
+			Handler:    _Category_SearchCategories_Handler,
+		},
+		{
+			MethodName: "SuggestCategories",
+			Handler:    _Category_SuggestCategories_Handler,
+		},
+		{
+			MethodName: "ListChildCategories",
+			Handler:    _Category_ListChildCategories_Handler,
+		},
+		{
+			MethodName: "GetCategoryAncestors",
+			Handler:    _Category_GetCategoryAncestors_Handler,
+		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _Category_MoveCategory_Handler,
+		},
+		{
+			MethodName: "SetCategoryVisibility",
+			Handler:    _Category_SetCategoryVisibility_Handler,
+		},
+		{
+			MethodName: "Subscribe",
+			Handler:    _Category_Subscribe_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _Category_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "ListSubscribers",
+			Handler:    _Category_ListSubscribers_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _Category_ListSubscriptions_Handler,
+		},
+		{
+			MethodName: "CreateJoinRequest",
+			Handler:    _Category_CreateJoinRequest_Handler,
+		},
+		{
+			MethodName: "ListJoinRequests",
+			Handler:    _Category_ListJoinRequests_Handler,
+		},
+		{
+			MethodName: "ApproveJoinRequest",
+			Handler:    _Category_ApproveJoinRequest_Handler,
+		},
+		{
+			MethodName: "DenyJoinRequest",
+			Handler:    _Category_DenyJoinRequest_Handler,
+		},
+		{
+			MethodName: "CheckMembership",
+			Handler:    _Category_CheckMembership_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _Category_CreateInvite_Handler,
+		},
+		{
+			MethodName: "RedeemInvite",
+			Handler:    _Category_RedeemInvite_Handler,
+		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _Category_ListInvites_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _Category_RevokeInvite_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _Category_BanUser_Handler,
+		},
+		{
+			MethodName: "UnbanUser",
+			Handler:    _Category_UnbanUser_Handler,
+		},
+		{
+			MethodName: "ListBans",
+			Handler:    _Category_ListBans_Handler,
+		},
+		{
+			MethodName: "IsBanned",
+			Handler:    _Category_IsBanned_Handler,
+		},
+		{
+			MethodName: "AddStrike",
+			Handler:    _Category_AddStrike_Handler,
+		},
+		{
+			MethodName: "ListStrikes",
+			Handler:    _Category_ListStrikes_Handler,
+		},
+		{
+			MethodName: "IsSuspended",
+			Handler:    _Category_IsSuspended_Handler,
+		},
+		{
+			MethodName: "GetEscalationPolicy",
+			Handler:    _Category_GetEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "SetEscalationPolicy",
+			Handler:    _Category_SetEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "GrantNoteAccess",
+			Handler:    _Category_GrantNoteAccess_Handler,
+		},
+		{
+			MethodName: "RevokeNoteAccess",
+			Handler:    _Category_RevokeNoteAccess_Handler,
+		},
+		{
+			MethodName: "ListNoteAccessGrants",
+			Handler:    _Category_ListNoteAccessGrants_Handler,
+		},
+		{
+			MethodName: "CreateUserNote",
+			Handler:    _Category_CreateUserNote_Handler,
+		},
+		{
+			MethodName: "ListUserNotes",
+			Handler:    _Category_ListUserNotes_Handler,
+		},
+		{
+			MethodName: "DeleteUserNote",
+			Handler:    _Category_DeleteUserNote_Handler,
+		},
+		{
+			MethodName: "CreateRule",
+			Handler:    _Category_CreateRule_Handler,
+		},
+		{
+			MethodName: "UpdateRule",
+			Handler:    _Category_UpdateRule_Handler,
+		},
+		{
+			MethodName: "ReorderRules",
+			Handler:    _Category_ReorderRules_Handler,
+		},
+		{
+			MethodName: "ListRules",
+			Handler:    _Category_ListRules_Handler,
+		},
 		{
 			MethodName: "ListReports",
 			Handler:    _Category_ListReports_Handler,
@@ -840,51 +10948,500 @@ var _Category_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteReport",
 			Handler:    _Category_DeleteReport_Handler,
 		},
+		{
+			MethodName: "DeleteReports",
+			Handler:    _Category_DeleteReports_Handler,
+		},
+		{
+			MethodName: "DeleteReportsByPost",
+			Handler:    _Category_DeleteReportsByPost_Handler,
+		},
+		{
+			MethodName: "DeleteReportsByFilter",
+			Handler:    _Category_DeleteReportsByFilter_Handler,
+		},
+		{
+			MethodName: "GetModerationStats",
+			Handler:    _Category_GetModerationStats_Handler,
+		},
+		{
+			MethodName: "ListReasonCodes",
+			Handler:    _Category_ListReasonCodes_Handler,
+		},
+		{
+			MethodName: "ListAdminReports",
+			Handler:    _Category_ListAdminReports_Handler,
+		},
+		{
+			MethodName: "ListAllReports",
+			Handler:    _Category_ListAllReports_Handler,
+		},
+		{
+			MethodName: "ClaimReport",
+			Handler:    _Category_ClaimReport_Handler,
+		},
+		{
+			MethodName: "ReleaseReport",
+			Handler:    _Category_ReleaseReport_Handler,
+		},
+		{
+			MethodName: "AssignReport",
+			Handler:    _Category_AssignReport_Handler,
+		},
+		{
+			MethodName: "AddReportNote",
+			Handler:    _Category_AddReportNote_Handler,
+		},
+		{
+			MethodName: "ListReportNotes",
+			Handler:    _Category_ListReportNotes_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _Category_ResolveReport_Handler,
+		},
+		{
+			MethodName: "ListReportOutcomes",
+			Handler:    _Category_ListReportOutcomes_Handler,
+		},
+		{
+			MethodName: "SetAssignmentStrategy",
+			Handler:    _Category_SetAssignmentStrategy_Handler,
+		},
+		{
+			MethodName: "AddReportHandler",
+			Handler:    _Category_AddReportHandler_Handler,
+		},
+		{
+			MethodName: "RemoveReportHandler",
+			Handler:    _Category_RemoveReportHandler_Handler,
+		},
+		{
+			MethodName: "SetReportHandlerAway",
+			Handler:    _Category_SetReportHandlerAway_Handler,
+		},
+		{
+			MethodName: "ListReportHandlers",
+			Handler:    _Category_ListReportHandlers_Handler,
+		},
+		{
+			MethodName: "CreateThresholdPolicy",
+			Handler:    _Category_CreateThresholdPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteThresholdPolicy",
+			Handler:    _Category_DeleteThresholdPolicy_Handler,
+		},
+		{
+			MethodName: "ListThresholdPolicies",
+			Handler:    _Category_ListThresholdPolicies_Handler,
+		},
+		{
+			MethodName: "ListAutoActions",
+			Handler:    _Category_ListAutoActions_Handler,
+		},
+		{
+			MethodName: "ReviewAutoAction",
+			Handler:    _Category_ReviewAutoAction_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _Category_ListEvents_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _Category_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteRetentionPolicy",
+			Handler:    _Category_DeleteRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "ListRetentionPolicies",
+			Handler:    _Category_ListRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "PreviewRetention",
+			Handler:    _Category_PreviewRetention_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportReports",
+			Handler:       _Category_ExportReports_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchReports",
+			Handler:       _Category_WatchReports_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/category/proto/category.proto",
 }
 
 func init() {
-	proto.RegisterFile("pkg/category/proto/category.proto", fileDescriptor_category_63066037b18693f0)
-}
-
-var fileDescriptor_category_63066037b18693f0 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x96, 0xe3, 0x34, 0x3f, 0x93, 0x28, 0xa0, 0x0d, 0x8d, 0x2c, 0x8b, 0xb6, 0xc6, 0x17, 0x22,
-	0x0e, 0x09, 0x0a, 0x97, 0x5e, 0x51, 0x41, 0x88, 0x9f, 0x72, 0x70, 0xe8, 0x03, 0x38, 0xce, 0xd4,
-	0xb2, 0x88, 0xbd, 0x66, 0x77, 0x7d, 0x08, 0x57, 0x4e, 0x5c, 0x78, 0x34, 0xde, 0x80, 0x77, 0x41,
-	0xf1, 0x5f, 0x76, 0x1d, 0xdb, 0xaa, 0xd4, 0xdb, 0xce, 0xec, 0xec, 0xb7, 0xdf, 0xcc, 0x7c, 0x33,
-	0xf0, 0x22, 0xfe, 0xee, 0x2f, 0x3d, 0x57, 0xa0, 0x4f, 0xd9, 0x7e, 0x19, 0x33, 0x2a, 0x68, 0x69,
-	0x2e, 0x52, 0x93, 0x0c, 0x0a, 0xdb, 0xbc, 0xf2, 0x29, 0xf5, 0x77, 0x98, 0x85, 0x6d, 0x92, 0xfb,
-	0xa5, 0x08, 0x42, 0xe4, 0xc2, 0x0d, 0xe3, 0x2c, 0xd4, 0x5e, 0xc3, 0xf9, 0x97, 0x80, 0x8b, 0x9b,
-	0xec, 0x41, 0x80, 0xdc, 0xc1, 0x1f, 0x09, 0x72, 0x41, 0x4c, 0x18, 0xc4, 0xae, 0x8f, 0xeb, 0xe0,
-	0x27, 0x1a, 0x9a, 0xa5, 0xcd, 0xcf, 0x9c, 0xd2, 0x26, 0x97, 0x00, 0x87, 0xf3, 0xd7, 0x24, 0xdc,
-	0x20, 0x33, 0x3a, 0xe9, 0xad, 0xe4, 0xb1, 0xff, 0x68, 0x30, 0xab, 0xa2, 0xf2, 0x98, 0x46, 0x1c,
-	0xc9, 0x35, 0x80, 0x57, 0x7a, 0x0d, 0xcd, 0xd2, 0xe7, 0xa3, 0x95, 0xb1, 0x28, 0xf9, 0xaf, 0x83,
-	0xc8, 0xdf, 0x61, 0xfe, 0x6e, 0xef, 0x48, 0xb1, 0x0a, 0xa1, 0x4e, 0x2b, 0x21, 0xfd, 0x84, 0x10,
-	0x83, 0x89, 0x8a, 0x4c, 0x9e, 0x82, 0x9e, 0x04, 0xdb, 0x34, 0xb3, 0xa1, 0x73, 0x38, 0x12, 0x03,
-	0xfa, 0x09, 0x47, 0x76, 0x17, 0x6c, 0x53, 0xf8, 0xa1, 0x53, 0x98, 0x84, 0x40, 0x37, 0x72, 0x43,
-	0x4c, 0x71, 0x87, 0x4e, 0x7a, 0x26, 0x16, 0x8c, 0xb6, 0xc8, 0x3d, 0x16, 0xc4, 0x22, 0xa0, 0x91,
-	0xd1, 0x4d, 0xaf, 0x64, 0x97, 0xed, 0xc3, 0xf9, 0x0d, 0x43, 0x57, 0x1c, 0xb3, 0xc9, 0x2b, 0x5b,
-	0xc0, 0x69, 0xcd, 0x70, 0x9d, 0x13, 0x38, 0x99, 0x9e, 0xae, 0xd0, 0xb3, 0x5f, 0xc1, 0xec, 0x03,
-	0x16, 0xb5, 0xde, 0x7f, 0x8c, 0xee, 0x69, 0xf1, 0xd3, 0x49, 0x92, 0x36, 0x03, 0x72, 0x68, 0x8c,
-	0x83, 0x31, 0x65, 0xa2, 0xec, 0xb5, 0x05, 0xa3, 0xa2, 0x03, 0x77, 0x65, 0xbc, 0xec, 0x7a, 0x54,
-	0xf1, 0x7f, 0x69, 0x30, 0x55, 0x3e, 0xcd, 0xa5, 0xf0, 0x1a, 0xfa, 0x2c, 0x73, 0xe5, 0x3a, 0x98,
-	0x55, 0x75, 0x90, 0xbd, 0x70, 0x8a, 0xb0, 0x47, 0xb1, 0xf8, 0xad, 0xc1, 0x34, 0xeb, 0x47, 0x8e,
-	0xfa, 0xe0, 0xdc, 0x0d, 0xe8, 0xc7, 0x94, 0x0b, 0x49, 0x18, 0xb9, 0x79, 0xf8, 0xd3, 0xa3, 0x61,
-	0x88, 0x91, 0x38, 0xb6, 0x45, 0xf2, 0x90, 0x19, 0xf4, 0x18, 0xba, 0xbc, 0xd4, 0x47, 0x6e, 0xd9,
-	0x7f, 0x35, 0x18, 0xcb, 0x19, 0xd6, 0xa8, 0xb1, 0x42, 0xab, 0xd3, 0x4a, 0x4b, 0x6f, 0xa3, 0xd5,
-	0x6d, 0xa1, 0x75, 0x26, 0xd3, 0x22, 0xd7, 0x30, 0xf4, 0xd2, 0x0a, 0x6d, 0xdf, 0x0a, 0xa3, 0x67,
-	0x69, 0xf3, 0xd1, 0xca, 0x5c, 0x64, 0x0b, 0x64, 0x51, 0x2c, 0x90, 0xc5, 0xb7, 0x62, 0x81, 0x38,
-	0xc7, 0x60, 0xfb, 0x25, 0x4c, 0xdf, 0xe1, 0x0e, 0xab, 0xb5, 0x3d, 0xd5, 0xdf, 0x0c, 0x9e, 0xa9,
-	0x81, 0x99, 0x16, 0x56, 0xff, 0x74, 0x18, 0x94, 0xb3, 0xb9, 0x86, 0x89, 0xba, 0x3d, 0xc8, 0xd5,
-	0x51, 0x19, 0xb5, 0xdb, 0xca, 0xb4, 0x9a, 0x03, 0x72, 0xb5, 0xdd, 0xc2, 0x93, 0xca, 0x94, 0x10,
-	0xe9, 0x51, 0xfd, 0x00, 0x99, 0x8d, 0x9b, 0x89, 0x7c, 0x86, 0x89, 0x3a, 0xdd, 0x32, 0xc7, 0xda,
-	0xb9, 0x6f, 0x01, 0xfb, 0x04, 0x23, 0x69, 0x40, 0xc8, 0x73, 0x35, 0x19, 0x75, 0x58, 0xcd, 0x8b,
-	0x86, 0xdb, 0x3c, 0xcf, 0xf7, 0x30, 0x96, 0x65, 0x4e, 0x2e, 0xaa, 0xb4, 0x94, 0x16, 0x99, 0x0d,
-	0x33, 0x47, 0x6e, 0x61, 0x2c, 0x37, 0x4a, 0x86, 0xa9, 0xe9, 0xb4, 0x79, 0xd9, 0x74, 0x9d, 0xb1,
-	0xda, 0xf4, 0x52, 0xfd, 0xbc, 0xf9, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x5e, 0xd7, 0x01, 0xbd,
-	0x06, 0x00, 0x00,
+	proto.RegisterFile("pkg/category/proto/category.proto", fileDescriptor_category_be8b86b54344ed0a)
+}
+
+var fileDescriptor_category_be8b86b54344ed0a = []byte{
+	// 5728 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x4d, 0x6f, 0x23, 0xc9,
+	0x75, 0xd3, 0xfc, 0x92, 0xf4, 0xf4, 0x45, 0x95, 0xa4, 0x19, 0x4e, 0x8f, 0x34, 0xab, 0x69, 0xaf,
+	0x77, 0x26, 0x72, 0x3c, 0x5e, 0x8f, 0xbf, 0x76, 0xd7, 0x81, 0xbd, 0x14, 0xc5, 0x91, 0x38, 0x2b,
+	0x91, 0xda, 0x26, 0x35, 0xe3, 0xd9, 0xd8, 0x50, 0x5a, 0x64, 0x8d, 0xd4, 0x1e, 0x92, 0xcd, 0x65,
+	0x37, 0x35, 0x23, 0x5f, 0x62, 0x20, 0x0e, 0x62, 0x18, 0xb1, 0x11, 0x67, 0x03, 0x24, 0x3e, 0x04,
+	0x71, 0x10, 0x04, 0x70, 0xbe, 0x4e, 0xc9, 0x2d, 0x41, 0x0e, 0x39, 0x07, 0x39, 0x04, 0x08, 0x92,
+	0x00, 0x39, 0xe4, 0x96, 0x20, 0x7f, 0x20, 0xb7, 0x24, 0xa8, 0xae, 0xea, 0xee, 0xaa, 0xea, 0x0f,
+	0x92, 0x22, 0x77, 0xd6, 0xb9, 0x75, 0x57, 0xbd, 0x7a, 0xf5, 0xea, 0xd5, 0x7b, 0xf5, 0xf1, 0x3e,
+	0x0a, 0xee, 0xf4, 0x9e, 0x9f, 0x7d, 0xae, 0x69, 0x38, 0xf8, 0xcc, 0xea, 0x5f, 0x7e, 0xae, 0xd7,
+	0xb7, 0x1c, 0xcb, 0xff, 0xbd, 0xef, 0xfe, 0xa2, 0x59, 0xef, 0x5f, 0xbd, 0x7d, 0x66, 0x59, 0x67,
+	0x6d, 0x4c, 0xc1, 0x4e, 0x07, 0xcf, 0x3e, 0xd7, 0x1a, 0xf4, 0x0d, 0xc7, 0xb4, 0xba, 0x14, 0x52,
+	0x7d, 0x4d, 0xae, 0x77, 0xcc, 0x0e, 0xb6, 0x1d, 0xa3, 0xd3, 0xa3, 0x00, 0x5a, 0x07, 0xd6, 0x0f,
+	0x4c, 0xdb, 0x29, 0x51, 0x84, 0x26, 0xb6, 0x75, 0xfc, 0xe1, 0x00, 0xdb, 0x0e, 0x52, 0x61, 0xb6,
+	0x67, 0x9c, 0xe1, 0xba, 0xf9, 0x1d, 0x5c, 0x50, 0xb6, 0x94, 0x7b, 0x59, 0xdd, 0xff, 0x47, 0xb7,
+	0x01, 0xc8, 0x77, 0x75, 0xd0, 0x39, 0xc5, 0xfd, 0x42, 0xca, 0xad, 0xe5, 0x4a, 0x50, 0x01, 0x66,
+	0x06, 0x36, 0xee, 0x1f, 0x9b, 0xad, 0x42, 0x7a, 0x4b, 0xb9, 0x37, 0xa7, 0x7b, 0xbf, 0xda, 0x8f,
+	0x14, 0xb8, 0x2e, 0xf7, 0x67, 0xf7, 0xac, 0xae, 0x8d, 0xd1, 0x5b, 0x00, 0x4d, 0xbf, 0xb4, 0xa0,
+	0x6c, 0xa5, 0xef, 0xcd, 0x3f, 0x28, 0xdc, 0xf7, 0x47, 0x5e, 0x37, 0xbb, 0x67, 0x6d, 0xcc, 0xda,
+	0x5d, 0xea, 0x1c, 0xac, 0x40, 0x6a, 0x2a, 0x91, 0xd4, 0xb4, 0x4c, 0xaa, 0xf6, 0xd3, 0x14, 0x2c,
+	0x89, 0xa8, 0x51, 0x1e, 0xd2, 0x03, 0xb3, 0xe5, 0x0e, 0x7a, 0x4e, 0x27, 0x9f, 0xfc, 0x78, 0x52,
+	0xc2, 0x78, 0x10, 0x82, 0x4c, 0xd7, 0xe8, 0x60, 0x36, 0x4c, 0xf7, 0x1b, 0x6d, 0xc1, 0x7c, 0x0b,
+	0xdb, 0xcd, 0xbe, 0xd9, 0x23, 0x13, 0x51, 0xc8, 0xb8, 0x55, 0x7c, 0x11, 0x21, 0xb8, 0x6d, 0x74,
+	0xcf, 0x06, 0xc6, 0x19, 0x2e, 0x64, 0xdd, 0x6a, 0xff, 0x9f, 0x60, 0xb4, 0xdb, 0x83, 0xb3, 0x42,
+	0x8e, 0x62, 0x24, 0xdf, 0x68, 0x03, 0xe6, 0x7a, 0x46, 0x1f, 0x77, 0x1d, 0x42, 0xc1, 0x8c, 0x5b,
+	0x11, 0x14, 0xa0, 0x7b, 0xb0, 0x6c, 0x0f, 0x4e, 0x09, 0xf6, 0x53, 0xdc, 0x2f, 0x59, 0x83, 0xae,
+	0x53, 0x98, 0xdd, 0x52, 0xee, 0xa5, 0x75, 0xb9, 0x18, 0x7d, 0x11, 0xe0, 0xc2, 0xb4, 0xcd, 0x53,
+	0xb3, 0x6d, 0x3a, 0x97, 0x85, 0xb9, 0x2d, 0xe5, 0xde, 0xd2, 0x83, 0xb5, 0x80, 0xc5, 0x8f, 0xfd,
+	0x3a, 0x9d, 0x83, 0xd3, 0xfe, 0x59, 0x81, 0xf5, 0x52, 0x1f, 0x1b, 0x4e, 0xc0, 0x7d, 0x26, 0x23,
+	0xde, 0xe8, 0x95, 0xf8, 0xd1, 0xa7, 0xc2, 0xa3, 0x8f, 0x95, 0x0e, 0x81, 0x2f, 0x19, 0x89, 0x2f,
+	0x02, 0x0f, 0xb2, 0x32, 0x0f, 0xc4, 0x91, 0xe5, 0x46, 0x1c, 0xd9, 0xf7, 0x14, 0x50, 0x5d, 0x69,
+	0x3c, 0x37, 0xdb, 0xad, 0xb0, 0x0a, 0x84, 0x05, 0x61, 0x02, 0x49, 0xe3, 0x87, 0x9d, 0x11, 0x95,
+	0xa2, 0x02, 0xb7, 0xf6, 0xb0, 0xa7, 0x12, 0x97, 0xc5, 0x6e, 0x13, 0xdb, 0x8e, 0xd5, 0x4f, 0x20,
+	0x23, 0x56, 0x1e, 0xb5, 0x6f, 0xc0, 0x46, 0x34, 0xaa, 0x49, 0x95, 0x4c, 0x3b, 0x81, 0xd5, 0x43,
+	0xeb, 0x22, 0x24, 0x02, 0x61, 0xe2, 0x84, 0x89, 0x4a, 0xc9, 0x13, 0x15, 0xbf, 0x34, 0x7c, 0x57,
+	0x81, 0x8d, 0x7a, 0x40, 0x3b, 0x37, 0x65, 0xe3, 0xf3, 0x41, 0x92, 0x87, 0xf4, 0x88, 0xf2, 0x50,
+	0x85, 0x7c, 0xdd, 0x53, 0x19, 0xaf, 0xd7, 0x2d, 0x98, 0xf7, 0x9a, 0x1d, 0xfb, 0xbd, 0xf3, 0x45,
+	0x09, 0xb3, 0xb1, 0x0a, 0x2b, 0x1c, 0x3e, 0x3a, 0x05, 0xda, 0x11, 0xa0, 0xe3, 0xae, 0x3d, 0xcd,
+	0x6e, 0xd6, 0x61, 0x55, 0xc0, 0xc8, 0x3a, 0xfa, 0x2d, 0xb6, 0xd6, 0xfa, 0x24, 0xf4, 0xed, 0xd1,
+	0x7b, 0xfb, 0x78, 0x24, 0xfd, 0x07, 0x0a, 0x20, 0x2a, 0x63, 0x8c, 0x28, 0xba, 0x22, 0x4c, 0x30,
+	0x78, 0xf4, 0x16, 0xcc, 0x35, 0xdd, 0xc5, 0xa9, 0x55, 0x74, 0x5c, 0x5a, 0xe6, 0x1f, 0xa8, 0xf7,
+	0xe9, 0xae, 0x77, 0xdf, 0xdb, 0xf5, 0xee, 0x37, 0xbc, 0x5d, 0x4f, 0x0f, 0x80, 0xb5, 0x9f, 0x28,
+	0x70, 0x23, 0xc4, 0x1f, 0xa6, 0x27, 0x3b, 0xb0, 0x68, 0x73, 0x14, 0x7a, 0xaa, 0xb2, 0x21, 0xab,
+	0x0a, 0x3f, 0x0c, 0x5d, 0x6c, 0x32, 0xd1, 0xb6, 0xf4, 0x23, 0x05, 0x0a, 0x1c, 0x6d, 0x14, 0xa3,
+	0x37, 0x7b, 0x1c, 0x33, 0x94, 0xd0, 0x02, 0x7a, 0xe5, 0x59, 0xdb, 0x80, 0xb9, 0x0b, 0x13, 0xbf,
+	0xe0, 0xe7, 0x2d, 0x28, 0xd0, 0x1e, 0x43, 0x81, 0xee, 0x01, 0x8f, 0x2c, 0xb3, 0xcb, 0x08, 0x99,
+	0x86, 0xec, 0xfe, 0x20, 0x05, 0x2b, 0x94, 0x95, 0x1c, 0xe2, 0x08, 0x55, 0x97, 0xfa, 0x48, 0x25,
+	0xf6, 0x21, 0x6d, 0x2b, 0x5f, 0x80, 0x9c, 0xed, 0x18, 0xce, 0xc0, 0x76, 0x87, 0xb5, 0xf4, 0xe0,
+	0x56, 0x30, 0x8b, 0x5c, 0xa7, 0x75, 0x17, 0x44, 0x67, 0xa0, 0xa2, 0x5c, 0x65, 0xc7, 0x90, 0x2b,
+	0xd2, 0xb2, 0x85, 0x9b, 0x66, 0xcb, 0x6d, 0x99, 0x1b, 0xde, 0xd2, 0x07, 0xd6, 0x7e, 0xcc, 0x24,
+	0x92, 0xa3, 0xca, 0x9e, 0x02, 0x93, 0x05, 0xb1, 0x48, 0x27, 0x8a, 0x45, 0x26, 0x24, 0x89, 0xbf,
+	0xcb, 0x24, 0x51, 0xa4, 0x89, 0xa9, 0xc9, 0xd7, 0x61, 0xe1, 0xdb, 0x5c, 0x39, 0xd3, 0x92, 0x5b,
+	0xb2, 0x96, 0xf0, 0x32, 0x23, 0x34, 0x98, 0x48, 0x47, 0x1e, 0x42, 0x61, 0xd7, 0x65, 0x5d, 0x84,
+	0x48, 0x8e, 0xb3, 0x67, 0x36, 0xe0, 0x7a, 0xe9, 0x1c, 0x37, 0x9f, 0x1f, 0x62, 0x82, 0xd6, 0x3e,
+	0x37, 0x7b, 0xd3, 0x10, 0xec, 0x1f, 0x2a, 0x70, 0x23, 0x84, 0x96, 0xb1, 0xed, 0x3a, 0xe4, 0x3a,
+	0x6e, 0xa9, 0x8b, 0x72, 0x56, 0x67, 0x7f, 0xe8, 0x0d, 0x58, 0xea, 0xe1, 0x6e, 0xcb, 0xec, 0x9e,
+	0x31, 0x0a, 0x5c, 0xa4, 0xb3, 0xba, 0x54, 0x4a, 0x7a, 0x6d, 0x1a, 0x5d, 0x1d, 0x1b, 0x54, 0xd4,
+	0x67, 0x75, 0xef, 0x97, 0xd5, 0x1c, 0x59, 0xb6, 0x53, 0xc8, 0xf8, 0x35, 0xe4, 0x57, 0xfb, 0x63,
+	0x05, 0x56, 0xa9, 0x06, 0x57, 0xba, 0x17, 0xa6, 0x33, 0x8d, 0x8d, 0x87, 0xd4, 0x74, 0x8c, 0x97,
+	0xc7, 0x36, 0xb6, 0xd9, 0xf4, 0x78, 0xbf, 0x44, 0x07, 0xf0, 0xcb, 0x9e, 0xd9, 0xc7, 0x76, 0x91,
+	0x52, 0x32, 0x44, 0x07, 0x7c, 0x60, 0xed, 0xbb, 0x29, 0x58, 0xa0, 0x52, 0x43, 0xe9, 0x24, 0x87,
+	0xcc, 0xa6, 0xd5, 0xf2, 0x0f, 0x99, 0xe4, 0x7b, 0xa2, 0xd5, 0x80, 0x23, 0x3a, 0x23, 0x12, 0x8d,
+	0x20, 0x33, 0x20, 0xc5, 0x59, 0xb7, 0x38, 0x33, 0x08, 0x0d, 0x24, 0x37, 0xc6, 0x40, 0xc4, 0x05,
+	0x64, 0x66, 0x9c, 0x8d, 0xa9, 0x04, 0xab, 0x3a, 0x6e, 0x61, 0xdc, 0x11, 0x67, 0x2a, 0x8a, 0x11,
+	0xf1, 0xf2, 0xf7, 0x9b, 0x0a, 0x20, 0xa2, 0xb7, 0x14, 0xc7, 0x27, 0xbe, 0x8c, 0xfc, 0x9a, 0x02,
+	0xab, 0x02, 0x39, 0x4c, 0x15, 0xde, 0x84, 0x19, 0x93, 0x16, 0xb1, 0xc5, 0xe3, 0xba, 0xbc, 0x78,
+	0x30, 0x26, 0x78, 0x60, 0x13, 0x2d, 0x19, 0x2e, 0x67, 0x2f, 0xac, 0xe7, 0x78, 0x12, 0xce, 0x5e,
+	0x87, 0x35, 0x11, 0x09, 0x3b, 0x6f, 0xfd, 0x9d, 0x02, 0x4b, 0x3b, 0x46, 0xf7, 0xd8, 0xc6, 0xfd,
+	0x69, 0x70, 0x5b, 0x83, 0x85, 0x8e, 0xd5, 0xc2, 0x7d, 0xc3, 0xb1, 0x38, 0x31, 0x16, 0xca, 0xc8,
+	0x42, 0xd2, 0xc7, 0x86, 0xed, 0xdf, 0x32, 0xd9, 0x9f, 0x28, 0xb5, 0xd9, 0x71, 0xd4, 0xef, 0xbf,
+	0x15, 0x98, 0xa3, 0x7c, 0xdf, 0x31, 0xba, 0xff, 0xff, 0xe8, 0x17, 0xb5, 0x2e, 0x37, 0x8e, 0xd6,
+	0xf5, 0x21, 0x7f, 0xdc, 0x3d, 0x7d, 0xa5, 0xf3, 0x47, 0x2e, 0x08, 0x5c, 0x9f, 0x4c, 0x8e, 0x3e,
+	0x52, 0x60, 0x99, 0xa8, 0xca, 0x8e, 0xd1, 0x7d, 0x45, 0x07, 0x76, 0x99, 0xd4, 0x4c, 0x04, 0xa9,
+	0x2f, 0x20, 0x1f, 0x10, 0xc5, 0x94, 0xf7, 0x2e, 0x64, 0x4e, 0x0d, 0xff, 0x70, 0xbc, 0x2a, 0x6b,
+	0xee, 0x8e, 0xd1, 0xd5, 0x5d, 0x80, 0x89, 0x74, 0xf6, 0x10, 0x96, 0x2b, 0xf6, 0x8e, 0xd1, 0xed,
+	0xe2, 0xd6, 0x34, 0xf6, 0xe5, 0xf7, 0x21, 0x1f, 0xa0, 0x0b, 0xf6, 0xe3, 0x53, 0xb7, 0xc4, 0xdb,
+	0x8f, 0xe9, 0x1f, 0xfa, 0x34, 0xa4, 0x4f, 0x0d, 0x6a, 0xc3, 0x88, 0x19, 0x1e, 0xa9, 0xd7, 0x7e,
+	0xa6, 0x40, 0xbe, 0xd8, 0x6a, 0xd5, 0x9d, 0xbe, 0xf9, 0x1c, 0xbf, 0x2a, 0xd5, 0xdf, 0x80, 0xb9,
+	0x3e, 0xee, 0x59, 0x7d, 0x87, 0x3b, 0xae, 0xfb, 0x05, 0x9c, 0x62, 0x65, 0x79, 0xc5, 0xd2, 0xfe,
+	0xd4, 0xdf, 0x5d, 0x29, 0xb5, 0x53, 0x3e, 0x69, 0x8f, 0x20, 0x48, 0x22, 0xe1, 0xd9, 0x78, 0xc2,
+	0x73, 0xf2, 0x8a, 0x70, 0xb5, 0xdd, 0x54, 0x5c, 0x4b, 0x66, 0xc7, 0x59, 0x0b, 0xff, 0x4b, 0x81,
+	0xbc, 0x77, 0xcd, 0xb3, 0x7b, 0xb8, 0x6b, 0x93, 0xbb, 0xea, 0x74, 0x19, 0xb6, 0x01, 0x73, 0xb6,
+	0x3b, 0x11, 0xdc, 0x2c, 0xfa, 0x05, 0xe8, 0xcb, 0x30, 0x6b, 0x3b, 0x46, 0xdf, 0x19, 0x6d, 0x15,
+	0xf4, 0x61, 0xd1, 0x03, 0xc8, 0xe1, 0x6e, 0x6b, 0xb4, 0x13, 0x0b, 0x83, 0xd4, 0x7e, 0x15, 0x56,
+	0x38, 0x19, 0x66, 0x8a, 0x71, 0x9f, 0xdc, 0x9c, 0x48, 0x89, 0x3b, 0xde, 0x88, 0xcd, 0x99, 0xc1,
+	0x33, 0x28, 0xf4, 0x0e, 0x80, 0xed, 0xb3, 0x8a, 0xe9, 0x8d, 0x1a, 0x6a, 0xe3, 0x43, 0xe8, 0x1c,
+	0xb4, 0xf6, 0x57, 0xec, 0xc0, 0x42, 0x51, 0x4e, 0xe5, 0xc0, 0xf2, 0x06, 0x2c, 0x99, 0xdd, 0x66,
+	0x7b, 0xd0, 0xc2, 0x65, 0x77, 0x52, 0xbd, 0xe3, 0xb2, 0x54, 0x2a, 0x2c, 0x4f, 0x99, 0xc4, 0xe5,
+	0x29, 0x1b, 0x7b, 0xb0, 0xf1, 0xc9, 0x0e, 0x0e, 0x36, 0x94, 0x29, 0xb1, 0x07, 0x1b, 0xc6, 0x3b,
+	0x0f, 0x6c, 0xa2, 0x45, 0xf2, 0x08, 0x50, 0xc5, 0xa6, 0x8c, 0x6d, 0x4d, 0x67, 0x9d, 0xb4, 0x60,
+	0x55, 0xc0, 0xc8, 0x86, 0x45, 0x04, 0xd6, 0x2b, 0x64, 0xab, 0x65, 0x50, 0x30, 0xd1, 0xfc, 0x7f,
+	0x0d, 0xd4, 0x3d, 0xec, 0x94, 0xed, 0xa6, 0xd1, 0x76, 0x3d, 0x18, 0x47, 0x56, 0xdb, 0x6c, 0x5e,
+	0x8e, 0x3c, 0x14, 0xed, 0xf7, 0x53, 0x70, 0x9d, 0x76, 0x20, 0xe3, 0x18, 0x81, 0x0f, 0x5b, 0x30,
+	0x4f, 0xa7, 0xe1, 0xc0, 0xec, 0x98, 0x0e, 0x63, 0x3f, 0x5f, 0x84, 0x3e, 0x0f, 0xb9, 0x17, 0x66,
+	0xb7, 0x65, 0xbd, 0x60, 0x46, 0xa6, 0x9b, 0x21, 0x9d, 0xda, 0x65, 0xae, 0x17, 0x9d, 0x01, 0xa2,
+	0x0a, 0xa0, 0x60, 0x7c, 0x5e, 0x6d, 0x21, 0x33, 0xac, 0x79, 0x44, 0x23, 0x54, 0x84, 0x25, 0x8f,
+	0x98, 0x67, 0x98, 0xf8, 0x70, 0x0a, 0xd9, 0x61, 0x68, 0xa4, 0x06, 0xda, 0x5f, 0xa7, 0x40, 0xad,
+	0x4f, 0xc0, 0xe0, 0x04, 0x3d, 0x93, 0xb8, 0x97, 0x4e, 0xe2, 0x5e, 0x66, 0x32, 0xee, 0x65, 0xa7,
+	0xc3, 0xbd, 0xdc, 0xb8, 0xdc, 0xb3, 0x60, 0xa5, 0x6a, 0x39, 0xb8, 0xd8, 0x6c, 0x62, 0x7b, 0x2a,
+	0x6b, 0xd3, 0x6d, 0x80, 0xb3, 0xbe, 0xd1, 0x75, 0x30, 0x0e, 0xb6, 0x05, 0xae, 0x84, 0xd8, 0x0f,
+	0xd6, 0xa9, 0x38, 0x07, 0xfd, 0xee, 0x91, 0xea, 0x4f, 0xc8, 0x5a, 0xaa, 0x42, 0x81, 0xde, 0x7a,
+	0x78, 0x36, 0xb0, 0x13, 0xeb, 0x53, 0xb8, 0x45, 0x96, 0x40, 0x89, 0xd0, 0x69, 0xb0, 0x49, 0x7b,
+	0x02, 0x1b, 0xd1, 0xa8, 0xd9, 0x7a, 0xf4, 0x15, 0xc8, 0xb9, 0x4c, 0xf3, 0x56, 0xd9, 0xd7, 0xe4,
+	0xd5, 0x46, 0x6a, 0xa9, 0x33, 0x70, 0xed, 0x8f, 0x7c, 0xaf, 0x16, 0x39, 0x7c, 0x13, 0xa8, 0x69,
+	0xcc, 0xea, 0x06, 0xcc, 0x19, 0x03, 0xe7, 0x9c, 0x3f, 0xb6, 0x05, 0x05, 0xe4, 0x9e, 0xe9, 0xe0,
+	0x97, 0x0e, 0xdb, 0xe8, 0xdd, 0xef, 0xe4, 0xe3, 0x90, 0xf6, 0x9f, 0x8a, 0xe7, 0x9e, 0xf4, 0xa8,
+	0x9c, 0xfe, 0x01, 0x24, 0x20, 0x38, 0x13, 0x47, 0x70, 0x36, 0x8e, 0xe0, 0x9c, 0x7c, 0x7e, 0xbb,
+	0xba, 0xd5, 0xe3, 0xcf, 0x15, 0x58, 0x23, 0x53, 0xed, 0x0d, 0xd4, 0x9e, 0xd2, 0x7c, 0x04, 0x46,
+	0xed, 0xb4, 0x64, 0xd4, 0x9e, 0x74, 0xdf, 0x5f, 0x97, 0xc8, 0xf5, 0x0f, 0x4d, 0xd9, 0xae, 0xe5,
+	0xc4, 0xbb, 0xd7, 0x7c, 0x79, 0xa3, 0x60, 0x13, 0xed, 0xfb, 0x7b, 0xb0, 0xbe, 0x8b, 0xdb, 0x38,
+	0x2c, 0xc4, 0x91, 0x7e, 0xb9, 0x80, 0x15, 0x29, 0xd9, 0xbe, 0x5f, 0x80, 0xeb, 0x32, 0x22, 0xa6,
+	0xdc, 0x7f, 0xa8, 0xc0, 0x8d, 0x3a, 0x36, 0xfa, 0xcd, 0xf3, 0xb0, 0x87, 0x74, 0x0d, 0xb2, 0x1f,
+	0x0e, 0x70, 0xff, 0x92, 0xf5, 0x43, 0x7f, 0x04, 0x37, 0x6e, 0x4a, 0x72, 0xe3, 0x4e, 0x60, 0x43,
+	0xe2, 0xa7, 0x39, 0x2b, 0xae, 0x12, 0x7f, 0xa3, 0xc0, 0x9a, 0xe7, 0x38, 0xa4, 0xb4, 0xea, 0xd8,
+	0x1e, 0xb4, 0x89, 0xc7, 0xdb, 0x8f, 0x95, 0x60, 0x47, 0xd8, 0x78, 0x6f, 0xa7, 0x0f, 0x49, 0x86,
+	0x65, 0x37, 0xad, 0x3e, 0xa5, 0x3e, 0xa5, 0xd3, 0x1f, 0xf4, 0x3a, 0x2c, 0x12, 0x0f, 0xf7, 0xbe,
+	0x79, 0x76, 0xde, 0x36, 0xcf, 0xce, 0x1d, 0x26, 0x4f, 0x62, 0x21, 0x7a, 0x00, 0x6b, 0x9c, 0xb3,
+	0x3b, 0x00, 0xa6, 0xba, 0x15, 0x59, 0x47, 0x3c, 0x75, 0x85, 0x30, 0x8b, 0x7d, 0x97, 0xed, 0x4c,
+	0xdf, 0x1d, 0x8c, 0x27, 0x50, 0xb7, 0x83, 0x11, 0x44, 0x8d, 0x59, 0xf7, 0xc0, 0x27, 0x12, 0xac,
+	0x53, 0x28, 0xd4, 0x07, 0x67, 0x67, 0x38, 0x2a, 0x34, 0xe4, 0x3a, 0xe4, 0x7a, 0x7d, 0xfc, 0xcc,
+	0x7c, 0xc9, 0xa6, 0x9d, 0xfd, 0x11, 0xb6, 0xb5, 0xb9, 0xe3, 0x13, 0xfd, 0x49, 0xf0, 0xf8, 0x1e,
+	0xc3, 0xcd, 0x88, 0x3e, 0x26, 0xf6, 0x54, 0xef, 0xc2, 0x75, 0xce, 0x07, 0x5e, 0xe9, 0x3e, 0xb3,
+	0xae, 0xe2, 0x15, 0xd8, 0x87, 0x02, 0x87, 0x65, 0xe7, 0xb2, 0xde, 0x1e, 0x9c, 0x71, 0xf6, 0x42,
+	0x37, 0x46, 0x43, 0xe1, 0x62, 0x34, 0xe2, 0x31, 0xfd, 0x86, 0x02, 0x2b, 0x74, 0xa7, 0xd1, 0x07,
+	0xed, 0xa9, 0xec, 0x32, 0x6b, 0x90, 0x75, 0x4c, 0xa7, 0xed, 0x85, 0x9d, 0xd0, 0x9f, 0xe1, 0x71,
+	0x27, 0xda, 0x3f, 0x28, 0x00, 0x94, 0x71, 0x84, 0x92, 0x2b, 0xed, 0x24, 0x44, 0xa6, 0x2c, 0xdb,
+	0x74, 0x7b, 0xf0, 0xf4, 0x97, 0xfd, 0x07, 0x64, 0x65, 0x12, 0xc8, 0xca, 0x86, 0xc8, 0x9a, 0xc0,
+	0x66, 0xf7, 0x02, 0x56, 0x8e, 0x7b, 0x2d, 0x89, 0xb3, 0x63, 0xcc, 0xf2, 0x95, 0x39, 0xd9, 0x21,
+	0x86, 0x64, 0xab, 0xdf, 0xc2, 0x7d, 0xd2, 0xf3, 0xb4, 0xac, 0xeb, 0xfd, 0x41, 0x9b, 0x9c, 0xfd,
+	0x88, 0x37, 0x25, 0x4d, 0x56, 0x4d, 0xef, 0x9f, 0x04, 0x26, 0x90, 0xbd, 0x66, 0x5a, 0x7d, 0x69,
+	0x5f, 0x87, 0x15, 0x0e, 0x1f, 0xd3, 0xb8, 0x6d, 0xc8, 0x92, 0x0e, 0x3d, 0x65, 0x5b, 0x93, 0x95,
+	0xcd, 0xe5, 0x31, 0x05, 0xd1, 0x7e, 0x96, 0xa2, 0x97, 0x75, 0xdd, 0xdd, 0xf8, 0x5f, 0x91, 0x99,
+	0xf2, 0x3e, 0x20, 0x76, 0x71, 0xdf, 0xc5, 0x76, 0x13, 0x77, 0x5b, 0xee, 0xb9, 0x8f, 0xfa, 0xb9,
+	0x22, 0x6a, 0xc8, 0xf8, 0x19, 0x07, 0xbd, 0xfd, 0x82, 0xfd, 0x12, 0x3a, 0xcf, 0xfa, 0xd6, 0xa0,
+	0xb7, 0x73, 0x49, 0x06, 0xe5, 0xca, 0xdc, 0xac, 0xce, 0x17, 0x11, 0x08, 0xc3, 0xb6, 0xcd, 0xb3,
+	0x2e, 0x3d, 0x9f, 0xd3, 0xa0, 0x2b, 0xbe, 0x88, 0x18, 0x17, 0x06, 0x5d, 0x56, 0xd0, 0xaa, 0x75,
+	0xdb, 0x97, 0xae, 0x71, 0x69, 0x56, 0x97, 0x4a, 0xb5, 0x27, 0xb0, 0x4c, 0xa5, 0x93, 0x70, 0x8a,
+	0xc6, 0x61, 0x71, 0x84, 0x29, 0x22, 0x61, 0xbe, 0x3c, 0xa6, 0x78, 0x79, 0x5c, 0x83, 0x6c, 0x93,
+	0x34, 0x74, 0x79, 0x92, 0xd6, 0xe9, 0x8f, 0xf6, 0xb7, 0xcc, 0xf2, 0xe0, 0xcf, 0x41, 0x60, 0x79,
+	0xa0, 0xe7, 0xb1, 0x58, 0xcb, 0x03, 0x6d, 0xa1, 0x7b, 0x60, 0x13, 0x4d, 0xca, 0xdb, 0x00, 0x84,
+	0x78, 0x77, 0x60, 0x64, 0x32, 0xd2, 0xee, 0xbd, 0xca, 0xef, 0x50, 0x1a, 0xba, 0xce, 0x01, 0x6b,
+	0xff, 0xea, 0xbb, 0x24, 0x19, 0x41, 0xe3, 0x48, 0x76, 0xcf, 0xb2, 0xb9, 0x08, 0x23, 0xef, 0x97,
+	0x90, 0xdb, 0xb4, 0x3a, 0x1d, 0x16, 0x7e, 0xc4, 0xae, 0x55, 0x41, 0x49, 0xac, 0xc7, 0x21, 0x5e,
+	0x56, 0xbe, 0x08, 0x40, 0x61, 0x4a, 0x56, 0x0b, 0x87, 0x43, 0xcb, 0x74, 0xbf, 0x4e, 0xe7, 0xe0,
+	0xb4, 0xff, 0x4d, 0x7b, 0x86, 0x56, 0x3a, 0xb6, 0xab, 0x1e, 0xdb, 0xbd, 0x61, 0xa6, 0x93, 0x86,
+	0x99, 0x49, 0x18, 0x66, 0x36, 0xde, 0x8c, 0x3a, 0xce, 0x52, 0xcb, 0x33, 0x68, 0x26, 0x89, 0x41,
+	0xb3, 0xa3, 0x31, 0x08, 0xdd, 0x87, 0x59, 0x1b, 0x5f, 0xe0, 0x7e, 0x10, 0x89, 0x88, 0x38, 0x31,
+	0x65, 0x35, 0xba, 0x0f, 0x83, 0x3e, 0x03, 0x33, 0x7d, 0x6b, 0xe0, 0x98, 0xdd, 0xb3, 0x02, 0xb8,
+	0xe0, 0x2b, 0x5c, 0x17, 0xb4, 0x42, 0xf7, 0x20, 0x64, 0xed, 0x9d, 0x0f, 0x6b, 0xef, 0x0e, 0x2c,
+	0x35, 0xdb, 0x86, 0xd9, 0x29, 0xfb, 0xa6, 0xe1, 0x85, 0xa1, 0xdc, 0x90, 0x5a, 0x90, 0x13, 0x75,
+	0xd7, 0x72, 0xa8, 0x34, 0x17, 0x16, 0x5d, 0xcd, 0x08, 0x0a, 0xb4, 0xf7, 0x60, 0x95, 0x9e, 0xa8,
+	0x45, 0xe1, 0x0e, 0xcb, 0x81, 0x6c, 0x34, 0x4f, 0x45, 0x78, 0x5f, 0xae, 0xc3, 0x9a, 0x88, 0x8c,
+	0x1d, 0xce, 0x0b, 0x34, 0xc4, 0x2b, 0xe0, 0xb1, 0xb7, 0x14, 0x6b, 0x3f, 0xf1, 0x8d, 0xd7, 0x41,
+	0x25, 0xba, 0xc7, 0x39, 0x3a, 0xe3, 0x26, 0x29, 0xd3, 0x94, 0xa7, 0x27, 0x35, 0xde, 0xf4, 0xa4,
+	0x87, 0x4d, 0x8f, 0xf6, 0x84, 0x86, 0xb9, 0x08, 0x54, 0xb3, 0xc5, 0xeb, 0x97, 0x60, 0x3e, 0x10,
+	0x12, 0x6f, 0x01, 0x53, 0xc3, 0x0b, 0x98, 0x4f, 0x2e, 0x0f, 0xae, 0x1d, 0x53, 0xc4, 0xc5, 0x56,
+	0xc7, 0xec, 0x4a, 0x5b, 0xd3, 0x04, 0xf1, 0xcc, 0xda, 0xbf, 0xa7, 0xe8, 0x5d, 0xaf, 0xd8, 0x6e,
+	0x4f, 0x0f, 0x2b, 0xfa, 0x1a, 0x2c, 0x78, 0xea, 0xf5, 0xcc, 0x61, 0x6b, 0x6b, 0xb2, 0x00, 0x0a,
+	0xf0, 0xe8, 0x5d, 0x58, 0x64, 0xff, 0x3b, 0xf8, 0x99, 0xd5, 0xc7, 0x23, 0xc4, 0x59, 0x88, 0x0d,
+	0x08, 0x85, 0x94, 0x7b, 0x8d, 0xe0, 0x92, 0xcf, 0x95, 0x10, 0xc9, 0xe4, 0x96, 0x23, 0xbb, 0x90,
+	0x73, 0x8f, 0x25, 0x42, 0x19, 0xbf, 0x46, 0xcd, 0x88, 0x6b, 0xd4, 0x67, 0x20, 0xeb, 0x9e, 0x90,
+	0xd8, 0x92, 0xb0, 0xce, 0x4b, 0x1b, 0x61, 0x62, 0x8d, 0x54, 0xea, 0x14, 0x46, 0x7b, 0x04, 0xa8,
+	0x44, 0xb4, 0x6b, 0x1a, 0xca, 0x72, 0x40, 0x1c, 0xf4, 0x6d, 0x6c, 0xd8, 0x53, 0x51, 0xbd, 0x3f,
+	0x50, 0x60, 0xb5, 0xe8, 0xae, 0x1c, 0xc3, 0xb0, 0x49, 0xab, 0x4e, 0x2a, 0xbc, 0xea, 0xbc, 0x09,
+	0xab, 0xf8, 0x65, 0x0f, 0x37, 0xc9, 0x1c, 0x72, 0x90, 0x74, 0x71, 0x8f, 0xaa, 0x1a, 0xc9, 0x35,
+	0xfb, 0x3b, 0x34, 0x72, 0x96, 0x36, 0x23, 0x3b, 0x40, 0xdd, 0xe9, 0x13, 0x56, 0x4f, 0xc5, 0xb6,
+	0xfb, 0x16, 0xf1, 0x41, 0x51, 0x74, 0x4c, 0xb3, 0xb9, 0x20, 0xc8, 0x88, 0x2e, 0x7d, 0x68, 0xed,
+	0x29, 0x6c, 0xc6, 0x50, 0xe5, 0x5f, 0xf1, 0x02, 0xd4, 0xca, 0x58, 0xa8, 0x49, 0xa0, 0x5c, 0xb1,
+	0xd5, 0xa2, 0x13, 0xb2, 0x6f, 0x74, 0x5b, 0xed, 0xe9, 0xf8, 0xec, 0x6f, 0x03, 0x9c, 0x53, 0x6c,
+	0xdc, 0xe9, 0x21, 0x28, 0x21, 0xea, 0xfe, 0x1c, 0x5f, 0xbe, 0xb0, 0xfa, 0x2d, 0x7a, 0xd4, 0x99,
+	0xd3, 0xfd, 0x7f, 0xed, 0xa3, 0x14, 0xac, 0xf2, 0x3b, 0x3e, 0x23, 0x6b, 0x22, 0x7a, 0x10, 0x64,
+	0x8c, 0x17, 0xc6, 0x25, 0x73, 0x5b, 0xb9, 0xdf, 0x49, 0x34, 0x90, 0x5d, 0xad, 0x6d, 0xd8, 0x8c,
+	0xe7, 0x23, 0x46, 0x2e, 0x4a, 0x2d, 0x26, 0x38, 0x22, 0x20, 0xc8, 0xb4, 0x2d, 0x83, 0xae, 0x03,
+	0x69, 0xdd, 0xfd, 0xd6, 0x5e, 0x82, 0xaa, 0xe3, 0x8e, 0x75, 0x81, 0x5f, 0xf5, 0x5c, 0x69, 0x9b,
+	0x70, 0x2b, 0xb2, 0x67, 0xb6, 0x73, 0xfe, 0x50, 0x81, 0x5b, 0x75, 0xec, 0x08, 0x95, 0xc5, 0x17,
+	0xc6, 0xe5, 0xab, 0x10, 0x23, 0x6f, 0x5a, 0x33, 0xc1, 0xb4, 0x6a, 0x4f, 0xe0, 0x66, 0x70, 0x98,
+	0x67, 0xf4, 0x4c, 0xe5, 0xae, 0xf7, 0x63, 0x96, 0xe4, 0x20, 0x63, 0x66, 0x4a, 0xf8, 0x36, 0xcc,
+	0x32, 0xca, 0xbc, 0xdd, 0x76, 0x33, 0xfa, 0xba, 0xe0, 0x31, 0xd0, 0x07, 0x17, 0xf4, 0x37, 0x35,
+	0x96, 0xfe, 0xfe, 0x87, 0x02, 0x1b, 0xf4, 0xe4, 0xdf, 0x38, 0xef, 0x63, 0xfb, 0xdc, 0x6a, 0xb7,
+	0xa6, 0xea, 0x8d, 0xea, 0x07, 0x37, 0x0e, 0xcf, 0x1b, 0xc5, 0x15, 0x5d, 0xc5, 0x1b, 0xf5, 0x65,
+	0xf1, 0x5c, 0x92, 0xdd, 0x4a, 0xc7, 0x1e, 0xa0, 0x84, 0x13, 0xc9, 0x6f, 0xa7, 0x3c, 0x37, 0x8e,
+	0x34, 0xd2, 0x2b, 0x5d, 0x08, 0x7e, 0x9e, 0x86, 0x36, 0x81, 0xd9, 0xe6, 0x11, 0x6c, 0xd0, 0xd3,
+	0x6c, 0xcc, 0xec, 0x8f, 0x63, 0xa7, 0x7b, 0x0d, 0x36, 0x63, 0x70, 0x31, 0x45, 0xff, 0x80, 0x7a,
+	0x90, 0xc4, 0x6a, 0x73, 0x3a, 0x76, 0x94, 0x6f, 0xc2, 0x66, 0x0c, 0x6e, 0xa6, 0x5d, 0x5f, 0x25,
+	0xe6, 0x32, 0x5a, 0x16, 0xe7, 0xa0, 0x92, 0xe9, 0xf6, 0x1b, 0xf8, 0x09, 0x1c, 0xc5, 0x81, 0x63,
+	0x15, 0x9b, 0x42, 0x0a, 0xc0, 0x27, 0x95, 0xc0, 0xf1, 0x2f, 0x29, 0xef, 0x56, 0x11, 0x10, 0x35,
+	0xe5, 0xab, 0x2d, 0xc9, 0x1f, 0x72, 0x39, 0x11, 0x74, 0x1f, 0x14, 0xc8, 0x1a, 0x90, 0x0d, 0x6b,
+	0xc0, 0xd5, 0xf7, 0xaf, 0x77, 0x00, 0xfa, 0xd8, 0x75, 0x89, 0x8c, 0xe6, 0xbc, 0xe2, 0xa0, 0x29,
+	0x5d, 0x81, 0x7f, 0x65, 0x96, 0x8e, 0x98, 0x2b, 0x22, 0x4c, 0x7f, 0x8e, 0x7b, 0xce, 0xbe, 0xd9,
+	0x6a, 0xe1, 0xae, 0x7b, 0xe5, 0x9d, 0xd5, 0xb9, 0x12, 0x12, 0xf6, 0x77, 0x23, 0x34, 0xdb, 0xc1,
+	0xad, 0xc8, 0x08, 0x8a, 0xe3, 0x6e, 0x45, 0x41, 0x4b, 0x9d, 0x07, 0x9f, 0xc8, 0x0f, 0x80, 0xe1,
+	0x86, 0xee, 0x0e, 0x82, 0x43, 0x7e, 0x05, 0x3b, 0xab, 0x3b, 0x78, 0xdc, 0x63, 0x83, 0x4f, 0x7b,
+	0x83, 0xf7, 0x4a, 0xb4, 0x12, 0x35, 0x48, 0x96, 0x2f, 0x30, 0xe7, 0x37, 0x2e, 0xc0, 0x8c, 0x41,
+	0x6e, 0x3a, 0x15, 0xda, 0x49, 0x5a, 0xf7, 0x7e, 0xa3, 0x3d, 0x0d, 0xda, 0xaf, 0x2b, 0x30, 0xcf,
+	0x22, 0x40, 0x08, 0x1e, 0xb4, 0x04, 0x29, 0xd3, 0x6b, 0x9a, 0x62, 0xde, 0xcc, 0xcb, 0x9e, 0x67,
+	0x5b, 0x73, 0xbf, 0x5d, 0x39, 0x34, 0x2e, 0xdd, 0x63, 0x8b, 0x27, 0x87, 0xf4, 0x57, 0x94, 0xa3,
+	0xcc, 0x78, 0xf1, 0xdb, 0x88, 0x1f, 0x0c, 0x9b, 0xc3, 0xcf, 0x42, 0x0e, 0xbb, 0x25, 0x6c, 0xfa,
+	0xd6, 0xe5, 0xe9, 0x73, 0xe1, 0x75, 0x06, 0x44, 0x72, 0x13, 0xd7, 0xfc, 0x23, 0x2e, 0xef, 0xd9,
+	0x13, 0xfc, 0xaf, 0x8a, 0xec, 0x7f, 0x15, 0xfc, 0xb9, 0x29, 0xd9, 0x9f, 0x2b, 0xe4, 0xe6, 0xa5,
+	0xe5, 0xdc, 0xbc, 0x08, 0xf7, 0xb4, 0xf6, 0xf7, 0x9c, 0x19, 0xc1, 0xa3, 0x24, 0xda, 0xb9, 0x18,
+	0x10, 0x95, 0x8a, 0x20, 0x2a, 0xa1, 0xdb, 0xf1, 0x5d, 0xd0, 0x57, 0xdf, 0x79, 0x8e, 0x3c, 0x7b,
+	0x89, 0x37, 0x16, 0x7b, 0x34, 0xb6, 0xc6, 0x6f, 0x01, 0x1f, 0x7a, 0xb6, 0x0c, 0x0e, 0xa3, 0x6f,
+	0x88, 0x15, 0x1c, 0xc1, 0x6a, 0xf4, 0xb9, 0x8a, 0x77, 0x05, 0xbf, 0x0e, 0x8b, 0xb4, 0x4f, 0xba,
+	0xa5, 0xb5, 0x58, 0xfe, 0x87, 0x58, 0xa8, 0xfd, 0x9b, 0x42, 0x2e, 0xb8, 0xb6, 0xd5, 0xbe, 0x98,
+	0xc6, 0x05, 0x97, 0x98, 0x6e, 0xac, 0x81, 0xd3, 0xb4, 0x3a, 0x38, 0x6c, 0xba, 0xa9, 0xd1, 0x0a,
+	0xdd, 0x83, 0x40, 0x5f, 0x85, 0xf9, 0x53, 0x63, 0x8c, 0x60, 0x26, 0x1e, 0x9a, 0x0c, 0xef, 0xdb,
+	0x03, 0xdb, 0x31, 0x9f, 0x99, 0x4d, 0x83, 0x73, 0x06, 0x89, 0x85, 0xda, 0x5f, 0x64, 0xc4, 0x8b,
+	0x14, 0xa3, 0x61, 0xc8, 0x0c, 0x7d, 0x9c, 0xd6, 0x54, 0xd1, 0xc2, 0x99, 0x1d, 0xd1, 0xc2, 0x29,
+	0xf3, 0x3e, 0x97, 0xcc, 0xfb, 0x99, 0x71, 0x79, 0x3f, 0x3b, 0x19, 0xef, 0xe7, 0x22, 0x78, 0x4f,
+	0xb7, 0x40, 0xc2, 0x52, 0x57, 0xb5, 0x60, 0x94, 0x2d, 0xd0, 0x83, 0xa6, 0x6d, 0x5d, 0xa9, 0x24,
+	0x6d, 0xe7, 0x47, 0x69, 0xeb, 0x41, 0x93, 0xb6, 0xc1, 0x8e, 0xe5, 0x9b, 0x62, 0xe3, 0xf7, 0x37,
+	0x0e, 0x9a, 0x6c, 0x9c, 0xdc, 0xd5, 0x89, 0x71, 0xed, 0x13, 0x3f, 0x29, 0x7d, 0x24, 0x5c, 0xbb,
+	0x02, 0xaa, 0x82, 0x6b, 0x17, 0x9b, 0xd6, 0x21, 0xd7, 0x2e, 0x4f, 0x0a, 0x7c, 0xf0, 0x89, 0xb6,
+	0xf3, 0x67, 0xa2, 0x1d, 0xd9, 0xe6, 0x3c, 0xda, 0x03, 0xb3, 0x45, 0x49, 0x99, 0xd3, 0xdd, 0x6f,
+	0xe2, 0x43, 0x68, 0xf5, 0x2f, 0xf5, 0x41, 0x97, 0xad, 0x42, 0xec, 0x6f, 0xa4, 0xc4, 0x86, 0x3e,
+	0xa8, 0x42, 0x3f, 0x3b, 0x97, 0x24, 0x09, 0x8d, 0xdb, 0xd8, 0x3d, 0x4d, 0x54, 0x44, 0x4d, 0x9c,
+	0xa4, 0xcf, 0x9f, 0xa6, 0x60, 0x43, 0xea, 0xf4, 0xa1, 0xd9, 0x76, 0x02, 0x6b, 0x83, 0x6c, 0xce,
+	0x54, 0x22, 0xcc, 0x99, 0xb2, 0x51, 0x36, 0x35, 0xa9, 0x51, 0x36, 0x3d, 0x99, 0x51, 0x36, 0x13,
+	0x32, 0xca, 0x06, 0x2c, 0xca, 0x26, 0xb2, 0x28, 0x62, 0xb9, 0xd1, 0xbe, 0xaf, 0x40, 0x7e, 0x67,
+	0xd0, 0x7e, 0xee, 0x7b, 0x11, 0x06, 0xed, 0xa8, 0x5d, 0xe3, 0x81, 0x9f, 0x30, 0x4b, 0xaf, 0xf5,
+	0x9c, 0x26, 0x06, 0xad, 0xa5, 0x7c, 0xd9, 0xfb, 0xc4, 0xe3, 0x44, 0xca, 0xd9, 0x88, 0xe3, 0x9c,
+	0x8e, 0x0c, 0x8a, 0xd8, 0x5f, 0x6e, 0x12, 0x64, 0x92, 0x38, 0x32, 0xf5, 0xf8, 0xa2, 0x1c, 0xf4,
+	0x12, 0x49, 0x82, 0x1c, 0xf0, 0x92, 0x20, 0x3d, 0x2d, 0xba, 0x77, 0xf2, 0xf7, 0x66, 0xa1, 0x8c,
+	0xc4, 0x03, 0xde, 0x74, 0xed, 0x41, 0x0e, 0xee, 0x5e, 0x25, 0x3a, 0xf6, 0xf3, 0x90, 0x33, 0x9a,
+	0xfe, 0x93, 0x17, 0x4b, 0x82, 0x0f, 0xd4, 0xc3, 0xc9, 0x16, 0x2f, 0x06, 0x48, 0x9a, 0x74, 0x8c,
+	0x97, 0xc5, 0x33, 0x3c, 0x42, 0x48, 0x31, 0x05, 0x24, 0x2e, 0xd3, 0x75, 0x8f, 0x9d, 0x02, 0xa1,
+	0x3f, 0x2f, 0x14, 0x92, 0xb3, 0xd9, 0xc0, 0x0d, 0xc9, 0x18, 0xf1, 0xd8, 0xec, 0x03, 0x6b, 0xef,
+	0x06, 0xea, 0x7b, 0xb5, 0x39, 0x08, 0x6c, 0x01, 0x21, 0x0c, 0xa2, 0x2d, 0x40, 0xac, 0x9e, 0xce,
+	0xa3, 0x37, 0xda, 0xef, 0x29, 0xb0, 0x19, 0x83, 0x7c, 0x74, 0x63, 0x80, 0x4c, 0xb8, 0xdf, 0x60,
+	0xa2, 0x55, 0xff, 0x26, 0xdc, 0x38, 0xa2, 0x57, 0x51, 0x1f, 0xbf, 0xe7, 0x26, 0xfc, 0x27, 0xc5,
+	0x8b, 0x9a, 0x0f, 0xba, 0xa6, 0xa0, 0x1f, 0x8f, 0x44, 0x45, 0x58, 0xb0, 0xd2, 0xe2, 0xfd, 0x7d,
+	0x17, 0x96, 0xad, 0x76, 0x0b, 0xdb, 0x4e, 0x69, 0x8c, 0xdb, 0x97, 0xdc, 0x44, 0xfb, 0x47, 0x05,
+	0x0a, 0xe1, 0x31, 0xb3, 0x89, 0x78, 0x37, 0x22, 0xb6, 0x6c, 0x2b, 0x7e, 0x2a, 0x18, 0x1a, 0xae,
+	0x8d, 0xcb, 0xf1, 0x41, 0xff, 0x8c, 0xf9, 0x7e, 0x53, 0xee, 0x28, 0xb8, 0x12, 0x12, 0x1c, 0x62,
+	0x74, 0xad, 0xee, 0x65, 0xc7, 0xfc, 0x0e, 0xe6, 0x47, 0x2a, 0x95, 0xa2, 0x5f, 0x84, 0x15, 0x12,
+	0xb9, 0x67, 0x5e, 0xe0, 0x56, 0xd5, 0x77, 0x25, 0x67, 0x5c, 0xd0, 0x70, 0x85, 0xf6, 0xbd, 0x14,
+	0xac, 0x95, 0x5f, 0xd2, 0x95, 0x6f, 0xcc, 0xb8, 0x9b, 0x4f, 0x7e, 0x5f, 0xbb, 0x0f, 0xb9, 0x67,
+	0x56, 0xbf, 0x63, 0x38, 0xec, 0x15, 0x06, 0x6e, 0x83, 0xa0, 0x63, 0x7a, 0xe8, 0xd6, 0xea, 0x0c,
+	0x2a, 0x21, 0xda, 0xf3, 0x1e, 0x20, 0x81, 0x0b, 0xa5, 0xf3, 0x41, 0xf7, 0x39, 0x39, 0xc2, 0xb4,
+	0x0c, 0xc7, 0x70, 0x07, 0xbf, 0xa0, 0xbb, 0xdf, 0xda, 0x2f, 0xc3, 0xea, 0x13, 0xc3, 0x69, 0x9e,
+	0x33, 0xc0, 0x71, 0x0e, 0x02, 0xae, 0xa0, 0xda, 0x83, 0x0e, 0x6e, 0x58, 0xcf, 0xb1, 0xff, 0x8e,
+	0x11, 0x57, 0xa4, 0x7d, 0x3f, 0x05, 0xf3, 0x14, 0x31, 0x35, 0x37, 0x48, 0x2d, 0x94, 0x50, 0x0b,
+	0xf4, 0x59, 0xce, 0x00, 0x21, 0x69, 0x8b, 0x8f, 0xa6, 0x71, 0xd9, 0xc3, 0xcc, 0x36, 0x21, 0x5c,
+	0x78, 0xd2, 0x43, 0x2e, 0x3c, 0x99, 0xf0, 0x9c, 0x07, 0x5b, 0x72, 0x76, 0x94, 0x2d, 0x79, 0x82,
+	0x6b, 0xf5, 0x5f, 0x2a, 0x70, 0x73, 0x0f, 0x3b, 0x87, 0xf4, 0xac, 0x61, 0x5a, 0x5d, 0x72, 0x38,
+	0x98, 0x4a, 0x54, 0xdc, 0x7d, 0xc8, 0x3c, 0xeb, 0x5b, 0x9d, 0x11, 0xc4, 0xcd, 0x85, 0x43, 0xdb,
+	0x90, 0x72, 0xac, 0x11, 0x16, 0x8c, 0x94, 0x63, 0x91, 0x2d, 0x7f, 0xe9, 0xd0, 0x3b, 0x1e, 0xb9,
+	0x14, 0x87, 0x0e, 0x51, 0x4a, 0xc4, 0x9d, 0x4d, 0x83, 0x05, 0xea, 0x02, 0x69, 0xf1, 0xda, 0x2f,
+	0x94, 0x91, 0xe4, 0x95, 0x0e, 0x6e, 0x99, 0x46, 0x97, 0xf4, 0xd8, 0xb0, 0xa8, 0xef, 0x64, 0xf8,
+	0x26, 0x1a, 0xd1, 0x48, 0xfb, 0x93, 0x14, 0x2c, 0x4b, 0x8c, 0x25, 0x4f, 0x7e, 0x59, 0x3d, 0xdc,
+	0xe5, 0x02, 0xab, 0x98, 0x99, 0x4b, 0x2e, 0x7e, 0xc5, 0xc4, 0xa2, 0x12, 0x2c, 0xf7, 0xde, 0xfe,
+	0x92, 0x80, 0x67, 0xa8, 0x89, 0x40, 0x6e, 0x41, 0x42, 0x7f, 0x7d, 0x86, 0x53, 0x7f, 0x84, 0x10,
+	0xfa, 0x2b, 0x4e, 0x99, 0xce, 0xc1, 0x6e, 0x7f, 0x09, 0x20, 0x78, 0xda, 0x09, 0x01, 0xe4, 0x8e,
+	0x8e, 0x77, 0x0e, 0x2a, 0xa5, 0xfc, 0x35, 0xb4, 0x04, 0xa0, 0x97, 0xeb, 0x0d, 0xbd, 0x52, 0x6a,
+	0x94, 0x77, 0xf3, 0x0a, 0x9a, 0x87, 0x99, 0x23, 0xbd, 0xf2, 0xb8, 0xd8, 0x28, 0xe7, 0x53, 0xdb,
+	0xef, 0xc0, 0x4a, 0xe8, 0x21, 0x18, 0x17, 0xa2, 0x5c, 0xdd, 0xad, 0x54, 0xf7, 0xf2, 0xd7, 0xd0,
+	0x02, 0xcc, 0x16, 0x8f, 0x8e, 0xf4, 0xda, 0x63, 0xb7, 0x31, 0x40, 0x6e, 0xb7, 0x5c, 0xad, 0x94,
+	0x77, 0xf3, 0xa9, 0xed, 0x3f, 0x53, 0x00, 0xb8, 0x08, 0x9b, 0x39, 0xc8, 0xd6, 0x1a, 0xfb, 0x65,
+	0x3d, 0x7f, 0x0d, 0xcd, 0x42, 0xa6, 0x7e, 0x54, 0x3c, 0xcc, 0x2b, 0x68, 0x11, 0xe6, 0x6a, 0x0f,
+	0x1f, 0x9e, 0x34, 0x6a, 0x47, 0x95, 0x52, 0x3e, 0x85, 0x10, 0x2c, 0x1d, 0x56, 0xea, 0x95, 0xea,
+	0xc3, 0x9a, 0x7e, 0x58, 0x6c, 0x54, 0x6a, 0xd5, 0x7c, 0x9a, 0xd0, 0xb7, 0x5f, 0xd4, 0x8b, 0xf5,
+	0xfa, 0x61, 0xb9, 0xda, 0xc8, 0x67, 0xd0, 0x32, 0xcc, 0xef, 0x17, 0x1b, 0xe5, 0x93, 0xfa, 0x51,
+	0xb9, 0x5c, 0xda, 0xcf, 0x67, 0x09, 0x05, 0x8f, 0x2b, 0xb5, 0x83, 0x72, 0xb5, 0x54, 0xce, 0xe7,
+	0x08, 0x8a, 0x7a, 0xf9, 0x1b, 0xc7, 0xc5, 0x83, 0x93, 0x52, 0xad, 0xda, 0x20, 0x4d, 0x66, 0x48,
+	0x2f, 0xf5, 0xf2, 0xc1, 0xc3, 0x93, 0xfd, 0xa2, 0x7e, 0x98, 0x9f, 0x45, 0xab, 0xb0, 0x5c, 0x39,
+	0x38, 0x28, 0xef, 0x71, 0x30, 0x73, 0xdb, 0x5f, 0x81, 0x59, 0x2f, 0x78, 0x07, 0xcd, 0x40, 0xfa,
+	0xa0, 0xf6, 0x24, 0x7f, 0x8d, 0x0c, 0xe7, 0xb0, 0xbc, 0x5b, 0x39, 0x26, 0xa4, 0xce, 0x42, 0x66,
+	0xbf, 0xb2, 0xb7, 0x9f, 0x4f, 0x91, 0x0e, 0x4b, 0x7a, 0xa5, 0x51, 0x29, 0x15, 0x0f, 0xf2, 0xe9,
+	0xed, 0x5f, 0x80, 0x19, 0x16, 0xc6, 0x43, 0xfa, 0x2e, 0x15, 0x1b, 0xe5, 0xbd, 0x9a, 0xfe, 0xf4,
+	0xa4, 0xf6, 0xa4, 0xea, 0x8e, 0x15, 0x20, 0x57, 0xdc, 0x3d, 0xac, 0x54, 0xeb, 0x79, 0x65, 0xfb,
+	0x2d, 0x98, 0xe7, 0x02, 0x3c, 0x48, 0x55, 0xb5, 0xfc, 0xa4, 0x5c, 0x6f, 0x50, 0xb0, 0xda, 0xc1,
+	0x2e, 0xf9, 0x56, 0xd0, 0x0a, 0x2c, 0x1e, 0xd6, 0xea, 0x8d, 0x13, 0xbd, 0x7c, 0x54, 0xd3, 0x1b,
+	0x2e, 0x2f, 0x8f, 0x00, 0x85, 0xdd, 0x86, 0x2e, 0x79, 0xc5, 0xea, 0x71, 0xf1, 0x20, 0x7f, 0x8d,
+	0xb0, 0x45, 0xaf, 0x1d, 0x57, 0x77, 0x4f, 0xf4, 0xda, 0x4e, 0xa5, 0x9a, 0x57, 0x50, 0x1e, 0x16,
+	0x0e, 0xca, 0xc5, 0x7a, 0xe3, 0xe4, 0xa0, 0x56, 0xdc, 0x25, 0x48, 0xc8, 0xbc, 0xbd, 0x57, 0x7e,
+	0xfa, 0xa4, 0xa6, 0xef, 0xe6, 0xd3, 0xdb, 0x06, 0xcc, 0x78, 0xe6, 0xa3, 0x3c, 0x2c, 0x54, 0x6b,
+	0x27, 0x84, 0x87, 0x94, 0xe7, 0xd7, 0x08, 0x87, 0x18, 0x67, 0x4e, 0xf4, 0xf2, 0x21, 0x9b, 0xdb,
+	0x65, 0x98, 0x3f, 0xae, 0x97, 0xf5, 0x93, 0x27, 0x45, 0xbd, 0xea, 0xe2, 0xf3, 0x0a, 0x76, 0x8a,
+	0x55, 0x52, 0x90, 0x26, 0x7c, 0x2e, 0xd7, 0x4b, 0xc5, 0x83, 0x22, 0x21, 0x3a, 0xb3, 0xfd, 0x2e,
+	0x7f, 0xa5, 0x0a, 0x64, 0x67, 0xb7, 0x7c, 0x50, 0x26, 0x00, 0xd7, 0x08, 0x7c, 0xb5, 0xd6, 0x38,
+	0x79, 0x48, 0xe8, 0xa6, 0x14, 0x3f, 0xa9, 0x1d, 0x1f, 0xec, 0x9e, 0x50, 0x88, 0x7c, 0x6a, 0xfb,
+	0x4b, 0xb0, 0x2c, 0x1d, 0x97, 0x88, 0x18, 0x1d, 0x1d, 0xeb, 0x7b, 0x65, 0xda, 0xbc, 0x58, 0xad,
+	0x55, 0x9f, 0x1e, 0x56, 0x3e, 0x28, 0xd3, 0x09, 0x7a, 0xaf, 0x5c, 0x3e, 0xca, 0xa7, 0xb6, 0x35,
+	0x58, 0xe0, 0x37, 0x4e, 0x32, 0x9f, 0xa5, 0xfa, 0xe3, 0xfc, 0x35, 0xd2, 0xf8, 0x51, 0xbd, 0x56,
+	0x3d, 0xc8, 0x2b, 0xdb, 0x6f, 0x13, 0xd4, 0xc2, 0xde, 0x42, 0xa6, 0x8f, 0xb2, 0xfc, 0xa4, 0xa4,
+	0x97, 0x8b, 0x94, 0xc4, 0xa0, 0xcc, 0x23, 0x5b, 0x79, 0xf0, 0x3f, 0x9f, 0x87, 0x59, 0xff, 0x4d,
+	0xc4, 0x3a, 0x2c, 0x89, 0xcf, 0x36, 0x22, 0xee, 0xe8, 0x1a, 0xf9, 0x80, 0xa4, 0xba, 0x15, 0x0f,
+	0xc0, 0x8e, 0x61, 0x87, 0xb0, 0x2c, 0x05, 0xea, 0x23, 0xae, 0x51, 0x74, 0x0c, 0xbf, 0x1a, 0x9b,
+	0x03, 0x80, 0xde, 0x87, 0x95, 0x50, 0xc4, 0x3e, 0xd2, 0x22, 0x11, 0x0a, 0xe1, 0xfc, 0x09, 0x28,
+	0xdf, 0x83, 0x25, 0xf1, 0xe5, 0x43, 0x7e, 0xd8, 0x91, 0x6f, 0x22, 0x26, 0x20, 0x7b, 0x0a, 0x79,
+	0x39, 0xc9, 0x03, 0xdd, 0xe1, 0xa0, 0xa3, 0x73, 0x6c, 0x54, 0x2d, 0x09, 0x84, 0x71, 0xf2, 0x9b,
+	0xb0, 0x12, 0xca, 0xa4, 0xe0, 0x87, 0x1e, 0x97, 0xca, 0xa1, 0x7e, 0x2a, 0x11, 0x86, 0x61, 0xff,
+	0x16, 0xac, 0x46, 0xbc, 0x92, 0x88, 0x5e, 0x97, 0x26, 0x38, 0xf2, 0x11, 0xc5, 0x11, 0xc4, 0x00,
+	0xc3, 0x5a, 0xd4, 0x9b, 0x85, 0xe8, 0xd3, 0x91, 0x53, 0x27, 0x3f, 0x8f, 0xa8, 0xbe, 0x31, 0x0c,
+	0x8c, 0x75, 0xb3, 0x07, 0x0b, 0xfc, 0x03, 0x86, 0x68, 0x93, 0xdf, 0x51, 0x2e, 0xc6, 0x9a, 0xc7,
+	0xf5, 0xc8, 0x77, 0x0a, 0x11, 0x47, 0x49, 0xd2, 0x43, 0x86, 0x09, 0xa8, 0x77, 0x61, 0xce, 0x7f,
+	0x8d, 0x0e, 0xf1, 0xf6, 0x4f, 0xe9, 0xb9, 0x40, 0xf5, 0x56, 0x64, 0x1d, 0x1b, 0xe9, 0x23, 0x98,
+	0xe7, 0xde, 0x03, 0x44, 0x5c, 0x50, 0x46, 0xf8, 0xe1, 0x41, 0x75, 0x33, 0xa6, 0x96, 0xe1, 0x7a,
+	0x4c, 0xdf, 0x22, 0xf1, 0x3b, 0xe9, 0xdb, 0x48, 0x9a, 0xd1, 0xf0, 0xf3, 0x82, 0xea, 0x9d, 0x04,
+	0x08, 0x86, 0xf7, 0x29, 0xac, 0x70, 0x55, 0xec, 0xc5, 0x3c, 0x2d, 0xb2, 0x9d, 0xf0, 0xf8, 0xdd,
+	0x08, 0xf2, 0xd4, 0xf0, 0xd2, 0x6d, 0xf8, 0x17, 0xe5, 0x34, 0x59, 0x6f, 0xc3, 0x8f, 0x86, 0xa9,
+	0x49, 0xef, 0x96, 0x11, 0xed, 0x95, 0x9f, 0x41, 0x43, 0xd2, 0x38, 0x23, 0x9e, 0x6d, 0x53, 0xb5,
+	0x24, 0x10, 0x46, 0xf0, 0x31, 0xa0, 0x62, 0xaf, 0xd7, 0xb7, 0x2e, 0xe2, 0x28, 0x8e, 0x7b, 0xe6,
+	0x2c, 0x99, 0x62, 0x1d, 0x96, 0x77, 0x71, 0xf7, 0x72, 0xaa, 0x38, 0x1f, 0xc3, 0xb2, 0xf4, 0xa8,
+	0x19, 0x2f, 0x0e, 0xd1, 0xcf, 0xa8, 0xa9, 0x77, 0x12, 0x20, 0x18, 0x0b, 0xca, 0xb0, 0xc0, 0x3f,
+	0x4e, 0xc6, 0x2b, 0x67, 0xc4, 0xa3, 0x65, 0x6a, 0xcc, 0x23, 0x51, 0x44, 0xc7, 0xf9, 0x97, 0xb3,
+	0x78, 0x34, 0x11, 0x2f, 0x6a, 0x25, 0x28, 0xe2, 0x23, 0x98, 0xe7, 0x5e, 0xab, 0xe2, 0x55, 0x28,
+	0xfc, 0xa6, 0x96, 0xba, 0x19, 0x53, 0xeb, 0x6f, 0x73, 0x0b, 0xfc, 0x7b, 0x51, 0x22, 0x51, 0xa1,
+	0xc7, 0xa8, 0xd4, 0xdb, 0x71, 0xd5, 0x41, 0x3e, 0x20, 0x7b, 0x65, 0x0a, 0x71, 0xf4, 0x8b, 0x0f,
+	0x4f, 0xa9, 0x51, 0x8f, 0xd5, 0x90, 0xd5, 0xc5, 0x7f, 0x6d, 0x88, 0x5f, 0x5d, 0xe4, 0x67, 0x8f,
+	0xd4, 0x5b, 0x91, 0x75, 0xac, 0xff, 0x22, 0xcc, 0x7a, 0x0f, 0x01, 0xa1, 0x9b, 0xe2, 0xc8, 0xb9,
+	0x17, 0x8b, 0x54, 0x35, 0xaa, 0x2a, 0x40, 0xe1, 0xbd, 0xc1, 0xc3, 0xa3, 0x90, 0x9e, 0xf9, 0x51,
+	0xd5, 0xa8, 0x2a, 0x86, 0x62, 0x17, 0xe6, 0xfc, 0xe7, 0x4a, 0xf8, 0xb1, 0xc8, 0xef, 0xf0, 0xa8,
+	0xb7, 0x22, 0xeb, 0x82, 0x95, 0x92, 0x7b, 0xbb, 0x43, 0x9e, 0x66, 0xf1, 0x25, 0x12, 0x75, 0x33,
+	0xa6, 0x36, 0xc0, 0xc5, 0x3d, 0x98, 0xc1, 0xe3, 0x0a, 0xbf, 0xcc, 0xa1, 0x6e, 0xc6, 0xd4, 0x06,
+	0x3b, 0x6e, 0xc4, 0x5b, 0x18, 0xfc, 0x8e, 0x1b, 0xff, 0x54, 0x86, 0x1a, 0xb2, 0x64, 0x85, 0xf0,
+	0x7c, 0x0b, 0x56, 0xeb, 0xc9, 0xe8, 0xeb, 0x93, 0xa0, 0xaf, 0xc1, 0xb2, 0x9b, 0x6b, 0x1f, 0xa4,
+	0xde, 0x23, 0x6e, 0x16, 0x42, 0xcf, 0x28, 0xa8, 0xc3, 0x72, 0xf6, 0x51, 0x1d, 0xf2, 0xf2, 0xdb,
+	0x03, 0xc9, 0x18, 0x35, 0x59, 0x87, 0xc2, 0x8f, 0x16, 0x90, 0x63, 0x47, 0xd4, 0xcb, 0x02, 0xfc,
+	0xb1, 0x23, 0xe1, 0x51, 0x03, 0xf5, 0x8d, 0x61, 0x60, 0xac, 0x1b, 0xff, 0x08, 0xe9, 0x27, 0xf0,
+	0x87, 0x8e, 0x90, 0x52, 0xee, 0xb6, 0x1a, 0x9b, 0x31, 0x8e, 0x8e, 0x60, 0x51, 0xc8, 0x39, 0x47,
+	0xb7, 0x45, 0x2a, 0xe4, 0xdc, 0x79, 0xf5, 0xb5, 0xd8, 0x7a, 0x46, 0x5e, 0x1d, 0x96, 0xc4, 0xbc,
+	0x6f, 0x9e, 0xbc, 0xc8, 0xd4, 0x72, 0x75, 0x2b, 0x1e, 0xc0, 0x7f, 0x16, 0x14, 0x82, 0x84, 0x57,
+	0x7e, 0xa6, 0x42, 0x69, 0xb0, 0x6a, 0x64, 0x96, 0x21, 0x41, 0x10, 0xe4, 0x75, 0xf2, 0x08, 0x42,
+	0xd9, 0x9e, 0x31, 0x08, 0x1e, 0x91, 0x35, 0x37, 0xc8, 0xcf, 0x14, 0xd7, 0xdc, 0x50, 0xde, 0xa6,
+	0x7a, 0x4b, 0x64, 0x93, 0x98, 0x17, 0xb9, 0x0b, 0x73, 0x7e, 0x21, 0x52, 0x23, 0x21, 0x47, 0xc0,
+	0xc2, 0x96, 0x1a, 0x66, 0x89, 0x94, 0x97, 0x1a, 0xd1, 0x40, 0xa9, 0x6e, 0xc6, 0xd4, 0xca, 0xbb,
+	0x25, 0xad, 0x08, 0xef, 0x96, 0x42, 0x58, 0x88, 0x1a, 0x63, 0xf7, 0x23, 0x1b, 0x13, 0xef, 0x7d,
+	0xe3, 0xd1, 0x44, 0x64, 0x2e, 0xa9, 0xb7, 0xe3, 0xaa, 0xfd, 0x73, 0xd7, 0x22, 0x5f, 0x2e, 0x08,
+	0x67, 0x94, 0xd3, 0x99, 0xbf, 0x7c, 0xc4, 0x7b, 0x02, 0x7f, 0x05, 0x56, 0x23, 0x3c, 0xc9, 0xfc,
+	0x5a, 0x15, 0xef, 0x68, 0x1e, 0xad, 0x87, 0x16, 0xac, 0x0b, 0x15, 0x9e, 0xdb, 0x98, 0x3f, 0xcf,
+	0x27, 0xf9, 0x95, 0x47, 0xeb, 0xa5, 0x06, 0x8b, 0x82, 0xd1, 0x9a, 0xe7, 0x4e, 0x94, 0x4d, 0x5f,
+	0xdd, 0x88, 0xa9, 0x77, 0xad, 0xdd, 0x6f, 0x2a, 0xe8, 0x21, 0x2c, 0xf0, 0xb6, 0x6d, 0x7e, 0xf6,
+	0x22, 0x6c, 0xde, 0xea, 0x7a, 0xa4, 0xb5, 0xf9, 0x4d, 0x05, 0x35, 0x00, 0x85, 0x4d, 0xb7, 0xe8,
+	0x53, 0xc2, 0x56, 0x13, 0x6d, 0xd8, 0x55, 0x6f, 0x86, 0x8c, 0x72, 0x7e, 0x7b, 0x76, 0x6f, 0xe0,
+	0x52, 0xbc, 0xe4, 0x7b, 0x43, 0x38, 0x67, 0x4d, 0xbd, 0x93, 0x00, 0xe1, 0x0b, 0x59, 0x5e, 0xce,
+	0xf0, 0x92, 0x8f, 0xe1, 0x11, 0xd9, 0x5f, 0xc3, 0x14, 0xea, 0x08, 0x96, 0xc4, 0xfc, 0x2e, 0xd9,
+	0xbc, 0x11, 0xca, 0xfc, 0x1a, 0x86, 0xb1, 0x04, 0xf3, 0x5c, 0x3e, 0x13, 0xaf, 0xee, 0xe1, 0x34,
+	0xa7, 0x58, 0x05, 0xdd, 0x83, 0x45, 0x21, 0x91, 0x09, 0x09, 0x67, 0xc3, 0x70, 0x86, 0x53, 0x2c,
+	0xa2, 0x32, 0x2c, 0xf0, 0x29, 0x4c, 0xbc, 0xac, 0x44, 0xa4, 0x36, 0xc5, 0xa2, 0x79, 0x0f, 0x16,
+	0x85, 0x90, 0x44, 0x9e, 0x9e, 0xa8, 0x58, 0x45, 0x35, 0x21, 0xe4, 0x2d, 0x90, 0x10, 0xaf, 0x24,
+	0x42, 0x42, 0xe4, 0x28, 0x3d, 0xf5, 0x4e, 0x02, 0x04, 0xe3, 0x7c, 0x95, 0x30, 0x8d, 0x0b, 0x8e,
+	0x13, 0x99, 0x16, 0x8e, 0x9a, 0x53, 0x93, 0x03, 0x6f, 0xd0, 0x09, 0x9f, 0xe9, 0x5e, 0xf3, 0x82,
+	0x70, 0x3e, 0x15, 0x45, 0x88, 0x14, 0x7b, 0xa4, 0xbe, 0x9e, 0x0c, 0xc4, 0x08, 0x3e, 0x77, 0xed,
+	0x09, 0x11, 0x86, 0x4f, 0xd1, 0x9e, 0x10, 0x9b, 0xde, 0xa5, 0xde, 0x1d, 0x0a, 0x17, 0x28, 0x8f,
+	0x9c, 0x35, 0xc5, 0x2b, 0x4f, 0x4c, 0x46, 0x95, 0x9a, 0x9c, 0x10, 0x82, 0x4e, 0x61, 0x35, 0x22,
+	0xd1, 0x86, 0x5f, 0xa1, 0xe3, 0x33, 0x80, 0xd4, 0x4f, 0x0f, 0x81, 0xf2, 0x0d, 0x5c, 0x6b, 0x51,
+	0xc9, 0x3a, 0xfc, 0x61, 0x2d, 0x21, 0x99, 0x67, 0xd8, 0x08, 0x84, 0x29, 0xde, 0xf7, 0xd2, 0x5b,
+	0x22, 0xa7, 0x58, 0xca, 0xcc, 0x51, 0x5f, 0x4f, 0x06, 0xf2, 0x37, 0xb1, 0xf5, 0xc8, 0x74, 0x17,
+	0x7e, 0x8a, 0x93, 0xf2, 0x61, 0xd4, 0x61, 0x59, 0x03, 0x44, 0x88, 0x22, 0xd3, 0x20, 0xc2, 0x9b,
+	0x58, 0x4c, 0x0f, 0x77, 0x87, 0xc2, 0x05, 0xe2, 0x1a, 0x99, 0xf3, 0x80, 0xa4, 0x13, 0x71, 0x5c,
+	0xc2, 0x85, 0x7a, 0x77, 0x28, 0x9c, 0x68, 0x7b, 0xe2, 0x02, 0xe2, 0xe5, 0x15, 0x22, 0x9c, 0x19,
+	0xa1, 0xde, 0x49, 0x80, 0x60, 0x78, 0xdf, 0x87, 0xbc, 0x1c, 0xd3, 0xce, 0xab, 0x41, 0x4c, 0xbc,
+	0xbb, 0x9a, 0x10, 0x8f, 0x88, 0xf6, 0x00, 0x82, 0x90, 0x6f, 0x24, 0x1d, 0x04, 0x85, 0xa8, 0x76,
+	0x75, 0x23, 0xba, 0x92, 0xd1, 0xf6, 0x01, 0xa0, 0x70, 0x14, 0x12, 0x2f, 0x8a, 0xb1, 0x31, 0x4a,
+	0xea, 0xb0, 0x60, 0x92, 0x40, 0x46, 0xe4, 0x8a, 0x88, 0x83, 0x4e, 0x64, 0x0f, 0x77, 0x87, 0xc2,
+	0x89, 0x32, 0x12, 0x0a, 0x85, 0x91, 0x65, 0x24, 0x2e, 0x10, 0x47, 0xbd, 0x3b, 0x14, 0xce, 0xb7,
+	0x23, 0xe6, 0xe5, 0x30, 0x0f, 0x7e, 0x2e, 0x63, 0xc2, 0x5e, 0x54, 0x2d, 0x09, 0x84, 0xa2, 0x3e,
+	0xcd, 0xb9, 0xae, 0xca, 0x2f, 0xfc, 0xdf, 0x00, 0xcf, 0x77, 0x84, 0x09, 0x91, 0x6b, 0x00, 0x00,
 }
@@ -0,0 +1,90 @@
+package category
+
+import (
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"golang.org/x/net/context"
+)
+
+var reasonCodes = map[pb.ReasonCode]ReasonCode{
+	pb.ReasonCode_OTHER:           ReasonOther,
+	pb.ReasonCode_SPAM:            ReasonSpam,
+	pb.ReasonCode_OFF_TOPIC:       ReasonOffTopic,
+	pb.ReasonCode_MISINFORMATION:  ReasonMisinformation,
+	pb.ReasonCode_HARASSMENT:      ReasonHarassment,
+	pb.ReasonCode_HATE_SPEECH:     ReasonHateSpeech,
+	pb.ReasonCode_VIOLENCE:        ReasonViolence,
+	pb.ReasonCode_SEXUAL_CONTENT:  ReasonSexualContent,
+	pb.ReasonCode_SELF_HARM:       ReasonSelfHarm,
+	pb.ReasonCode_ILLEGAL_CONTENT: ReasonIllegalContent,
+}
+
+var reasonCodesProto = map[ReasonCode]pb.ReasonCode{
+	ReasonOther:          pb.ReasonCode_OTHER,
+	ReasonSpam:           pb.ReasonCode_SPAM,
+	ReasonOffTopic:       pb.ReasonCode_OFF_TOPIC,
+	ReasonMisinformation: pb.ReasonCode_MISINFORMATION,
+	ReasonHarassment:     pb.ReasonCode_HARASSMENT,
+	ReasonHateSpeech:     pb.ReasonCode_HATE_SPEECH,
+	ReasonViolence:       pb.ReasonCode_VIOLENCE,
+	ReasonSexualContent:  pb.ReasonCode_SEXUAL_CONTENT,
+	ReasonSelfHarm:       pb.ReasonCode_SELF_HARM,
+	ReasonIllegalContent: pb.ReasonCode_ILLEGAL_CONTENT,
+}
+
+var routingsProto = map[Routing]pb.Routing{
+	RoutingOwner:  pb.Routing_CATEGORY_OWNER,
+	RoutingAdmins: pb.Routing_ADMINS,
+}
+
+// SingleReasonCode converts ReasonCodeInfo to SingleReasonCode
+func (i ReasonCodeInfo) SingleReasonCode() *pb.SingleReasonCode {
+	res := new(pb.SingleReasonCode)
+	res.Code = reasonCodesProto[i.Code]
+	res.Severity = pb.Severity(i.Severity)
+	res.Routing = routingsProto[i.Routing]
+
+	return res
+}
+
+// ListReasonCodes returns site-wide reason codes which reports can use
+func (s *Server) ListReasonCodes(ctx context.Context, req *pb.ListReasonCodesRequest) (*pb.ListReasonCodesResponse, error) {
+	res := new(pb.ListReasonCodesResponse)
+	for _, info := range reasonTaxonomy {
+		res.ReasonCodes = append(res.ReasonCodes, info.SingleReasonCode())
+	}
+
+	return res, nil
+}
+
+// ListAdminReports returns reports of all categories which are routed to site admins, newest first.
+// Caller must be site admin, it is checked by gateway
+func (s *Server) ListAdminReports(ctx context.Context, req *pb.ListAdminReportsRequest) (*pb.ListReportsResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	filter := new(reportFilter)
+	filter.Routing = RoutingAdmins
+	reports, err := s.db.getAllReports(filter, reportOrderNewest, pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListReportsResponse)
+	for _, report := range reports {
+		reportResponse, err := report.SingleReport()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Reports = append(res.Reports, reportResponse)
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
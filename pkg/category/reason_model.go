@@ -0,0 +1,71 @@
+package category
+
+// ReasonCode is the site-wide classification of report
+type ReasonCode string
+
+// Reason codes of reports, reports which fit none of them use ReasonOther with free-text reason
+const (
+	ReasonOther          ReasonCode = "other"
+	ReasonSpam           ReasonCode = "spam"
+	ReasonOffTopic       ReasonCode = "off_topic"
+	ReasonMisinformation ReasonCode = "misinformation"
+	ReasonHarassment     ReasonCode = "harassment"
+	ReasonHateSpeech     ReasonCode = "hate_speech"
+	ReasonViolence       ReasonCode = "violence"
+	ReasonSexualContent  ReasonCode = "sexual_content"
+	ReasonSelfHarm       ReasonCode = "self_harm"
+	ReasonIllegalContent ReasonCode = "illegal_content"
+)
+
+// Severity tells how urgently report must be handled
+type Severity int32
+
+// Severities are ordered from the least to the most urgent
+const (
+	SeverityLow Severity = iota
+	SeverityMedium
+	SeverityHigh
+	SeverityCritical
+)
+
+// Routing tells who handles report
+type Routing string
+
+const (
+	// RoutingOwner reports are handled by owner and moderators of category
+	RoutingOwner Routing = "owner"
+	// RoutingAdmins reports are handled by site admins and aren't shown to category
+	RoutingAdmins Routing = "admins"
+)
+
+// ReasonCodeInfo describes how reports with reason code are handled
+type ReasonCodeInfo struct {
+	Code     ReasonCode
+	Severity Severity
+	Routing  Routing
+}
+
+// reasonTaxonomy lists every reason code, in the order it is shown to users
+var reasonTaxonomy = []ReasonCodeInfo{
+	{ReasonSpam, SeverityLow, RoutingOwner},
+	{ReasonOffTopic, SeverityLow, RoutingOwner},
+	{ReasonMisinformation, SeverityMedium, RoutingOwner},
+	{ReasonHarassment, SeverityMedium, RoutingOwner},
+	{ReasonHateSpeech, SeverityHigh, RoutingAdmins},
+	{ReasonViolence, SeverityHigh, RoutingAdmins},
+	{ReasonSexualContent, SeverityHigh, RoutingAdmins},
+	{ReasonSelfHarm, SeverityCritical, RoutingAdmins},
+	{ReasonIllegalContent, SeverityCritical, RoutingAdmins},
+	{ReasonOther, SeverityLow, RoutingOwner},
+}
+
+// reasonCodeInfo returns taxonomy entry of code, unknown codes are handled like ReasonOther
+func reasonCodeInfo(code ReasonCode) ReasonCodeInfo {
+	for _, info := range reasonTaxonomy {
+		if info.Code == code {
+			return info
+		}
+	}
+
+	return reasonCodeInfo(ReasonOther)
+}
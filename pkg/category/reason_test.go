@@ -0,0 +1,72 @@
+package category
+
+import (
+	"testing"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"golang.org/x/net/context"
+)
+
+func TestReasonTaxonomy(t *testing.T) {
+	if len(reasonTaxonomy) != len(reasonCodes) || len(reasonTaxonomy) != len(reasonCodesProto) {
+		t.Fatalf("taxonomy has %d codes, proto has %d", len(reasonTaxonomy), len(reasonCodes))
+	}
+
+	for _, info := range reasonTaxonomy {
+		if _, ok := reasonCodesProto[info.Code]; !ok {
+			t.Errorf("reason code %q has no proto value", info.Code)
+		}
+	}
+
+	if info := reasonCodeInfo("unknown"); info.Code != ReasonOther {
+		t.Errorf("unexpected info of unknown code %v", info)
+	}
+}
+
+func TestListReasonCodes(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	res, err := s.ListReasonCodes(context.Background(), &pb.ListReasonCodesRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(res.ReasonCodes) != len(reasonTaxonomy) || res.ReasonCodes[0].Code != pb.ReasonCode_SPAM {
+		t.Errorf("unexpected reason codes %v", res.ReasonCodes)
+	}
+}
+
+func TestCreateReportWithReasonCode(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.CreateReportRequest{ReasonCode: pb.ReasonCode_SPAM, CategoryUid: nilUIDString, PostUid: nilUIDString, CommentUid: nilUIDString}
+	res, err := s.CreateReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Reason != "" || res.Routing != pb.Routing_CATEGORY_OWNER || res.Severity != pb.Severity_LOW {
+		t.Errorf("unexpected report %v", res)
+	}
+
+	req.ReasonCode = pb.ReasonCode_SELF_HARM
+	req.Reason = "  please help  "
+	res, err = s.CreateReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Reason != "please help" || res.Routing != pb.Routing_ADMINS || res.Severity != pb.Severity_CRITICAL {
+		t.Errorf("unexpected report %v", res)
+	}
+
+	req.ReasonCode = pb.ReasonCode(100)
+	_, err = s.CreateReport(context.Background(), req)
+	if !hasViolations(err, "reasonCode") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListAdminReports(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	res, err := s.ListAdminReports(context.Background(), &pb.ListAdminReportsRequest{})
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.PageSize != 10 || len(res.Reports) == 0 {
+		t.Errorf("unexpected response %v", res)
+	}
+}
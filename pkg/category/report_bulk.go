@@ -0,0 +1,142 @@
+package category
+
+import (
+	"fmt"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var statusTooManyReports = status.Error(codes.FailedPrecondition, fmt.Sprintf("more than %d reports selected, narrow the selection", maxBulkReports))
+
+// bulkDeleteReports deletes reports selected by filter as handled by moderator and returns result for every selected report
+func (s *Server) bulkDeleteReports(filter *reportFilter, moderatorUID uuid.UUID, dryRun bool) (*pb.BulkDeleteReportsResponse, error) {
+	reports, err := s.db.deleteReports(filter, moderatorUID, dryRun)
+	switch err {
+	case nil:
+	case errTooManyReports:
+		return nil, statusTooManyReports
+	default:
+		return nil, internalError(err)
+	}
+
+	res := new(pb.BulkDeleteReportsResponse)
+	for _, report := range reports {
+		reportResponse, err := report.SingleReport()
+		if err != nil {
+			return nil, err
+		}
+
+		result := new(pb.BulkReportResult)
+		result.Uid = reportResponse.Uid
+		result.Report = reportResponse
+		if dryRun {
+			result.Status = pb.BulkReportStatus_WOULD_DELETE
+		} else {
+			result.Status = pb.BulkReportStatus_DELETED
+			res.DeletedCount++
+		}
+
+		res.Results = append(res.Results, result)
+	}
+
+	res.DryRun = dryRun
+
+	return res, nil
+}
+
+// DeleteReports deletes reports with given UIDs in one transaction, result is returned for every UID in order of request.
+// With dry run nothing is deleted. Caller must be site admin, it is checked by gateway
+func (s *Server) DeleteReports(ctx context.Context, req *pb.DeleteReportsRequest) (*pb.BulkDeleteReportsResponse, error) {
+	v := new(validator)
+	filter := new(reportFilter)
+	switch {
+	case len(req.Uids) == 0:
+		v.addViolation("uids", "at least one report is required")
+	case len(req.Uids) > maxBulkReports:
+		v.addViolation("uids", fmt.Sprintf("at most %d reports can be given", maxBulkReports))
+	}
+
+	for i, uid := range req.Uids {
+		filter.UIDs = append(filter.UIDs, v.uuid(fmt.Sprintf("uids[%d]", i), uid))
+	}
+
+	moderatorUID := v.optionalUUID("moderatorUid", req.ModeratorUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	selected, err := s.bulkDeleteReports(filter, moderatorUID, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]*pb.BulkReportResult, len(selected.Results))
+	for _, result := range selected.Results {
+		found[result.Uid] = result
+	}
+
+	res := new(pb.BulkDeleteReportsResponse)
+	for _, uid := range filter.UIDs {
+		result, ok := found[uid.String()]
+		if !ok {
+			result = &pb.BulkReportResult{Uid: uid.String(), Status: pb.BulkReportStatus_NOT_FOUND}
+		}
+
+		res.Results = append(res.Results, result)
+	}
+
+	res.DryRun = selected.DryRun
+	res.DeletedCount = selected.DeletedCount
+
+	return res, nil
+}
+
+// DeleteReportsByPost deletes every report of post and its comments in one transaction.
+// With dry run nothing is deleted. Caller must be site admin, it is checked by gateway
+func (s *Server) DeleteReportsByPost(ctx context.Context, req *pb.DeleteReportsByPostRequest) (*pb.BulkDeleteReportsResponse, error) {
+	v := new(validator)
+	filter := new(reportFilter)
+	filter.PostUID = v.uuid("postUid", req.PostUid)
+	moderatorUID := v.optionalUUID("moderatorUid", req.ModeratorUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	return s.bulkDeleteReports(filter, moderatorUID, req.DryRun)
+}
+
+// DeleteReportsByFilter deletes reports matching every condition of request in one transaction,
+// at least one condition is required. With dry run nothing is deleted. Caller must be site admin, it is checked by gateway
+func (s *Server) DeleteReportsByFilter(ctx context.Context, req *pb.DeleteReportsByFilterRequest) (*pb.BulkDeleteReportsResponse, error) {
+	v := new(validator)
+	filter := new(reportFilter)
+	filter.CreatedAfter = v.optionalTime("createdAfter", req.CreatedAfter)
+	filter.CreatedBefore = v.optionalTime("createdBefore", req.CreatedBefore)
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+		v.addViolation("createdBefore", "createdBefore must be after createdAfter")
+	}
+
+	filter.ReasonText = v.optionalText("reasonText", req.ReasonText, reportSearchRules)
+	if len(req.CategoryUids) > maxReportFilterCategories {
+		v.addViolation("categoryUids", fmt.Sprintf("at most %d categories can be given", maxReportFilterCategories))
+	}
+
+	for i, categoryUID := range req.CategoryUids {
+		filter.CategoryUIDs = append(filter.CategoryUIDs, v.uuid(fmt.Sprintf("categoryUids[%d]", i), categoryUID))
+	}
+
+	if len(req.CategoryUids) == 0 && req.CreatedAfter == nil && req.CreatedBefore == nil && filter.ReasonText == "" {
+		v.addViolation("filter", "at least one condition is required")
+	}
+
+	moderatorUID := v.optionalUUID("moderatorUid", req.ModeratorUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	return s.bulkDeleteReports(filter, moderatorUID, req.DryRun)
+}
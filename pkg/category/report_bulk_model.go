@@ -0,0 +1,85 @@
+package category
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// maxBulkReports is the maximum number of reports deleted by one bulk operation
+const maxBulkReports = 500
+
+var errTooManyReports = errors.New("too many reports selected")
+
+// deleteReports deletes reports selected by filter in one transaction as handled by moderator,
+// their notes are moved to archive. Nothing is deleted when filter selects more than maxBulkReports reports or dryRun is set,
+// selected reports are returned oldest first in every case but the first one
+func (db *db) deleteReports(filter *reportFilter, moderatorUID uuid.UUID, dryRun bool) ([]*Report, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	args := new(queryArgs)
+	query := `SELECT ` + reportColumns + ` FROM reports WHERE ` + filter.where(args) + `
+	          ORDER BY created_at, uid LIMIT ` + args.add(maxBulkReports+1) + ` FOR UPDATE`
+	rows, err := tx.Query(query, *args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*Report, 0)
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, report)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(result) > maxBulkReports {
+		return nil, errTooManyReports
+	}
+
+	if dryRun || len(result) == 0 {
+		return result, nil
+	}
+
+	uids := make([]uuid.UUID, len(result))
+	for i, report := range result {
+		uids[i] = report.UID
+	}
+
+	if err := archiveReportNotes(tx, uids...); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec("DELETE FROM reports WHERE uid=ANY($1)", pq.Array(uuidStrings(uids))); err != nil {
+		return nil, err
+	}
+
+	for _, report := range result {
+		if err := recordHandledReport(tx, report.UID, report.CategoryUID, moderatorUID, report.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		if err := emitReportDeleted(tx, report.UID.String(), report.CategoryUID.String(), report.Routing); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
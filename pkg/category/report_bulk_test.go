@@ -0,0 +1,139 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+// floodedPostUID has more reports than bulk operation can delete
+var floodedPostUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000092"))
+
+func (mdb *mockdb) deleteReports(filter *reportFilter, moderatorUID uuid.UUID, dryRun bool) ([]*Report, error) {
+	if filter.PostUID == floodedPostUID {
+		return nil, errTooManyReports
+	}
+
+	newReport := func(uid uuid.UUID) *Report {
+		return &Report{
+			UID: uid, CategoryUID: restrictedUID, PostUID: uuid.New(), ReasonCode: ReasonSpam, Reason: "spam",
+			Routing: RoutingOwner, CreatedAt: time.Now().Add(-8 * 24 * time.Hour),
+		}
+	}
+
+	result := make([]*Report, 0)
+	switch {
+	case len(filter.UIDs) > 0:
+		for _, uid := range filter.UIDs {
+			if uid != missingReportUID {
+				result = append(result, newReport(uid))
+			}
+		}
+	case filter.PostUID != uuid.Nil:
+		result = append(result, newReport(uuid.New()), newReport(uuid.New()))
+	default:
+		result = append(result, newReport(uuid.New()))
+	}
+
+	return result, nil
+}
+
+func TestDeleteReports(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.DeleteReportsRequest{Uids: []string{heldReportUID.String(), missingReportUID.String()}}
+	res, err := s.DeleteReports(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(res.Results) != 2 || res.DeletedCount != 1 || res.DryRun {
+		t.Fatalf("unexpected response %v", res)
+	}
+
+	if res.Results[0].Status != pb.BulkReportStatus_DELETED || res.Results[0].Report == nil {
+		t.Errorf("unexpected result %v", res.Results[0])
+	}
+
+	if res.Results[1].Uid != missingReportUID.String() || res.Results[1].Status != pb.BulkReportStatus_NOT_FOUND {
+		t.Errorf("unexpected result %v", res.Results[1])
+	}
+
+	req.DryRun = true
+	res, err = s.DeleteReports(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if !res.DryRun || res.DeletedCount != 0 || res.Results[0].Status != pb.BulkReportStatus_WOULD_DELETE {
+		t.Errorf("unexpected response %v", res)
+	}
+}
+
+func TestDeleteReportsFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	_, err := s.DeleteReports(context.Background(), &pb.DeleteReportsRequest{})
+	if !hasViolations(err, "uids") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	uids := make([]string, maxBulkReports+1)
+	for i := range uids {
+		uids[i] = uuid.New().String()
+	}
+
+	uids[0] = "not uuid"
+	_, err = s.DeleteReports(context.Background(), &pb.DeleteReportsRequest{Uids: uids})
+	if !hasViolations(err, "uids", "uids[0]") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestDeleteReportsByPost(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	res, err := s.DeleteReportsByPost(context.Background(), &pb.DeleteReportsByPostRequest{PostUid: uuid.New().String()})
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Results) != 2 || res.DeletedCount != 2 {
+		t.Errorf("unexpected response %v", res)
+	}
+
+	_, err = s.DeleteReportsByPost(context.Background(), &pb.DeleteReportsByPostRequest{PostUid: floodedPostUID.String()})
+	if err != statusTooManyReports {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	_, err = s.DeleteReportsByPost(context.Background(), &pb.DeleteReportsByPostRequest{PostUid: ""})
+	if !hasViolations(err, "postUid") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestDeleteReportsByFilter(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	createdBefore, _ := ptypes.TimestampProto(time.Now().Add(-7 * 24 * time.Hour))
+	req := &pb.DeleteReportsByFilterRequest{ReasonText: "spam", CreatedBefore: createdBefore, DryRun: true}
+	res, err := s.DeleteReportsByFilter(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Results) != 1 || res.Results[0].Status != pb.BulkReportStatus_WOULD_DELETE {
+		t.Errorf("unexpected response %v", res)
+	}
+}
+
+func TestDeleteReportsByFilterFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	_, err := s.DeleteReportsByFilter(context.Background(), &pb.DeleteReportsByFilterRequest{DryRun: true})
+	if !hasViolations(err, "filter") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	createdAfter, _ := ptypes.TimestampProto(time.Now())
+	createdBefore, _ := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	req := &pb.DeleteReportsByFilterRequest{CategoryUids: []string{"not uuid"}, CreatedAfter: createdAfter, CreatedBefore: createdBefore}
+	_, err = s.DeleteReportsByFilter(context.Background(), req)
+	if !hasViolations(err, "categoryUids[0]", "createdBefore") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package category
+
+import (
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	statusReportHeld           = status.Error(codes.FailedPrecondition, "report is held by another moderator")
+	statusReportNotHeld        = status.Error(codes.FailedPrecondition, "report isn't held by moderator")
+	statusAssigneeMoved        = status.Error(codes.Aborted, "report assignee has changed")
+	statusAssigneeNotModerator = status.Error(codes.FailedPrecondition, "assignee isn't category owner or report handler")
+)
+
+// ClaimReport makes moderator hold report for a limited time, so other moderators don't handle it too.
+// Report held by another moderator can't be claimed. Moderator must be owner or report handler of category of report
+func (s *Server) ClaimReport(ctx context.Context, req *pb.ClaimReportRequest) (*pb.SingleReport, error) {
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	moderatorUID := v.uuid("moderatorUid", req.ModeratorUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getReportModeratedCategory(uid, moderatorUID); err != nil {
+		return nil, err
+	}
+
+	report, err := s.db.claimReport(uid, moderatorUID, time.Now().Add(claimDuration))
+	switch err {
+	case nil:
+		return report.SingleReport()
+	case errNotFound:
+		return nil, statusReportNotFound
+	case errReportHeld:
+		return nil, statusReportHeld
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ReleaseReport lets other moderators take report held by moderator, who must be owner or report handler of category of report
+func (s *Server) ReleaseReport(ctx context.Context, req *pb.ReleaseReportRequest) (*pb.SingleReport, error) {
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	moderatorUID := v.uuid("moderatorUid", req.ModeratorUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getReportModeratedCategory(uid, moderatorUID); err != nil {
+		return nil, err
+	}
+
+	report, err := s.db.releaseReport(uid, moderatorUID)
+	switch err {
+	case nil:
+		return report.SingleReport()
+	case errNotFound:
+		return nil, statusReportNotFound
+	case errReportNotHeld:
+		return nil, statusReportNotHeld
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// AssignReport makes moderator hold report until released. Report is only assigned if it is still held
+// by expected assignee, empty expected assignee means report must be held by nobody.
+// Both moderator assigning report and assignee must be owner or report handler of category of report
+func (s *Server) AssignReport(ctx context.Context, req *pb.AssignReportRequest) (*pb.SingleReport, error) {
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	assigneeUID := v.uuid("assigneeUid", req.AssigneeUid)
+	expectedAssigneeUID := v.optionalUUID("expectedAssigneeUid", req.ExpectedAssigneeUid)
+	moderatorUID := v.uuid("moderatorUid", req.ModeratorUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	category, err := s.getReportModeratedCategory(uid, moderatorUID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch _, err := s.getModeratedCategory(category.UID, assigneeUID); err {
+	case nil:
+	case statusNotCategoryModerator:
+		return nil, statusAssigneeNotModerator
+	default:
+		return nil, err
+	}
+
+	report, err := s.db.assignReport(uid, assigneeUID, expectedAssigneeUID)
+	switch err {
+	case nil:
+		return report.SingleReport()
+	case errNotFound:
+		return nil, statusReportNotFound
+	case errAssigneeMoved:
+		return nil, statusAssigneeMoved
+	default:
+		return nil, internalError(err)
+	}
+}
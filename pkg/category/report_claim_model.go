@@ -0,0 +1,91 @@
+package category
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// claimDuration is the time moderator holds claimed report
+const claimDuration = 30 * time.Minute
+
+var (
+	errReportHeld    = errors.New("report is held by another moderator")
+	errReportNotHeld = errors.New("report isn't held by moderator")
+	errAssigneeMoved = errors.New("report assignee has changed")
+)
+
+// claimActive is the condition matching reports whose assignee still holds them.
+// Reports assigned to moderator are held until released, claimed reports are held until the claim expires
+const claimActive = "(claim_expires_at IS NULL OR claim_expires_at > now())"
+
+// currentAssignee is the moderator holding report or NULL
+const currentAssignee = "(CASE WHEN " + claimActive + " THEN assignee_uid END)"
+
+// reportHeldError tells why report wasn't updated: it doesn't exist or condition on its holder failed
+func (db *db) reportHeldError(uid uuid.UUID, err error) error {
+	var exists bool
+	if err := db.QueryRow("SELECT EXISTS (SELECT 1 FROM reports WHERE uid=$1)", uid.String()).Scan(&exists); err != nil {
+		return err
+	}
+
+	if !exists {
+		return errNotFound
+	}
+
+	return err
+}
+
+// claimReport makes moderator hold report until expiresAt unless somebody else holds it.
+// Claiming report held by the same moderator prolongs the claim, assignments are kept without expiration
+func (db *db) claimReport(uid, moderatorUID uuid.UUID, expiresAt time.Time) (*Report, error) {
+	query := `UPDATE reports
+	          SET claim_expires_at=CASE WHEN ` + currentAssignee + `=$1 AND claim_expires_at IS NULL THEN NULL ELSE $2::timestamptz END,
+	              assignee_uid=$1
+	          WHERE uid=$3 AND (` + currentAssignee + ` IS NULL OR ` + currentAssignee + `=$1)
+	          RETURNING ` + reportColumns
+	report, err := scanReport(db.QueryRow(query, moderatorUID.String(), expiresAt, uid.String()))
+	switch err {
+	case nil:
+		return report, nil
+	case sql.ErrNoRows:
+		return nil, db.reportHeldError(uid, errReportHeld)
+	default:
+		return nil, err
+	}
+}
+
+// releaseReport stops moderator from holding report
+func (db *db) releaseReport(uid, moderatorUID uuid.UUID) (*Report, error) {
+	query := `UPDATE reports SET assignee_uid=NULL, claim_expires_at=NULL
+	          WHERE uid=$1 AND ` + currentAssignee + `=$2
+	          RETURNING ` + reportColumns
+	report, err := scanReport(db.QueryRow(query, uid.String(), moderatorUID.String()))
+	switch err {
+	case nil:
+		return report, nil
+	case sql.ErrNoRows:
+		return nil, db.reportHeldError(uid, errReportNotHeld)
+	default:
+		return nil, err
+	}
+}
+
+// assignReport makes moderator hold report until released, if report is still held by expectedAssigneeUID.
+// uuid.Nil expectedAssigneeUID means report must be held by nobody
+func (db *db) assignReport(uid, assigneeUID, expectedAssigneeUID uuid.UUID) (*Report, error) {
+	query := `UPDATE reports SET assignee_uid=$1, claim_expires_at=NULL
+	          WHERE uid=$2 AND ` + currentAssignee + ` IS NOT DISTINCT FROM $3::uuid
+	          RETURNING ` + reportColumns
+	report, err := scanReport(db.QueryRow(query, assigneeUID.String(), uid.String(), nullableUUID(expectedAssigneeUID)))
+	switch err {
+	case nil:
+		return report, nil
+	case sql.ErrNoRows:
+		return nil, db.reportHeldError(uid, errAssigneeMoved)
+	default:
+		return nil, err
+	}
+}
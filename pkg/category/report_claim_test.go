@@ -0,0 +1,193 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+var (
+	// heldReportUID is held by moderatorUID until released
+	heldReportUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000070"))
+	// missingReportUID doesn't exist
+	missingReportUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000071"))
+)
+
+func (mdb *mockdb) getReportCategoryUID(uid uuid.UUID) (uuid.UUID, error) {
+	if uid == missingReportUID {
+		return uuid.Nil, errNotFound
+	}
+
+	return restrictedUID, nil
+}
+
+func (mdb *mockdb) claimReport(uid, claimerUID uuid.UUID, expiresAt time.Time) (*Report, error) {
+	switch {
+	case uid == missingReportUID:
+		return nil, errNotFound
+	case uid == heldReportUID && claimerUID != moderatorUID:
+		return nil, errReportHeld
+	case uid == heldReportUID:
+		expiresAt = time.Time{}
+	}
+
+	return &Report{UID: uid, AssigneeUID: claimerUID, ClaimExpiresAt: expiresAt, CreatedAt: time.Now()}, nil
+}
+
+func (mdb *mockdb) releaseReport(uid, releaserUID uuid.UUID) (*Report, error) {
+	switch {
+	case uid == missingReportUID:
+		return nil, errNotFound
+	case uid != heldReportUID || releaserUID != moderatorUID:
+		return nil, errReportNotHeld
+	}
+
+	return &Report{UID: uid, CreatedAt: time.Now()}, nil
+}
+
+func (mdb *mockdb) assignReport(uid, assigneeUID, expectedAssigneeUID uuid.UUID) (*Report, error) {
+	currentAssigneeUID := uuid.Nil
+	switch uid {
+	case missingReportUID:
+		return nil, errNotFound
+	case heldReportUID:
+		currentAssigneeUID = moderatorUID
+	}
+
+	if currentAssigneeUID != expectedAssigneeUID {
+		return nil, errAssigneeMoved
+	}
+
+	return &Report{UID: uid, AssigneeUID: assigneeUID, CreatedAt: time.Now()}, nil
+}
+
+func TestClaimReport(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ClaimReportRequest{Uid: rootUID.String(), ModeratorUid: ownerUID.String()}
+	res, err := s.ClaimReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.AssigneeUid != ownerUID.String() || res.ClaimExpiresAt == nil {
+		t.Errorf("unexpected report %v", res)
+	}
+
+	req.Uid = heldReportUID.String()
+	_, err = s.ClaimReport(context.Background(), req)
+	if err != statusReportHeld {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.ModeratorUid = moderatorUID.String()
+	res, err = s.ClaimReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.ClaimExpiresAt != nil {
+		t.Errorf("assignment became claim %v", res)
+	}
+
+	req.ModeratorUid = memberUID.String()
+	_, err = s.ClaimReport(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Uid = missingReportUID.String()
+	_, err = s.ClaimReport(context.Background(), req)
+	if err != statusReportNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestReleaseReport(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ReleaseReportRequest{Uid: heldReportUID.String(), ModeratorUid: moderatorUID.String()}
+	res, err := s.ReleaseReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.AssigneeUid != "" {
+		t.Errorf("unexpected report %v", res)
+	}
+
+	req.ModeratorUid = ownerUID.String()
+	_, err = s.ReleaseReport(context.Background(), req)
+	if err != statusReportNotHeld {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.ModeratorUid = memberUID.String()
+	_, err = s.ReleaseReport(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestAssignReport(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.AssignReportRequest{
+		Uid: heldReportUID.String(), AssigneeUid: ownerUID.String(), ExpectedAssigneeUid: moderatorUID.String(), ModeratorUid: ownerUID.String(),
+	}
+	res, err := s.AssignReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.AssigneeUid != ownerUID.String() {
+		t.Errorf("unexpected report %v", res)
+	}
+
+	req.ExpectedAssigneeUid = ""
+	_, err = s.AssignReport(context.Background(), req)
+	if err != statusAssigneeMoved {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Uid = rootUID.String()
+	_, err = s.AssignReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.AssigneeUid = memberUID.String()
+	_, err = s.AssignReport(context.Background(), req)
+	if err != statusAssigneeNotModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.AssigneeUid = moderatorUID.String()
+	req.ModeratorUid = memberUID.String()
+	_, err = s.AssignReport(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.AssigneeUid = ""
+	req.ModeratorUid = ""
+	_, err = s.AssignReport(context.Background(), req)
+	if !hasViolations(err, "assigneeUid", "moderatorUid") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListReportsByAssignee(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListReportsRequest{CategoryUid: nilUIDString, AssigneeUid: moderatorUID.String()}
+	_, err := s.ListReports(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UnassignedOnly = true
+	_, err = s.ListReports(context.Background(), req)
+	if !hasViolations(err, "unassignedOnly") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestReportFilterAssignee(t *testing.T) {
+	args := new(queryArgs)
+	where := (&reportFilter{Unassigned: true}).where(args)
+	if where != "TRUE AND (assignee_uid IS NULL OR NOT "+claimActive+")" || len(*args) != 0 {
+		t.Errorf("unexpected condition %q", where)
+	}
+}
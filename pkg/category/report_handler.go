@@ -0,0 +1,238 @@
+package category
+
+import (
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	statusReportHandlerNotFound = status.Error(codes.NotFound, "user isn't report handler of category")
+	statusNotCategoryModerator  = status.Error(codes.PermissionDenied, "only category owner or report handler can do this")
+)
+
+var assignmentStrategies = map[pb.AssignmentStrategy]AssignmentStrategy{
+	pb.AssignmentStrategy_MANUAL:       AssignmentManual,
+	pb.AssignmentStrategy_ROUND_ROBIN:  AssignmentRoundRobin,
+	pb.AssignmentStrategy_LEAST_LOADED: AssignmentLeastLoaded,
+	pb.AssignmentStrategy_KEYWORD:      AssignmentKeyword,
+}
+
+var assignmentStrategiesProto = map[AssignmentStrategy]pb.AssignmentStrategy{
+	AssignmentManual:      pb.AssignmentStrategy_MANUAL,
+	AssignmentRoundRobin:  pb.AssignmentStrategy_ROUND_ROBIN,
+	AssignmentLeastLoaded: pb.AssignmentStrategy_LEAST_LOADED,
+	AssignmentKeyword:     pb.AssignmentStrategy_KEYWORD,
+}
+
+// getModeratedCategory returns category if user is its owner or one of its report handlers
+func (s *Server) getModeratedCategory(uid, userUID uuid.UUID) (*Category, error) {
+	category, err := s.db.getCategoryInfo(uid)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	if category.UserUID == userUID {
+		return category, nil
+	}
+
+	ok, err := s.db.isReportHandler(uid, userUID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	if !ok {
+		return nil, statusNotCategoryModerator
+	}
+
+	return category, nil
+}
+
+// getReportModeratedCategory returns category of report if user is its owner or one of its report handlers
+func (s *Server) getReportModeratedCategory(reportUID, userUID uuid.UUID) (*Category, error) {
+	categoryUID, err := s.db.getReportCategoryUID(reportUID)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusReportNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	return s.getModeratedCategory(categoryUID, userUID)
+}
+
+// SingleReportHandler converts ReportHandler to SingleReportHandler
+func (h *ReportHandler) SingleReportHandler() (*pb.SingleReportHandler, error) {
+	createdAtProto, err := ptypes.TimestampProto(h.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleReportHandler)
+	res.CategoryUid = h.CategoryUID.String()
+	res.UserUid = h.UserUID.String()
+	res.Away = h.Away
+	res.Keywords = h.Keywords
+	if !h.LastAssignedAt.IsZero() {
+		res.LastAssignedAt, err = ptypes.TimestampProto(h.LastAssignedAt)
+		if err != nil {
+			return nil, internalError(err)
+		}
+	}
+
+	res.CreatedAt = createdAtProto
+	res.Load = h.Load
+
+	return res, nil
+}
+
+// SetAssignmentStrategy changes how new reports of category are assigned to its handlers, only owner can do it
+func (s *Server) SetAssignmentStrategy(ctx context.Context, req *pb.SetAssignmentStrategyRequest) (*pb.SetAssignmentStrategyResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	strategy := v.assignmentStrategy("strategy", req.Strategy)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.setAssignmentStrategy(categoryUID, strategy); err {
+	case nil:
+		res := new(pb.SetAssignmentStrategyResponse)
+		res.Strategy = assignmentStrategiesProto[strategy]
+		return res, nil
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// AddReportHandler adds user to handlers of category or replaces keywords of handler, only owner can do it
+func (s *Server) AddReportHandler(ctx context.Context, req *pb.AddReportHandlerRequest) (*pb.SingleReportHandler, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	handlerUID := v.uuid("handlerUid", req.HandlerUid)
+	keywords := v.keywords("keywords", req.Keywords)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	handler, err := s.db.addReportHandler(categoryUID, handlerUID, keywords)
+	switch err {
+	case nil:
+		return handler.SingleReportHandler()
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// RemoveReportHandler removes user from handlers of category, only owner can do it.
+// Reports already assigned to the handler stay assigned
+func (s *Server) RemoveReportHandler(ctx context.Context, req *pb.RemoveReportHandlerRequest) (*pb.RemoveReportHandlerResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	handlerUID := v.uuid("handlerUid", req.HandlerUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.removeReportHandler(categoryUID, handlerUID); err {
+	case nil:
+		return new(pb.RemoveReportHandlerResponse), nil
+	case errNotFound:
+		return nil, statusReportHandlerNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// SetReportHandlerAway marks handler as away, so new reports aren't assigned to them.
+// It can be done by the handler or by owner of category
+func (s *Server) SetReportHandlerAway(ctx context.Context, req *pb.SetReportHandlerAwayRequest) (*pb.SingleReportHandler, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	handlerUID := v.uuid("handlerUid", req.HandlerUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if userUID != handlerUID {
+		if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+			return nil, err
+		}
+	}
+
+	handler, err := s.db.setReportHandlerAway(categoryUID, handlerUID, req.Away)
+	switch err {
+	case nil:
+		return handler.SingleReportHandler()
+	case errNotFound:
+		return nil, statusReportHandlerNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ListReportHandlers returns handlers of category and its assignment strategy, only owner can do it
+func (s *Server) ListReportHandlers(ctx context.Context, req *pb.ListReportHandlersRequest) (*pb.ListReportHandlersResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	strategy, err := s.db.getAssignmentStrategy(categoryUID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	handlers, err := s.db.getReportHandlers(categoryUID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListReportHandlersResponse)
+	for _, handler := range handlers {
+		handlerResponse, err := handler.SingleReportHandler()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Handlers = append(res.Handlers, handlerResponse)
+	}
+
+	res.Strategy = assignmentStrategiesProto[strategy]
+
+	return res, nil
+}
@@ -0,0 +1,209 @@
+package category
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ReportHandler describes member of category team which reports are assigned to automatically
+type ReportHandler struct {
+	CategoryUID    uuid.UUID
+	UserUID        uuid.UUID
+	Away           bool
+	Keywords       []string
+	LastAssignedAt time.Time
+	CreatedAt      time.Time
+	// Load is the number of reports of category the handler holds
+	Load int64
+}
+
+// reportHandlerColumns are selected from report_handlers h
+const reportHandlerColumns = `h.category_uid, h.user_uid, h.away, h.keywords, h.last_assigned_at, h.created_at,
+	(SELECT count(*) FROM reports r WHERE r.category_uid=h.category_uid AND r.assignee_uid=h.user_uid AND ` + claimActive + `)`
+
+func scanReportHandler(row scanner) (*ReportHandler, error) {
+	handler := new(ReportHandler)
+	var categoryUID, userUID string
+	var lastAssignedAt pq.NullTime
+	err := row.Scan(&categoryUID, &userUID, &handler.Away, pq.Array(&handler.Keywords), &lastAssignedAt,
+		&handler.CreatedAt, &handler.Load,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if lastAssignedAt.Valid {
+		handler.LastAssignedAt = lastAssignedAt.Time
+	}
+
+	handler.CategoryUID, err = uuid.Parse(categoryUID)
+	if err != nil {
+		return nil, err
+	}
+
+	handler.UserUID, err = uuid.Parse(userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler, nil
+}
+
+func (db *db) getAssignmentStrategy(categoryUID uuid.UUID) (AssignmentStrategy, error) {
+	return getAssignmentStrategy(db, categoryUID)
+}
+
+func getAssignmentStrategy(q queryer, categoryUID uuid.UUID) (AssignmentStrategy, error) {
+	var strategy string
+	err := q.QueryRow("SELECT strategy FROM assignment_policies WHERE category_uid=$1", categoryUID.String()).Scan(&strategy)
+	switch err {
+	case nil:
+		return AssignmentStrategy(strategy), nil
+	case sql.ErrNoRows:
+		return AssignmentManual, nil
+	default:
+		return "", err
+	}
+}
+
+func (db *db) setAssignmentStrategy(categoryUID uuid.UUID, strategy AssignmentStrategy) error {
+	query := `INSERT INTO assignment_policies (category_uid, strategy) VALUES ($1, $2)
+	          ON CONFLICT (category_uid) DO UPDATE SET strategy=EXCLUDED.strategy`
+	_, err := db.Exec(query, categoryUID.String(), string(strategy))
+	if isForeignKeyViolation(err) {
+		return errNotFound
+	}
+
+	return err
+}
+
+// addReportHandler adds user to handlers of category or replaces keywords of handler
+func (db *db) addReportHandler(categoryUID, userUID uuid.UUID, keywords []string) (*ReportHandler, error) {
+	query := `INSERT INTO report_handlers AS h (category_uid, user_uid, away, keywords, created_at)
+	          VALUES ($1, $2, FALSE, $3, $4)
+	          ON CONFLICT (category_uid, user_uid) DO UPDATE SET keywords=EXCLUDED.keywords
+	          RETURNING ` + reportHandlerColumns
+	row := db.QueryRow(query, categoryUID.String(), userUID.String(), pq.Array(keywords), time.Now())
+	result, err := scanReportHandler(row)
+	if isForeignKeyViolation(err) {
+		return nil, errNotFound
+	}
+
+	return result, err
+}
+
+func (db *db) removeReportHandler(categoryUID, userUID uuid.UUID) error {
+	query := "DELETE FROM report_handlers WHERE category_uid=$1 AND user_uid=$2"
+	result, err := db.Exec(query, categoryUID.String(), userUID.String())
+	if err != nil {
+		return err
+	}
+
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if nRows == 0 {
+		return errNotFound
+	}
+
+	return nil
+}
+
+func (db *db) isReportHandler(categoryUID, userUID uuid.UUID) (bool, error) {
+	query := "SELECT EXISTS (SELECT 1 FROM report_handlers WHERE category_uid=$1 AND user_uid=$2)"
+	var result bool
+	err := db.QueryRow(query, categoryUID.String(), userUID.String()).Scan(&result)
+	return result, err
+}
+
+func (db *db) setReportHandlerAway(categoryUID, userUID uuid.UUID, away bool) (*ReportHandler, error) {
+	query := `UPDATE report_handlers h SET away=$1 WHERE category_uid=$2 AND user_uid=$3
+	          RETURNING ` + reportHandlerColumns
+	result, err := scanReportHandler(db.QueryRow(query, away, categoryUID.String(), userUID.String()))
+	switch err {
+	case nil:
+		return result, nil
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (db *db) getReportHandlers(categoryUID uuid.UUID) ([]*ReportHandler, error) {
+	query := `SELECT ` + reportHandlerColumns + ` FROM report_handlers h
+	          WHERE h.category_uid=$1
+	          ORDER BY h.created_at`
+	return queryReportHandlers(db, query, categoryUID.String())
+}
+
+func queryReportHandlers(q queryer, query string, args ...interface{}) ([]*ReportHandler, error) {
+	rows, err := q.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*ReportHandler, 0)
+	for rows.Next() {
+		handler, err := scanReportHandler(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, handler)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// autoAssignReport assigns new report to handler picked by assignment strategy of its category.
+// Handlers are locked, so concurrent reports see assignments of each other
+func autoAssignReport(tx *sql.Tx, report *Report) error {
+	strategyName, err := getAssignmentStrategy(tx, report.CategoryUID)
+	if err != nil {
+		return err
+	}
+
+	strategy, ok := strategies[strategyName]
+	if !ok {
+		return nil
+	}
+
+	query := `SELECT ` + reportHandlerColumns + ` FROM report_handlers h
+	          WHERE h.category_uid=$1 AND NOT h.away
+	          ORDER BY h.created_at
+	          FOR UPDATE OF h`
+	handlers, err := queryReportHandlers(tx, query, report.CategoryUID.String())
+	if err != nil {
+		return err
+	}
+
+	handler := strategy.pick(report, handlers)
+	if handler == nil {
+		return nil
+	}
+
+	_, err = tx.Exec("UPDATE reports SET assignee_uid=$1 WHERE uid=$2", handler.UserUID.String(), report.UID.String())
+	if err != nil {
+		return err
+	}
+
+	query = "UPDATE report_handlers SET last_assigned_at=$1 WHERE category_uid=$2 AND user_uid=$3"
+	_, err = tx.Exec(query, report.CreatedAt, report.CategoryUID.String(), handler.UserUID.String())
+	if err != nil {
+		return err
+	}
+
+	report.AssigneeUID = handler.UserUID
+	return nil
+}
@@ -0,0 +1,175 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+func (mdb *mockdb) getAssignmentStrategy(categoryUID uuid.UUID) (AssignmentStrategy, error) {
+	return AssignmentRoundRobin, nil
+}
+
+func (mdb *mockdb) setAssignmentStrategy(categoryUID uuid.UUID, strategy AssignmentStrategy) error {
+	return nil
+}
+
+func (mdb *mockdb) addReportHandler(categoryUID, userUID uuid.UUID, keywords []string) (*ReportHandler, error) {
+	return &ReportHandler{CategoryUID: categoryUID, UserUID: userUID, Keywords: keywords, CreatedAt: time.Now()}, nil
+}
+
+func (mdb *mockdb) removeReportHandler(categoryUID, userUID uuid.UUID) error {
+	if userUID != moderatorUID {
+		return errNotFound
+	}
+
+	return nil
+}
+
+func (mdb *mockdb) isReportHandler(categoryUID, userUID uuid.UUID) (bool, error) {
+	return userUID == moderatorUID, nil
+}
+
+func (mdb *mockdb) setReportHandlerAway(categoryUID, userUID uuid.UUID, away bool) (*ReportHandler, error) {
+	if userUID != moderatorUID {
+		return nil, errNotFound
+	}
+
+	return &ReportHandler{CategoryUID: categoryUID, UserUID: userUID, Away: away, CreatedAt: time.Now()}, nil
+}
+
+func (mdb *mockdb) getReportHandlers(categoryUID uuid.UUID) ([]*ReportHandler, error) {
+	now := time.Now()
+	return []*ReportHandler{
+		{CategoryUID: categoryUID, UserUID: moderatorUID, Keywords: []string{"spam"}, LastAssignedAt: now, CreatedAt: now, Load: 2},
+	}, nil
+}
+
+func TestStrategies(t *testing.T) {
+	now := time.Now()
+	first := &ReportHandler{UserUID: uuid.New(), CreatedAt: now.Add(-3 * time.Hour), LastAssignedAt: now.Add(-time.Minute), Load: 1}
+	second := &ReportHandler{UserUID: uuid.New(), CreatedAt: now.Add(-2 * time.Hour), LastAssignedAt: now.Add(-time.Hour), Load: 5}
+	third := &ReportHandler{UserUID: uuid.New(), CreatedAt: now.Add(-time.Hour), Keywords: []string{"crypto", "scam"}, Load: 1}
+	fourth := &ReportHandler{UserUID: uuid.New(), CreatedAt: now, Load: 1}
+	handlers := []*ReportHandler{first, second, third, fourth}
+
+	tests := []struct {
+		strategy AssignmentStrategy
+		reason   string
+		handlers []*ReportHandler
+		expected *ReportHandler
+	}{
+		{AssignmentRoundRobin, "", handlers, third},
+		{AssignmentRoundRobin, "", []*ReportHandler{first, second}, second},
+		{AssignmentLeastLoaded, "", []*ReportHandler{first, second}, first},
+		{AssignmentLeastLoaded, "", handlers, third},
+		{AssignmentKeyword, "Crypto giveaway", []*ReportHandler{first, fourth, third}, third},
+		{AssignmentKeyword, "rude", []*ReportHandler{first, second}, second},
+		{AssignmentRoundRobin, "", nil, nil},
+	}
+	for _, tt := range tests {
+		actual := strategies[tt.strategy].pick(&Report{Reason: tt.reason}, tt.handlers)
+		if actual != tt.expected {
+			t.Errorf("%s strategy with reason %q picked %v, expected %v", tt.strategy, tt.reason, actual, tt.expected)
+		}
+	}
+
+	if _, ok := strategies[AssignmentManual]; ok {
+		t.Errorf("manual strategy must not assign reports")
+	}
+}
+
+func TestSetAssignmentStrategy(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.SetAssignmentStrategyRequest{CategoryUid: restrictedUID.String(), UserUid: ownerUID.String(), Strategy: pb.AssignmentStrategy_LEAST_LOADED}
+	res, err := s.SetAssignmentStrategy(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Strategy != pb.AssignmentStrategy_LEAST_LOADED {
+		t.Errorf("unexpected strategy %v", res.Strategy)
+	}
+
+	req.Strategy = pb.AssignmentStrategy(100)
+	_, err = s.SetAssignmentStrategy(context.Background(), req)
+	if !hasViolations(err, "strategy") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Strategy = pb.AssignmentStrategy_KEYWORD
+	req.UserUid = moderatorUID.String()
+	_, err = s.SetAssignmentStrategy(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestAddReportHandler(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.AddReportHandlerRequest{
+		CategoryUid: restrictedUID.String(), UserUid: ownerUID.String(), HandlerUid: moderatorUID.String(), Keywords: []string{" Spam ", "spam", "Scam"},
+	}
+	res, err := s.AddReportHandler(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Keywords) != 2 || res.Keywords[0] != "spam" || res.Keywords[1] != "scam" {
+		t.Errorf("unexpected keywords %v", res.Keywords)
+	}
+
+	req.Keywords = []string{"spam", ""}
+	_, err = s.AddReportHandler(context.Background(), req)
+	if !hasViolations(err, "keywords[1]") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestRemoveReportHandler(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.RemoveReportHandlerRequest{CategoryUid: restrictedUID.String(), UserUid: ownerUID.String(), HandlerUid: moderatorUID.String()}
+	_, err := s.RemoveReportHandler(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.HandlerUid = memberUID.String()
+	_, err = s.RemoveReportHandler(context.Background(), req)
+	if err != statusReportHandlerNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestSetReportHandlerAway(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.SetReportHandlerAwayRequest{CategoryUid: restrictedUID.String(), UserUid: moderatorUID.String(), HandlerUid: moderatorUID.String(), Away: true}
+	res, err := s.SetReportHandlerAway(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if !res.Away {
+		t.Errorf("unexpected handler %v", res)
+	}
+
+	req.UserUid = ownerUID.String()
+	_, err = s.SetReportHandlerAway(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.SetReportHandlerAway(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListReportHandlers(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListReportHandlersRequest{CategoryUid: restrictedUID.String(), UserUid: ownerUID.String()}
+	res, err := s.ListReportHandlers(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Handlers) != 1 || res.Strategy != pb.AssignmentStrategy_ROUND_ROBIN || res.Handlers[0].LastAssignedAt == nil {
+		t.Errorf("unexpected response %v", res)
+	}
+}
@@ -0,0 +1,100 @@
+package category
+
+import (
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var statusParentNoteNotFound = status.Error(codes.NotFound, "parent note not found in report")
+
+// SingleReportNote converts ReportNote to SingleReportNote
+func (n *ReportNote) SingleReportNote() (*pb.SingleReportNote, error) {
+	createdAtProto, err := ptypes.TimestampProto(n.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleReportNote)
+	res.Uid = n.UID.String()
+	res.ReportUid = n.ReportUID.String()
+	if n.ParentUID != uuid.Nil {
+		res.ParentUid = n.ParentUID.String()
+	}
+
+	res.AuthorUid = n.AuthorUID.String()
+	res.Text = n.Text
+	res.CreatedAt = createdAtProto
+
+	return res, nil
+}
+
+// AddReportNote adds internal note of moderator to report, note can reply to another note of the same report.
+// Author must be owner or report handler of category of report
+func (s *Server) AddReportNote(ctx context.Context, req *pb.AddReportNoteRequest) (*pb.SingleReportNote, error) {
+	v := new(validator)
+	note := new(ReportNote)
+	note.ReportUID = v.uuid("reportUid", req.ReportUid)
+	note.AuthorUID = v.uuid("authorUid", req.AuthorUid)
+	note.ParentUID = v.optionalUUID("parentUid", req.ParentUid)
+	note.Text = v.text("text", req.Text, reportNoteRules)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getReportModeratedCategory(note.ReportUID, note.AuthorUID); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.addReportNote(note); err {
+	case nil:
+		return note.SingleReportNote()
+	case errNotFound:
+		return nil, statusReportNotFound
+	case errParentNoteNotFound:
+		return nil, statusParentNoteNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ListReportNotes returns notes of report oldest first. Notes of deleted report are returned from archive
+// until their retention period ends. User must be owner or report handler of category of report
+func (s *Server) ListReportNotes(ctx context.Context, req *pb.ListReportNotesRequest) (*pb.ListReportNotesResponse, error) {
+	v := new(validator)
+	reportUID := v.uuid("reportUid", req.ReportUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getReportModeratedCategory(reportUID, userUID); err != nil {
+		return nil, err
+	}
+
+	notes, archived, err := s.db.getReportNotes(reportUID)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusReportNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListReportNotesResponse)
+	for _, note := range notes {
+		noteResponse, err := note.SingleReportNote()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Notes = append(res.Notes, noteResponse)
+	}
+
+	res.ReportDeleted = archived
+
+	return res, nil
+}
@@ -0,0 +1,140 @@
+package category
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// reportNoteRetention is the time notes of deleted report are kept in archive
+const reportNoteRetention = 90 * 24 * time.Hour
+
+// reportNotesParentFKey is the constraint making replies belong to the same report as the note they reply to
+const reportNotesParentFKey = "report_notes_parent_fkey"
+
+var errParentNoteNotFound = errors.New("parent note not found")
+
+// ReportNote is an internal note of moderators discussing report, notes replying to other notes have ParentUID
+type ReportNote struct {
+	UID       uuid.UUID
+	ReportUID uuid.UUID
+	ParentUID uuid.UUID
+	AuthorUID uuid.UUID
+	Text      string
+	CreatedAt time.Time
+}
+
+// addReportNote stores note and counts it in report, its UID and creation time are set
+func (db *db) addReportNote(note *ReportNote) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	note.UID = uuid.New()
+	note.CreatedAt = time.Now()
+	query := `INSERT INTO report_notes (uid, report_uid, parent_uid, author_uid, text, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err = tx.Exec(query, note.UID.String(), note.ReportUID.String(), nullableUUID(note.ParentUID), note.AuthorUID.String(),
+		note.Text, note.CreatedAt,
+	)
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == foreignKeyViolation {
+		if pqErr.Constraint == reportNotesParentFKey {
+			return errParentNoteNotFound
+		}
+
+		return errNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("UPDATE reports SET note_count=note_count+1 WHERE uid=$1", note.ReportUID.String())
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// getReportNotes returns notes of report oldest first.
+// Notes of deleted report are read from archive, archived tells which of them are returned.
+// Deleted report without archived notes isn't found
+func (db *db) getReportNotes(reportUID uuid.UUID) (notes []*ReportNote, archived bool, err error) {
+	query := `SELECT uid, parent_uid, author_uid, text, created_at, FALSE FROM report_notes WHERE report_uid=$1
+	          UNION ALL
+	          SELECT uid, parent_uid, author_uid, text, created_at, TRUE FROM archived_report_notes
+	          WHERE report_uid=$1 AND NOT EXISTS (SELECT 1 FROM reports WHERE uid=$1)
+	          ORDER BY created_at, uid`
+	rows, err := db.Query(query, reportUID.String())
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer rows.Close()
+	notes = make([]*ReportNote, 0)
+	for rows.Next() {
+		note := new(ReportNote)
+		var uid, authorUID string
+		var parentUID sql.NullString
+		err := rows.Scan(&uid, &parentUID, &authorUID, &note.Text, &note.CreatedAt, &archived)
+		if err != nil {
+			return nil, false, err
+		}
+
+		note.UID, err = uuid.Parse(uid)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if parentUID.Valid {
+			note.ParentUID, err = uuid.Parse(parentUID.String)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+
+		note.AuthorUID, err = uuid.Parse(authorUID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		note.ReportUID = reportUID
+
+		notes = append(notes, note)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	if len(notes) == 0 {
+		var exists bool
+		err := db.QueryRow("SELECT EXISTS (SELECT 1 FROM reports WHERE uid=$1)", reportUID.String()).Scan(&exists)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !exists {
+			return nil, false, errNotFound
+		}
+	}
+
+	return notes, archived, nil
+}
+
+// archiveReportNotes copies notes of reports being deleted to archive, where they are kept for reportNoteRetention
+func archiveReportNotes(tx *sql.Tx, reportUIDs ...uuid.UUID) error {
+	query := `INSERT INTO archived_report_notes (uid, report_uid, category_uid, post_uid, parent_uid, author_uid, text, created_at, purge_after)
+	          SELECT n.uid, n.report_uid, r.category_uid, r.post_uid, n.parent_uid, n.author_uid, n.text, n.created_at, $2::timestamptz
+	          FROM report_notes n JOIN reports r ON r.uid=n.report_uid
+	          WHERE n.report_uid=ANY($1)`
+	_, err := tx.Exec(query, pq.Array(uuidStrings(reportUIDs)), time.Now().Add(reportNoteRetention))
+	return err
+}
@@ -0,0 +1,126 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+var (
+	// reportNoteUID is a note of heldReportUID which can be replied to
+	reportNoteUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000090"))
+	// deletedReportUID is a deleted report with archived notes
+	deletedReportUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000091"))
+)
+
+func (mdb *mockdb) addReportNote(note *ReportNote) error {
+	switch {
+	case note.ReportUID != heldReportUID:
+		return errNotFound
+	case note.ParentUID != uuid.Nil && note.ParentUID != reportNoteUID:
+		return errParentNoteNotFound
+	}
+
+	note.UID = uuid.New()
+	note.CreatedAt = time.Now()
+	return nil
+}
+
+func (mdb *mockdb) getReportNotes(reportUID uuid.UUID) ([]*ReportNote, bool, error) {
+	now := time.Now()
+	notes := []*ReportNote{
+		{UID: reportNoteUID, ReportUID: reportUID, AuthorUID: moderatorUID, Text: "looks like spam", CreatedAt: now.Add(-time.Hour)},
+		{UID: uuid.New(), ReportUID: reportUID, ParentUID: reportNoteUID, AuthorUID: ownerUID, Text: "agreed", CreatedAt: now},
+	}
+
+	switch reportUID {
+	case heldReportUID:
+		return notes, false, nil
+	case deletedReportUID:
+		return notes, true, nil
+	default:
+		return nil, false, errNotFound
+	}
+}
+
+func TestAddReportNote(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.AddReportNoteRequest{ReportUid: heldReportUID.String(), AuthorUid: moderatorUID.String(), Text: "looks like spam"}
+	res, err := s.AddReportNote(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Uid == "" || res.ParentUid != "" {
+		t.Errorf("unexpected note %v", res)
+	}
+
+	req.ParentUid = reportNoteUID.String()
+	res, err = s.AddReportNote(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.ParentUid != reportNoteUID.String() {
+		t.Errorf("unexpected note %v", res)
+	}
+
+	req.ParentUid = uuid.New().String()
+	_, err = s.AddReportNote(context.Background(), req)
+	if err != statusParentNoteNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.ParentUid = ""
+	req.AuthorUid = memberUID.String()
+	_, err = s.AddReportNote(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.ReportUid = missingReportUID.String()
+	_, err = s.AddReportNote(context.Background(), req)
+	if err != statusReportNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestAddReportNoteFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.AddReportNoteRequest{ReportUid: "", AuthorUid: "not uuid", ParentUid: "not uuid"}
+	_, err := s.AddReportNote(context.Background(), req)
+	if !hasViolations(err, "reportUid", "authorUid", "parentUid", "text") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListReportNotes(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListReportNotesRequest{ReportUid: heldReportUID.String(), UserUid: moderatorUID.String()}
+	res, err := s.ListReportNotes(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Notes) != 2 || res.Notes[1].ParentUid != reportNoteUID.String() || res.ReportDeleted {
+		t.Errorf("unexpected notes %v", res)
+	}
+
+	req.ReportUid = deletedReportUID.String()
+	req.UserUid = ownerUID.String()
+	res, err = s.ListReportNotes(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if !res.ReportDeleted {
+		t.Errorf("unexpected notes %v", res)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.ListReportNotes(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.ReportUid = missingReportUID.String()
+	_, err = s.ListReportNotes(context.Background(), req)
+	if err != statusReportNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package category
+
+import (
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+)
+
+const maxBanDuration = 10 * 365 * 24 * time.Hour
+
+var outcomes = map[pb.Outcome]Outcome{
+	pb.Outcome_NO_VIOLATION:    OutcomeNoViolation,
+	pb.Outcome_CONTENT_REMOVED: OutcomeContentRemoved,
+	pb.Outcome_USER_WARNED:     OutcomeUserWarned,
+	pb.Outcome_USER_BANNED:     OutcomeUserBanned,
+	pb.Outcome_ESCALATED:       OutcomeEscalated,
+}
+
+var outcomesProto = map[Outcome]pb.Outcome{
+	OutcomeNoViolation:    pb.Outcome_NO_VIOLATION,
+	OutcomeContentRemoved: pb.Outcome_CONTENT_REMOVED,
+	OutcomeUserWarned:     pb.Outcome_USER_WARNED,
+	OutcomeUserBanned:     pb.Outcome_USER_BANNED,
+	OutcomeEscalated:      pb.Outcome_ESCALATED,
+}
+
+// SingleReportOutcome converts ReportOutcome to SingleReportOutcome
+func (o *ReportOutcome) SingleReportOutcome() (*pb.SingleReportOutcome, error) {
+	reportedAtProto, err := ptypes.TimestampProto(o.ReportedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	resolvedAtProto, err := ptypes.TimestampProto(o.ResolvedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleReportOutcome)
+	res.ReportUid = o.ReportUID.String()
+	res.CategoryUid = o.CategoryUID.String()
+	res.PostUid = o.PostUID.String()
+	res.CommentUid = o.CommentUID.String()
+	res.ReasonCode = reasonCodesProto[o.ReasonCode]
+	res.ModeratorUid = o.ModeratorUID.String()
+	res.Outcome = outcomesProto[o.Outcome]
+	if o.BanDuration != 0 {
+		res.BanDuration = ptypes.DurationProto(o.BanDuration)
+	}
+
+	res.Justification = o.Justification
+	res.ReportedAt = reportedAtProto
+	res.ResolvedAt = resolvedAtProto
+	if o.AutoAction != nil {
+		res.AutoAction, err = o.AutoAction.SingleAutoAction()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// ResolveReport records the action moderator took on report and removes report from queue.
+// Ban duration can be given only for banned users, ban without it is permanent.
+// Resolving report of post hidden by threshold policy as no violation unhides the post,
+// resolving it as removed content keeps the post hidden. Moderator must be owner or report handler of category of report
+func (s *Server) ResolveReport(ctx context.Context, req *pb.ResolveReportRequest) (*pb.SingleReportOutcome, error) {
+	v := new(validator)
+	outcome := new(ReportOutcome)
+	outcome.ReportUID = v.uuid("uid", req.Uid)
+	outcome.ModeratorUID = v.uuid("moderatorUid", req.ModeratorUid)
+	outcome.Outcome = v.outcome("outcome", req.Outcome)
+	if req.BanDuration != nil {
+		if req.Outcome == pb.Outcome_USER_BANNED {
+			outcome.BanDuration = v.duration("banDuration", req.BanDuration, maxBanDuration)
+		} else {
+			v.addViolation("banDuration", "ban duration can be given only for banned user")
+		}
+	}
+
+	outcome.Justification = v.optionalText("justification", req.Justification, justificationRules)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getReportModeratedCategory(outcome.ReportUID, outcome.ModeratorUID); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.resolveReport(outcome); err {
+	case nil:
+		return outcome.SingleReportOutcome()
+	case errNotFound:
+		return nil, statusReportNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ListReportOutcomes returns outcomes of resolved reports of category, latest first.
+// User must be owner or report handler of category
+func (s *Server) ListReportOutcomes(ctx context.Context, req *pb.ListReportOutcomesRequest) (*pb.ListReportOutcomesResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getModeratedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	reportOutcomes, err := s.db.getReportOutcomes(categoryUID, pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListReportOutcomesResponse)
+	for _, outcome := range reportOutcomes {
+		outcomeResponse, err := outcome.SingleReportOutcome()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Outcomes = append(res.Outcomes, outcomeResponse)
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
@@ -0,0 +1,242 @@
+package category
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Outcome is the action moderator took on report
+type Outcome string
+
+// Outcomes of reports
+const (
+	OutcomeNoViolation    Outcome = "no_violation"
+	OutcomeContentRemoved Outcome = "content_removed"
+	OutcomeUserWarned     Outcome = "user_warned"
+	OutcomeUserBanned     Outcome = "user_banned"
+	OutcomeEscalated      Outcome = "escalated"
+)
+
+// ReportOutcome records how report was resolved. It keeps what report was about, so it outlives the report.
+// BanDuration is set only for banned users, ban without duration is permanent.
+// AutoAction is the auto action hiding post of report which was reviewed by resolving report, it isn't stored with outcome
+type ReportOutcome struct {
+	ReportUID     uuid.UUID
+	CategoryUID   uuid.UUID
+	PostUID       uuid.UUID
+	CommentUID    uuid.UUID
+	ReasonCode    ReasonCode
+	ModeratorUID  uuid.UUID
+	Outcome       Outcome
+	BanDuration   time.Duration
+	Justification string
+	ReportedAt    time.Time
+	ResolvedAt    time.Time
+	AutoAction    *AutoAction
+}
+
+// reportResolvedEvent is payload of EventReportResolved
+type reportResolvedEvent struct {
+	ReportUID   string    `json:"reportUid"`
+	CategoryUID string    `json:"categoryUid"`
+	PostUID     string    `json:"postUid"`
+	CommentUID  string    `json:"commentUid"`
+	Outcome     Outcome   `json:"outcome"`
+	ResolvedAt  time.Time `json:"resolvedAt"`
+}
+
+// autoActionReview tells if resolving report reviews auto action hiding its post and if the post stays hidden.
+// Only reports of posts themselves decide whether post violates rules. Outcomes which don't tell it,
+// such as escalation, leave auto action for ReviewAutoAction
+func autoActionReview(outcome *ReportOutcome) (review, keepHidden bool) {
+	if outcome.CommentUID != uuid.Nil {
+		return false, false
+	}
+
+	switch outcome.Outcome {
+	case OutcomeNoViolation:
+		return true, false
+	case OutcomeContentRemoved:
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+const reportOutcomeColumns = `report_uid, category_uid, post_uid, comment_uid, reason_code, moderator_uid, outcome,
+	ban_seconds, justification, reported_at, resolved_at`
+
+func scanReportOutcome(row scanner) (*ReportOutcome, error) {
+	outcome := new(ReportOutcome)
+	var reportUID, categoryUID, postUID, commentUID, reasonCode, moderatorUID, result string
+	var banSeconds sql.NullInt64
+	var justification sql.NullString
+	err := row.Scan(&reportUID, &categoryUID, &postUID, &commentUID, &reasonCode, &moderatorUID, &result,
+		&banSeconds, &justification, &outcome.ReportedAt, &outcome.ResolvedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome.ReasonCode = ReasonCode(reasonCode)
+	outcome.Outcome = Outcome(result)
+	outcome.BanDuration = time.Duration(banSeconds.Int64) * time.Second
+	outcome.Justification = justification.String
+
+	outcome.ReportUID, err = uuid.Parse(reportUID)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome.CategoryUID, err = uuid.Parse(categoryUID)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome.PostUID, err = uuid.Parse(postUID)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome.CommentUID, err = uuid.Parse(commentUID)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome.ModeratorUID, err = uuid.Parse(moderatorUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return outcome, nil
+}
+
+// resolveReport records outcome of report and deletes the report the same way deleteReport does.
+// If outcome decides whether post hidden by threshold policy violates rules, its auto action is reviewed by moderator.
+// Fields of outcome describing the report and its resolution time are set
+func (db *db) resolveReport(outcome *ReportOutcome) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	query := "SELECT category_uid, post_uid, comment_uid, reason_code, routing, created_at FROM reports WHERE uid=$1 FOR UPDATE"
+	var categoryUID, postUID, commentUID, reasonCode, routing string
+	err = tx.QueryRow(query, outcome.ReportUID.String()).Scan(&categoryUID, &postUID, &commentUID, &reasonCode, &routing,
+		&outcome.ReportedAt,
+	)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return errNotFound
+	default:
+		return err
+	}
+
+	outcome.ReasonCode = ReasonCode(reasonCode)
+	outcome.ResolvedAt = time.Now()
+
+	outcome.CategoryUID, err = uuid.Parse(categoryUID)
+	if err != nil {
+		return err
+	}
+
+	outcome.PostUID, err = uuid.Parse(postUID)
+	if err != nil {
+		return err
+	}
+
+	outcome.CommentUID, err = uuid.Parse(commentUID)
+	if err != nil {
+		return err
+	}
+
+	var banSeconds interface{}
+	if outcome.BanDuration != 0 {
+		banSeconds = int64(outcome.BanDuration / time.Second)
+	}
+
+	var justification interface{}
+	if outcome.Justification != "" {
+		justification = outcome.Justification
+	}
+
+	query = `INSERT INTO report_outcomes (` + reportOutcomeColumns + `)
+	         VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+	_, err = tx.Exec(query, outcome.ReportUID.String(), categoryUID, postUID, commentUID, reasonCode,
+		outcome.ModeratorUID.String(), string(outcome.Outcome), banSeconds, justification, outcome.ReportedAt, outcome.ResolvedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if review, keepHidden := autoActionReview(outcome); review {
+		outcome.AutoAction, err = reviewAutoActions(tx, "post_uid=$4", postUID, outcome.ModeratorUID, keepHidden)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+	}
+
+	err = recordHandledReport(tx, outcome.ReportUID, outcome.CategoryUID, outcome.ModeratorUID, outcome.ReportedAt)
+	if err != nil {
+		return err
+	}
+
+	if err := archiveReportNotes(tx, outcome.ReportUID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM reports WHERE uid=$1", outcome.ReportUID.String()); err != nil {
+		return err
+	}
+
+	err = emitEvent(tx, EventReportResolved, reportResolvedEvent{
+		ReportUID:   outcome.ReportUID.String(),
+		CategoryUID: categoryUID,
+		PostUID:     postUID,
+		CommentUID:  commentUID,
+		Outcome:     outcome.Outcome,
+		ResolvedAt:  outcome.ResolvedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := emitReportDeleted(tx, outcome.ReportUID.String(), categoryUID, Routing(routing)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (db *db) getReportOutcomes(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*ReportOutcome, error) {
+	query := `SELECT ` + reportOutcomeColumns + ` FROM report_outcomes
+	          WHERE category_uid=$1
+	          ORDER BY resolved_at DESC LIMIT $2 OFFSET $3`
+	lastRecord := pageNumber * pageSize
+	rows, err := db.Query(query, categoryUID.String(), pageSize, lastRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*ReportOutcome, 0)
+	for rows.Next() {
+		outcome, err := scanReportOutcome(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, outcome)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,173 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+// autoHiddenReportUID is a report of post hidden by threshold policy
+var autoHiddenReportUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000083"))
+
+func (mdb *mockdb) resolveReport(outcome *ReportOutcome) error {
+	if outcome.ReportUID == missingReportUID {
+		return errNotFound
+	}
+
+	outcome.CategoryUID = restrictedUID
+	outcome.PostUID = uuid.New()
+	outcome.ReasonCode = ReasonSpam
+	outcome.ReportedAt = time.Now().Add(-time.Hour)
+	outcome.ResolvedAt = time.Now()
+	if review, keepHidden := autoActionReview(outcome); review && outcome.ReportUID == autoHiddenReportUID {
+		outcome.AutoAction = &AutoAction{
+			UID: autoActionUID, CategoryUID: outcome.CategoryUID, PostUID: outcome.PostUID, ReportCount: 5,
+			CreatedAt: outcome.ReportedAt, ReviewedAt: outcome.ResolvedAt, ReviewerUID: outcome.ModeratorUID, KeptHidden: keepHidden,
+		}
+	}
+
+	return nil
+}
+
+func (mdb *mockdb) getReportOutcomes(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*ReportOutcome, error) {
+	now := time.Now()
+	return []*ReportOutcome{
+		{
+			ReportUID: uuid.New(), CategoryUID: categoryUID, PostUID: uuid.New(), ReasonCode: ReasonHarassment,
+			ModeratorUID: moderatorUID, Outcome: OutcomeUserBanned, BanDuration: 24 * time.Hour, Justification: "repeated insults",
+			ReportedAt: now.Add(-time.Hour), ResolvedAt: now,
+		},
+		{
+			ReportUID: uuid.New(), CategoryUID: categoryUID, PostUID: uuid.New(), ReasonCode: ReasonOther,
+			ModeratorUID: moderatorUID, Outcome: OutcomeNoViolation, ReportedAt: now.Add(-2 * time.Hour), ResolvedAt: now.Add(-time.Hour),
+		},
+	}, nil
+}
+
+func TestResolveReport(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ResolveReportRequest{
+		Uid: heldReportUID.String(), ModeratorUid: moderatorUID.String(), Outcome: pb.Outcome_USER_BANNED,
+		BanDuration: ptypes.DurationProto(24 * time.Hour), Justification: "repeated spam",
+	}
+	res, err := s.ResolveReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Outcome != pb.Outcome_USER_BANNED || res.BanDuration == nil || res.ReasonCode != pb.ReasonCode_SPAM {
+		t.Errorf("unexpected outcome %v", res)
+	}
+
+	req.Outcome = pb.Outcome_NO_VIOLATION
+	req.BanDuration = nil
+	req.Justification = ""
+	res, err = s.ResolveReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.BanDuration != nil || res.Justification != "" {
+		t.Errorf("unexpected outcome %v", res)
+	}
+
+	req.ModeratorUid = memberUID.String()
+	_, err = s.ResolveReport(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Uid = missingReportUID.String()
+	_, err = s.ResolveReport(context.Background(), req)
+	if err != statusReportNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestResolveAutoHiddenReport(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ResolveReportRequest{Uid: autoHiddenReportUID.String(), ModeratorUid: moderatorUID.String(), Outcome: pb.Outcome_NO_VIOLATION}
+	res, err := s.ResolveReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.AutoAction == nil || res.AutoAction.KeptHidden || res.AutoAction.ReviewerUid != moderatorUID.String() {
+		t.Errorf("unexpected auto action %v", res.AutoAction)
+	}
+
+	req.Outcome = pb.Outcome_CONTENT_REMOVED
+	res, err = s.ResolveReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.AutoAction == nil || !res.AutoAction.KeptHidden {
+		t.Errorf("unexpected auto action %v", res.AutoAction)
+	}
+
+	req.Outcome = pb.Outcome_ESCALATED
+	res, err = s.ResolveReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.AutoAction != nil {
+		t.Errorf("unexpected auto action %v", res.AutoAction)
+	}
+}
+
+func TestAutoActionReview(t *testing.T) {
+	tests := []struct {
+		outcome    Outcome
+		commentUID uuid.UUID
+		review     bool
+		keepHidden bool
+	}{
+		{OutcomeNoViolation, uuid.Nil, true, false},
+		{OutcomeContentRemoved, uuid.Nil, true, true},
+		{OutcomeUserWarned, uuid.Nil, false, false},
+		{OutcomeEscalated, uuid.Nil, false, false},
+		{OutcomeNoViolation, rootUID, false, false},
+	}
+
+	for _, tt := range tests {
+		review, keepHidden := autoActionReview(&ReportOutcome{Outcome: tt.outcome, CommentUID: tt.commentUID})
+		if review != tt.review || keepHidden != tt.keepHidden {
+			t.Errorf("outcome %s of comment %s: unexpected review %v, keep hidden %v", tt.outcome, tt.commentUID, review, keepHidden)
+		}
+	}
+}
+
+func TestResolveReportFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ResolveReportRequest{
+		Uid: "not uuid", ModeratorUid: "", Outcome: pb.Outcome(100), BanDuration: ptypes.DurationProto(time.Hour),
+	}
+	_, err := s.ResolveReport(context.Background(), req)
+	if !hasViolations(err, "uid", "moderatorUid", "outcome", "banDuration") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req = &pb.ResolveReportRequest{
+		Uid: heldReportUID.String(), ModeratorUid: moderatorUID.String(), Outcome: pb.Outcome_USER_BANNED,
+		BanDuration: ptypes.DurationProto(-time.Hour),
+	}
+	_, err = s.ResolveReport(context.Background(), req)
+	if !hasViolations(err, "banDuration") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListReportOutcomes(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListReportOutcomesRequest{CategoryUid: restrictedUID.String(), UserUid: ownerUID.String()}
+	res, err := s.ListReportOutcomes(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Outcomes) != 2 || res.Outcomes[0].Outcome != pb.Outcome_USER_BANNED || res.Outcomes[1].BanDuration != nil {
+		t.Errorf("unexpected outcomes %v", res.Outcomes)
+	} else if res.PageSize != 10 {
+		t.Errorf("unexpected page size %v", res.PageSize)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.ListReportOutcomes(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,242 @@
+package category
+
+import (
+	"expvar"
+	"log"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	minRetentionAge = 24 * time.Hour
+	maxRetentionAge = 10 * 365 * 24 * time.Hour
+	// retentionInterval is the time between retention runs
+	retentionInterval = time.Hour
+	// retentionBatchSize is the number of reports handled by one transaction of retention run
+	retentionBatchSize = 500
+)
+
+var statusRetentionPolicyNotFound = status.Error(codes.NotFound, "retention policy not found")
+
+// retentionMetrics counts rows handled by retention runs, they are published with other expvar variables
+var retentionMetrics = expvar.NewMap("report_retention")
+
+var retentionActions = map[pb.RetentionAction]RetentionAction{
+	pb.RetentionAction_PURGE:     RetentionPurge,
+	pb.RetentionAction_ANONYMIZE: RetentionAnonymize,
+	pb.RetentionAction_KEEP:      RetentionKeep,
+}
+
+var retentionActionsProto = map[RetentionAction]pb.RetentionAction{
+	RetentionPurge:     pb.RetentionAction_PURGE,
+	RetentionAnonymize: pb.RetentionAction_ANONYMIZE,
+	RetentionKeep:      pb.RetentionAction_KEEP,
+}
+
+// SingleRetentionPolicy converts RetentionPolicy to SingleRetentionPolicy
+func (p *RetentionPolicy) SingleRetentionPolicy() (*pb.SingleRetentionPolicy, error) {
+	updatedAtProto, err := ptypes.TimestampProto(p.UpdatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleRetentionPolicy)
+	if p.CategoryUID != uuid.Nil {
+		res.CategoryUid = p.CategoryUID.String()
+	}
+
+	res.Action = retentionActionsProto[p.Action]
+	if p.Action != RetentionKeep {
+		res.MaxAge = ptypes.DurationProto(p.MaxAge)
+	}
+
+	res.UpdatedAt = updatedAtProto
+
+	return res, nil
+}
+
+// SingleRetentionPreview converts RetentionPreview to SingleRetentionPreview
+func (p *RetentionPreview) SingleRetentionPreview() (*pb.SingleRetentionPreview, error) {
+	oldestCreatedAtProto, err := ptypes.TimestampProto(p.OldestCreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleRetentionPreview)
+	res.CategoryUid = p.CategoryUID.String()
+	res.Action = retentionActionsProto[p.Action]
+	res.ReportCount = p.ReportCount
+	res.OldestCreatedAt = oldestCreatedAtProto
+
+	return res, nil
+}
+
+// runRetention applies retention policies every retentionInterval, failed runs are retried by the next one
+func (s *Server) runRetention() {
+	ticker := time.NewTicker(retentionInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.applyRetention(); err != nil {
+			retentionMetrics.Add("errors", 1)
+			log.Printf("retention run failed: %v", err)
+		}
+
+		<-ticker.C
+	}
+}
+
+// applyRetention purges and anonymizes reports due for it and purges archived notes which outlived their retention.
+// Rows are handled in batches of retentionBatchSize, so every transaction holds its locks briefly
+func (s *Server) applyRetention() error {
+	steps := []struct {
+		metric string
+		batch  func() (int64, error)
+	}{
+		{"reports_purged", func() (int64, error) { return s.db.applyRetention(RetentionPurge, retentionBatchSize) }},
+		{"reports_anonymized", func() (int64, error) { return s.db.applyRetention(RetentionAnonymize, retentionBatchSize) }},
+		{"archived_notes_purged", func() (int64, error) { return s.db.purgeArchivedReportNotes(retentionBatchSize) }},
+	}
+
+	for _, step := range steps {
+		total, err := inBatches(step.batch)
+		retentionMetrics.Add(step.metric, total)
+		if err != nil {
+			return err
+		}
+
+		if total > 0 {
+			log.Printf("retention: %s %d", step.metric, total)
+		}
+	}
+
+	retentionMetrics.Add("runs", 1)
+	return nil
+}
+
+// inBatches calls batch until it handles less than retentionBatchSize rows and returns the number of handled rows
+func inBatches(batch func() (int64, error)) (int64, error) {
+	var total int64
+	for {
+		n, err := batch()
+		total += n
+		if err != nil || n < retentionBatchSize {
+			return total, err
+		}
+	}
+}
+
+// SetRetentionPolicy sets retention policy of category or the default policy if category isn't given.
+// Max age is required unless reports are kept forever. Caller must be site admin, it is checked by gateway
+func (s *Server) SetRetentionPolicy(ctx context.Context, req *pb.SetRetentionPolicyRequest) (*pb.SingleRetentionPolicy, error) {
+	v := new(validator)
+	policy := new(RetentionPolicy)
+	policy.CategoryUID = v.optionalUUID("categoryUid", req.CategoryUid)
+	policy.Action = v.retentionAction("action", req.Action)
+	if policy.Action == RetentionKeep {
+		if req.MaxAge != nil {
+			v.addViolation("maxAge", "max age can't be given when reports are kept")
+		}
+	} else {
+		policy.MaxAge = v.duration("maxAge", req.MaxAge, maxRetentionAge)
+		if policy.MaxAge > 0 && policy.MaxAge < minRetentionAge {
+			v.addViolation("maxAge", "max age must be at least "+minRetentionAge.String())
+		}
+	}
+
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.setRetentionPolicy(policy); err {
+	case nil:
+		return policy.SingleRetentionPolicy()
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// DeleteRetentionPolicy deletes retention policy of category, so the default policy applies to it.
+// Without category the default policy is deleted. Caller must be site admin, it is checked by gateway
+func (s *Server) DeleteRetentionPolicy(ctx context.Context, req *pb.DeleteRetentionPolicyRequest) (*pb.DeleteRetentionPolicyResponse, error) {
+	v := new(validator)
+	categoryUID := v.optionalUUID("categoryUid", req.CategoryUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.deleteRetentionPolicy(categoryUID); err {
+	case nil:
+		return new(pb.DeleteRetentionPolicyResponse), nil
+	case errNotFound:
+		return nil, statusRetentionPolicyNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ListRetentionPolicies returns the default retention policy followed by policies of categories
+func (s *Server) ListRetentionPolicies(ctx context.Context, req *pb.ListRetentionPoliciesRequest) (*pb.ListRetentionPoliciesResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	policies, err := s.db.getRetentionPolicies(pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListRetentionPoliciesResponse)
+	for _, policy := range policies {
+		policyResponse, err := policy.SingleRetentionPolicy()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Policies = append(res.Policies, policyResponse)
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
+
+// PreviewRetention returns what the next retention run would purge or anonymize if it ran now, nothing is changed
+func (s *Server) PreviewRetention(ctx context.Context, req *pb.PreviewRetentionRequest) (*pb.PreviewRetentionResponse, error) {
+	previews, archivedNotes, err := s.db.previewRetention()
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.PreviewRetentionResponse)
+	for _, preview := range previews {
+		previewResponse, err := preview.SingleRetentionPreview()
+		if err != nil {
+			return nil, err
+		}
+
+		switch preview.Action {
+		case RetentionPurge:
+			res.PurgeCount += preview.ReportCount
+		case RetentionAnonymize:
+			res.AnonymizeCount += preview.ReportCount
+		}
+
+		res.Categories = append(res.Categories, previewResponse)
+	}
+
+	res.ArchivedNoteCount = archivedNotes
+
+	return res, nil
+}
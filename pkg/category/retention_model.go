@@ -0,0 +1,282 @@
+package category
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// RetentionAction is what happens to reports older than retention policy allows
+type RetentionAction string
+
+// Retention actions
+const (
+	// RetentionPurge deletes reports with their notes
+	RetentionPurge RetentionAction = "purge"
+	// RetentionAnonymize keeps reports for statistics but erases free-text reasons and notes
+	RetentionAnonymize RetentionAction = "anonymize"
+	// RetentionKeep keeps reports forever, it is used to exempt category from the default policy
+	RetentionKeep RetentionAction = "keep"
+)
+
+// RetentionPolicy applies Action to reports older than MaxAge. Policy without CategoryUID is the default one,
+// it applies to categories which don't have their own policy. MaxAge isn't set for RetentionKeep
+type RetentionPolicy struct {
+	CategoryUID uuid.UUID
+	Action      RetentionAction
+	MaxAge      time.Duration
+	UpdatedAt   time.Time
+}
+
+// RetentionPreview describes reports of category which the next retention run will purge or anonymize
+type RetentionPreview struct {
+	CategoryUID     uuid.UUID
+	Action          RetentionAction
+	ReportCount     int64
+	OldestCreatedAt time.Time
+}
+
+// retainedReports joins reports with policy of their category, default policy is used when category doesn't have one
+const retainedReports = `reports r
+	LEFT JOIN retention_policies p ON p.category_uid=r.category_uid
+	LEFT JOIN retention_defaults d ON TRUE`
+
+// retentionDue is condition matching retained reports older than their policy allows
+const retentionDue = `(COALESCE(p.action, d.action)='purge' OR COALESCE(p.action, d.action)='anonymize' AND r.anonymized_at IS NULL)
+	AND r.created_at < now() - COALESCE(p.age_seconds, d.age_seconds) * interval '1 second'`
+
+func scanRetentionPolicy(row scanner) (*RetentionPolicy, error) {
+	policy := new(RetentionPolicy)
+	var categoryUID sql.NullString
+	var action string
+	var ageSeconds sql.NullInt64
+	err := row.Scan(&categoryUID, &action, &ageSeconds, &policy.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.Action = RetentionAction(action)
+	policy.MaxAge = time.Duration(ageSeconds.Int64) * time.Second
+	if categoryUID.Valid {
+		policy.CategoryUID, err = uuid.Parse(categoryUID.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return policy, nil
+}
+
+// setRetentionPolicy creates or replaces policy of category or the default policy, its update time is set
+func (db *db) setRetentionPolicy(policy *RetentionPolicy) error {
+	policy.UpdatedAt = time.Now()
+
+	var ageSeconds interface{}
+	if policy.Action != RetentionKeep {
+		ageSeconds = int64(policy.MaxAge / time.Second)
+	}
+
+	var err error
+	if policy.CategoryUID == uuid.Nil {
+		query := `INSERT INTO retention_defaults (action, age_seconds, updated_at) VALUES ($1, $2, $3)
+		          ON CONFLICT (id) DO UPDATE
+		          SET action=EXCLUDED.action, age_seconds=EXCLUDED.age_seconds, updated_at=EXCLUDED.updated_at`
+		_, err = db.Exec(query, string(policy.Action), ageSeconds, policy.UpdatedAt)
+	} else {
+		query := `INSERT INTO retention_policies (category_uid, action, age_seconds, updated_at) VALUES ($1, $2, $3, $4)
+		          ON CONFLICT (category_uid) DO UPDATE
+		          SET action=EXCLUDED.action, age_seconds=EXCLUDED.age_seconds, updated_at=EXCLUDED.updated_at`
+		_, err = db.Exec(query, policy.CategoryUID.String(), string(policy.Action), ageSeconds, policy.UpdatedAt)
+	}
+
+	if isForeignKeyViolation(err) {
+		return errNotFound
+	}
+
+	return err
+}
+
+// deleteRetentionPolicy deletes policy of category, so the default policy applies to it.
+// Deleting the default policy keeps reports of categories without policy forever
+func (db *db) deleteRetentionPolicy(categoryUID uuid.UUID) error {
+	var result sql.Result
+	var err error
+	if categoryUID == uuid.Nil {
+		result, err = db.Exec("DELETE FROM retention_defaults")
+	} else {
+		result, err = db.Exec("DELETE FROM retention_policies WHERE category_uid=$1", categoryUID.String())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if nRows == 0 {
+		return errNotFound
+	}
+
+	return nil
+}
+
+// getRetentionPolicies returns the default policy if there is one followed by policies of categories
+func (db *db) getRetentionPolicies(pageSize, pageNumber int32) ([]*RetentionPolicy, error) {
+	query := `SELECT * FROM (
+	              SELECT NULL::uuid AS category_uid, action, age_seconds, updated_at FROM retention_defaults
+	              UNION ALL
+	              SELECT category_uid, action, age_seconds, updated_at FROM retention_policies
+	          ) p
+	          ORDER BY category_uid NULLS FIRST LIMIT $1 OFFSET $2`
+	lastRecord := pageNumber * pageSize
+	rows, err := db.Query(query, pageSize, lastRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*RetentionPolicy, 0)
+	for rows.Next() {
+		policy, err := scanRetentionPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, policy)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// applyRetention purges or anonymizes at most limit reports which are due for action and returns their number.
+// Reports locked by other transactions are skipped, so batches of concurrent runs don't wait for each other
+func (db *db) applyRetention(action RetentionAction, limit int32) (int64, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	defer tx.Rollback()
+
+	query := `SELECT r.uid, r.category_uid, r.routing FROM ` + retainedReports + `
+	          WHERE COALESCE(p.action, d.action)=$1 AND ` + retentionDue + `
+	          LIMIT $2 FOR UPDATE OF r SKIP LOCKED`
+	rows, err := tx.Query(query, string(action), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	defer rows.Close()
+	uids := make([]string, 0)
+	categoryUIDs := make([]string, 0)
+	routings := make([]Routing, 0)
+	for rows.Next() {
+		var uid, categoryUID, routing string
+		if err := rows.Scan(&uid, &categoryUID, &routing); err != nil {
+			return 0, err
+		}
+
+		uids = append(uids, uid)
+		categoryUIDs = append(categoryUIDs, categoryUID)
+		routings = append(routings, Routing(routing))
+	}
+
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	if len(uids) == 0 {
+		return 0, nil
+	}
+
+	switch action {
+	case RetentionPurge:
+		_, err = tx.Exec("DELETE FROM reports WHERE uid=ANY($1)", pq.Array(uids))
+		for i := 0; err == nil && i < len(uids); i++ {
+			err = emitReportDeleted(tx, uids[i], categoryUIDs[i], routings[i])
+		}
+	case RetentionAnonymize:
+		_, err = tx.Exec("DELETE FROM report_notes WHERE report_uid=ANY($1)", pq.Array(uids))
+		if err == nil {
+			query = "UPDATE reports SET reason='', note_count=0, anonymized_at=now() WHERE uid=ANY($1)"
+			_, err = tx.Exec(query, pq.Array(uids))
+		}
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return int64(len(uids)), nil
+}
+
+// purgeArchivedReportNotes deletes at most limit archived notes which outlived their retention and returns their number
+func (db *db) purgeArchivedReportNotes(limit int32) (int64, error) {
+	query := `DELETE FROM archived_report_notes WHERE uid IN (
+	              SELECT uid FROM archived_report_notes WHERE purge_after < now() LIMIT $1 FOR UPDATE SKIP LOCKED
+	          )`
+	result, err := db.Exec(query, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// previewRetention returns reports which the next retention run will handle grouped by category and action,
+// and the number of archived notes it will purge
+func (db *db) previewRetention() ([]*RetentionPreview, int64, error) {
+	query := `SELECT r.category_uid, COALESCE(p.action, d.action), count(*), min(r.created_at)
+	          FROM ` + retainedReports + `
+	          WHERE ` + retentionDue + `
+	          GROUP BY r.category_uid, COALESCE(p.action, d.action)
+	          ORDER BY count(*) DESC, r.category_uid`
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+	result := make([]*RetentionPreview, 0)
+	for rows.Next() {
+		preview := new(RetentionPreview)
+		var categoryUID, action string
+		err := rows.Scan(&categoryUID, &action, &preview.ReportCount, &preview.OldestCreatedAt)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		preview.Action = RetentionAction(action)
+		preview.CategoryUID, err = uuid.Parse(categoryUID)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		result = append(result, preview)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	var archivedNotes int64
+	query = "SELECT count(*) FROM archived_report_notes WHERE purge_after < now()"
+	if err := db.QueryRow(query).Scan(&archivedNotes); err != nil {
+		return nil, 0, err
+	}
+
+	return result, archivedNotes, nil
+}
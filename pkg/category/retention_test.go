@@ -0,0 +1,185 @@
+package category
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+func (mdb *mockdb) setRetentionPolicy(policy *RetentionPolicy) error {
+	if policy.CategoryUID == missingReportUID {
+		return errNotFound
+	}
+
+	policy.UpdatedAt = time.Now()
+	return nil
+}
+
+func (mdb *mockdb) deleteRetentionPolicy(categoryUID uuid.UUID) error {
+	if categoryUID != restrictedUID {
+		return errNotFound
+	}
+
+	return nil
+}
+
+func (mdb *mockdb) getRetentionPolicies(pageSize, pageNumber int32) ([]*RetentionPolicy, error) {
+	return []*RetentionPolicy{
+		{Action: RetentionPurge, MaxAge: 365 * 24 * time.Hour, UpdatedAt: time.Now()},
+		{CategoryUID: restrictedUID, Action: RetentionKeep, UpdatedAt: time.Now()},
+	}, nil
+}
+
+func (mdb *mockdb) applyRetention(action RetentionAction, limit int32) (int64, error) {
+	if action == RetentionPurge {
+		return 3, nil
+	}
+
+	return 1, nil
+}
+
+func (mdb *mockdb) purgeArchivedReportNotes(limit int32) (int64, error) {
+	return 0, nil
+}
+
+func (mdb *mockdb) previewRetention() ([]*RetentionPreview, int64, error) {
+	oldest := time.Now().Add(-400 * 24 * time.Hour)
+	return []*RetentionPreview{
+		{CategoryUID: rootUID, Action: RetentionPurge, ReportCount: 10, OldestCreatedAt: oldest},
+		{CategoryUID: childUID, Action: RetentionAnonymize, ReportCount: 4, OldestCreatedAt: oldest},
+		{CategoryUID: privateUID, Action: RetentionPurge, ReportCount: 2, OldestCreatedAt: oldest},
+	}, 7, nil
+}
+
+func retentionMetric(name string) int64 {
+	value := retentionMetrics.Get(name)
+	if value == nil {
+		return 0
+	}
+
+	n, _ := strconv.ParseInt(value.String(), 10, 64)
+	return n
+}
+
+func TestApplyRetention(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	purged := retentionMetric("reports_purged")
+	anonymized := retentionMetric("reports_anonymized")
+	if err := s.applyRetention(); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if n := retentionMetric("reports_purged") - purged; n != 3 {
+		t.Errorf("expected 3 purged reports, got %d", n)
+	}
+
+	if n := retentionMetric("reports_anonymized") - anonymized; n != 1 {
+		t.Errorf("expected 1 anonymized report, got %d", n)
+	}
+}
+
+func TestInBatches(t *testing.T) {
+	batches := []int64{retentionBatchSize, retentionBatchSize, 7, 100}
+	calls := 0
+	total, err := inBatches(func() (int64, error) {
+		n := batches[calls]
+		calls++
+		return n, nil
+	})
+	if err != nil || total != 2*retentionBatchSize+7 || calls != 3 {
+		t.Errorf("unexpected result %d, %v after %d batches", total, err, calls)
+	}
+
+	calls = 0
+	total, err = inBatches(func() (int64, error) {
+		calls++
+		return retentionBatchSize, errDummy
+	})
+	if err != errDummy || total != retentionBatchSize || calls != 1 {
+		t.Errorf("unexpected result %d, %v after %d batches", total, err, calls)
+	}
+}
+
+func TestSetRetentionPolicy(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.SetRetentionPolicyRequest{Action: pb.RetentionAction_ANONYMIZE, MaxAge: ptypes.DurationProto(90 * 24 * time.Hour)}
+	res, err := s.SetRetentionPolicy(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.CategoryUid != "" || res.MaxAge == nil {
+		t.Errorf("unexpected policy %v", res)
+	}
+
+	req = &pb.SetRetentionPolicyRequest{CategoryUid: restrictedUID.String(), Action: pb.RetentionAction_KEEP}
+	res, err = s.SetRetentionPolicy(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.CategoryUid != restrictedUID.String() || res.MaxAge != nil {
+		t.Errorf("unexpected policy %v", res)
+	}
+
+	req.CategoryUid = missingReportUID.String()
+	_, err = s.SetRetentionPolicy(context.Background(), req)
+	if err != statusCategoryNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestSetRetentionPolicyFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.SetRetentionPolicyRequest{CategoryUid: "not uuid", Action: pb.RetentionAction(100), MaxAge: ptypes.DurationProto(time.Hour)}
+	_, err := s.SetRetentionPolicy(context.Background(), req)
+	if !hasViolations(err, "categoryUid", "action", "maxAge") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req = &pb.SetRetentionPolicyRequest{Action: pb.RetentionAction_PURGE}
+	_, err = s.SetRetentionPolicy(context.Background(), req)
+	if !hasViolations(err, "maxAge") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req = &pb.SetRetentionPolicyRequest{Action: pb.RetentionAction_KEEP, MaxAge: ptypes.DurationProto(30 * 24 * time.Hour)}
+	_, err = s.SetRetentionPolicy(context.Background(), req)
+	if !hasViolations(err, "maxAge") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestDeleteRetentionPolicy(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	_, err := s.DeleteRetentionPolicy(context.Background(), &pb.DeleteRetentionPolicyRequest{CategoryUid: restrictedUID.String()})
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	_, err = s.DeleteRetentionPolicy(context.Background(), &pb.DeleteRetentionPolicyRequest{})
+	if err != statusRetentionPolicyNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListRetentionPolicies(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	res, err := s.ListRetentionPolicies(context.Background(), &pb.ListRetentionPoliciesRequest{})
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Policies) != 2 || res.Policies[0].CategoryUid != "" || res.Policies[1].Action != pb.RetentionAction_KEEP {
+		t.Errorf("unexpected policies %v", res.Policies)
+	}
+}
+
+func TestPreviewRetention(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	res, err := s.PreviewRetention(context.Background(), &pb.PreviewRetentionRequest{})
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Categories) != 3 || res.PurgeCount != 12 || res.AnonymizeCount != 4 || res.ArchivedNoteCount != 7 {
+		t.Errorf("unexpected preview %v", res)
+	}
+}
@@ -0,0 +1,176 @@
+package category
+
+import (
+	"fmt"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	statusRuleNotFound  = status.Error(codes.NotFound, "rule not found")
+	statusTooManyRules  = status.Error(codes.FailedPrecondition, fmt.Sprintf("category can't have more than %d rules", maxCategoryRules))
+	statusRulesMismatch = status.Error(codes.InvalidArgument, "ruleUids must list every rule of category exactly once")
+)
+
+// SingleRule converts Rule to SingleRule
+func (r *Rule) SingleRule() (*pb.SingleRule, error) {
+	createdAtProto, err := ptypes.TimestampProto(r.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleRule)
+	res.Uid = r.UID.String()
+	res.CategoryUid = r.CategoryUID.String()
+	res.Position = r.Position
+	res.Title = r.Title
+	res.Description = r.Description
+	res.CreatedAt = createdAtProto
+
+	return res, nil
+}
+
+// RuleReportCount converts RuleReportCount to its protobuf form
+func (c *RuleReportCount) RuleReportCount() *pb.RuleReportCount {
+	res := new(pb.RuleReportCount)
+	if c.RuleUID != uuid.Nil {
+		res.RuleUid = c.RuleUID.String()
+	}
+
+	res.Title = c.Title
+	res.Count = c.Count
+
+	return res
+}
+
+func rulesResponse(rules []*Rule) (*pb.ListRulesResponse, error) {
+	res := new(pb.ListRulesResponse)
+	for _, rule := range rules {
+		ruleResponse, err := rule.SingleRule()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Rules = append(res.Rules, ruleResponse)
+	}
+
+	return res, nil
+}
+
+// CreateRule adds rule to the end of rules of category, only owner can do it
+func (s *Server) CreateRule(ctx context.Context, req *pb.CreateRuleRequest) (*pb.SingleRule, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	title := v.text("title", req.Title, ruleTitleRules)
+	description := v.text("description", req.Description, ruleDescriptionRules)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	rule, err := s.db.createRule(categoryUID, title, description)
+	switch err {
+	case nil:
+		return rule.SingleRule()
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	case errTooManyRules:
+		return nil, statusTooManyRules
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// UpdateRule changes title and description of rule, only owner of its category can do it
+func (s *Server) UpdateRule(ctx context.Context, req *pb.UpdateRuleRequest) (*pb.SingleRule, error) {
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	userUID := v.uuid("userUid", req.UserUid)
+	title := v.text("title", req.Title, ruleTitleRules)
+	description := v.text("description", req.Description, ruleDescriptionRules)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	rule, err := s.db.getRule(uid)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusRuleNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	if _, err := s.getOwnedCategory(rule.CategoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	rule, err = s.db.updateRule(uid, title, description)
+	switch err {
+	case nil:
+		return rule.SingleRule()
+	case errNotFound:
+		return nil, statusRuleNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ReorderRules sets order of rules of category, only owner can do it
+func (s *Server) ReorderRules(ctx context.Context, req *pb.ReorderRulesRequest) (*pb.ListRulesResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	ruleUIDs := make([]uuid.UUID, len(req.RuleUids))
+	for i, ruleUID := range req.RuleUids {
+		ruleUIDs[i] = v.uuid(fmt.Sprintf("ruleUids[%d]", i), ruleUID)
+	}
+
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.db.reorderRules(categoryUID, ruleUIDs)
+	switch err {
+	case nil:
+		return rulesResponse(rules)
+	case errRulesMismatch:
+		return nil, statusRulesMismatch
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ListRules returns rules of category in order, rules of private category are listed only to its members
+func (s *Server) ListRules(ctx context.Context, req *pb.ListRulesRequest) (*pb.ListRulesResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	viewerUID := v.optionalUUID("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getVisibleCategory(categoryUID, viewerUID); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.db.getRules(categoryUID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return rulesResponse(rules)
+}
@@ -0,0 +1,219 @@
+package category
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxCategoryRules is the maximum number of rules in category
+const maxCategoryRules = 15
+
+var (
+	errTooManyRules  = errors.New("category has too many rules")
+	errRulesMismatch = errors.New("rules don't match rules of category")
+)
+
+// Rule describes rule of category which reports can cite. Rules are ordered by position starting from 1
+type Rule struct {
+	UID         uuid.UUID
+	CategoryUID uuid.UUID
+	Position    int32
+	Title       string
+	Description string
+	CreatedAt   time.Time
+}
+
+// RuleReportCount is the number of reports citing rule, reports which cite no rule have uuid.Nil rule
+type RuleReportCount struct {
+	RuleUID uuid.UUID
+	Title   string
+	Count   int64
+}
+
+const ruleColumns = "uid, category_uid, position, title, description, created_at"
+
+func scanRule(row scanner) (*Rule, error) {
+	rule := new(Rule)
+	var uid, categoryUID string
+	err := row.Scan(&uid, &categoryUID, &rule.Position, &rule.Title, &rule.Description, &rule.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.UID, err = uuid.Parse(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.CategoryUID, err = uuid.Parse(categoryUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// lockCategory serializes changes of category settings which are checked against each other, like rule positions
+func lockCategory(tx *sql.Tx, categoryUID uuid.UUID) error {
+	_, err := tx.Exec("SELECT uid FROM categories WHERE uid=$1 FOR UPDATE", categoryUID.String())
+	return err
+}
+
+// createRule appends rule to the end of rules of category
+func (db *db) createRule(categoryUID uuid.UUID, title, description string) (*Rule, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	if err := lockCategory(tx, categoryUID); err != nil {
+		return nil, err
+	}
+
+	var count int32
+	err = tx.QueryRow("SELECT count(*) FROM category_rules WHERE category_uid=$1", categoryUID.String()).Scan(&count)
+	if err != nil {
+		return nil, err
+	}
+
+	if count >= maxCategoryRules {
+		return nil, errTooManyRules
+	}
+
+	rule := new(Rule)
+	rule.UID = uuid.New()
+	rule.CategoryUID = categoryUID
+	rule.Position = count + 1
+	rule.Title = title
+	rule.Description = description
+	rule.CreatedAt = time.Now()
+
+	query := `INSERT INTO category_rules (uid, category_uid, position, title, description, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err = tx.Exec(query, rule.UID.String(), categoryUID.String(), rule.Position, title, description, rule.CreatedAt)
+	if isForeignKeyViolation(err) {
+		return nil, errNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, tx.Commit()
+}
+
+func (db *db) updateRule(uid uuid.UUID, title, description string) (*Rule, error) {
+	query := "UPDATE category_rules SET title=$1, description=$2 WHERE uid=$3 RETURNING " + ruleColumns
+	result, err := scanRule(db.QueryRow(query, title, description, uid.String()))
+	switch err {
+	case nil:
+		return result, nil
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (db *db) getRule(uid uuid.UUID) (*Rule, error) {
+	query := "SELECT " + ruleColumns + " FROM category_rules WHERE uid=$1"
+	result, err := scanRule(db.QueryRow(query, uid.String()))
+	switch err {
+	case nil:
+		return result, nil
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (db *db) getRules(categoryUID uuid.UUID) ([]*Rule, error) {
+	return getRules(db, categoryUID)
+}
+
+func getRules(q queryer, categoryUID uuid.UUID) ([]*Rule, error) {
+	query := "SELECT " + ruleColumns + " FROM category_rules WHERE category_uid=$1 ORDER BY position"
+	rows, err := q.Query(query, categoryUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*Rule, 0)
+	for rows.Next() {
+		rule, err := scanRule(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, rule)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// reorderRules sets positions of rules of category to their order in ruleUIDs, which must list every rule of category once
+func (db *db) reorderRules(categoryUID uuid.UUID, ruleUIDs []uuid.UUID) ([]*Rule, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	if err := lockCategory(tx, categoryUID); err != nil {
+		return nil, err
+	}
+
+	rules, err := getRules(tx, categoryUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rules) != len(ruleUIDs) {
+		return nil, errRulesMismatch
+	}
+
+	byUID := make(map[uuid.UUID]*Rule, len(rules))
+	for _, rule := range rules {
+		byUID[rule.UID] = rule
+	}
+
+	result := make([]*Rule, len(ruleUIDs))
+	for i, uid := range ruleUIDs {
+		rule, ok := byUID[uid]
+		if !ok {
+			return nil, errRulesMismatch
+		}
+
+		// every rule is listed once
+		delete(byUID, uid)
+		rule.Position = int32(i + 1)
+		result[i] = rule
+	}
+
+	// positions are unique, so rules are moved out of the way first
+	_, err = tx.Exec("UPDATE category_rules SET position=-position WHERE category_uid=$1", categoryUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range result {
+		_, err := tx.Exec("UPDATE category_rules SET position=$1 WHERE uid=$2", rule.Position, rule.UID.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, tx.Commit()
+}
@@ -0,0 +1,221 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+var (
+	// ruleUID is the first rule of restricted category
+	ruleUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000060"))
+	// secondRuleUID is the second rule of restricted category
+	secondRuleUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000061"))
+	// foreignRuleUID is a rule of private category
+	foreignRuleUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000062"))
+)
+
+func (mdb *mockdb) createRule(categoryUID uuid.UUID, title, description string) (*Rule, error) {
+	if title == "overflow" {
+		return nil, errTooManyRules
+	}
+
+	return &Rule{UID: uuid.New(), CategoryUID: categoryUID, Position: 3, Title: title, Description: description, CreatedAt: time.Now()}, nil
+}
+
+func (mdb *mockdb) updateRule(uid uuid.UUID, title, description string) (*Rule, error) {
+	rule, err := mdb.getRule(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Title = title
+	rule.Description = description
+	return rule, nil
+}
+
+func (mdb *mockdb) getRule(uid uuid.UUID) (*Rule, error) {
+	switch uid {
+	case ruleUID:
+		return &Rule{UID: uid, CategoryUID: restrictedUID, Position: 1, Title: "No spam", Description: "aaa", CreatedAt: time.Now()}, nil
+	case secondRuleUID:
+		return &Rule{UID: uid, CategoryUID: restrictedUID, Position: 2, Title: "Be nice", Description: "aaa", CreatedAt: time.Now()}, nil
+	case foreignRuleUID:
+		return &Rule{UID: uid, CategoryUID: privateUID, Position: 1, Title: "No spam", Description: "aaa", CreatedAt: time.Now()}, nil
+	}
+
+	return nil, errNotFound
+}
+
+func (mdb *mockdb) getRules(categoryUID uuid.UUID) ([]*Rule, error) {
+	result := make([]*Rule, 0)
+	for _, uid := range []uuid.UUID{ruleUID, secondRuleUID, foreignRuleUID} {
+		rule, _ := mdb.getRule(uid)
+		if rule.CategoryUID == categoryUID {
+			result = append(result, rule)
+		}
+	}
+
+	return result, nil
+}
+
+func (mdb *mockdb) reorderRules(categoryUID uuid.UUID, ruleUIDs []uuid.UUID) ([]*Rule, error) {
+	rules, _ := mdb.getRules(categoryUID)
+	if len(rules) != len(ruleUIDs) {
+		return nil, errRulesMismatch
+	}
+
+	result := make([]*Rule, len(ruleUIDs))
+	for i, uid := range ruleUIDs {
+		rule, err := mdb.getRule(uid)
+		if err != nil || rule.CategoryUID != categoryUID {
+			return nil, errRulesMismatch
+		}
+
+		rule.Position = int32(i + 1)
+		result[i] = rule
+	}
+
+	return result, nil
+}
+
+func (mdb *mockdb) countReportsByRule(filter *reportFilter) ([]*RuleReportCount, error) {
+	return []*RuleReportCount{{RuleUID: ruleUID, Title: "No spam", Count: 2}, {Count: 1}}, nil
+}
+
+func TestCreateRule(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.CreateRuleRequest{CategoryUid: restrictedUID.String(), UserUid: ownerUID.String(), Title: "  No   memes ", Description: "aaa"}
+	res, err := s.CreateRule(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Title != "No memes" {
+		t.Errorf("unexpected rule %v", res)
+	}
+
+	req.Title = "overflow"
+	_, err = s.CreateRule(context.Background(), req)
+	if err != statusTooManyRules {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.CreateRule(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestCreateRuleFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.CreateRuleRequest{CategoryUid: restrictedUID.String(), UserUid: ownerUID.String(), Title: "a\nb"}
+	_, err := s.CreateRule(context.Background(), req)
+	if !hasViolations(err, "description") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestUpdateRule(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.UpdateRuleRequest{Uid: ruleUID.String(), UserUid: ownerUID.String(), Title: "No ads", Description: "aaa"}
+	res, err := s.UpdateRule(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Title != "No ads" || res.Position != 1 {
+		t.Errorf("unexpected rule %v", res)
+	}
+
+	req.Uid = uuid.New().String()
+	_, err = s.UpdateRule(context.Background(), req)
+	if err != statusRuleNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Uid = ruleUID.String()
+	req.UserUid = memberUID.String()
+	_, err = s.UpdateRule(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestReorderRules(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ReorderRulesRequest{
+		CategoryUid: restrictedUID.String(), UserUid: ownerUID.String(), RuleUids: []string{secondRuleUID.String(), ruleUID.String()},
+	}
+	res, err := s.ReorderRules(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Rules) != 2 || res.Rules[0].Uid != secondRuleUID.String() || res.Rules[0].Position != 1 {
+		t.Errorf("unexpected rules %v", res.Rules)
+	}
+
+	req.RuleUids = []string{secondRuleUID.String(), foreignRuleUID.String()}
+	_, err = s.ReorderRules(context.Background(), req)
+	if err != statusRulesMismatch {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.RuleUids = []string{secondRuleUID.String(), "nay"}
+	_, err = s.ReorderRules(context.Background(), req)
+	if !hasViolations(err, "ruleUids[1]") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListRules(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListRulesRequest{CategoryUid: restrictedUID.String()}
+	res, err := s.ListRules(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Rules) != 2 {
+		t.Errorf("unexpected rules %v", res.Rules)
+	}
+
+	req = &pb.ListRulesRequest{CategoryUid: privateUID.String(), UserUid: subscriberUID.String()}
+	_, err = s.ListRules(context.Background(), req)
+	if err != statusCategoryNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestCreateReportWithRule(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.CreateReportRequest{
+		Reason: "success", CategoryUid: restrictedUID.String(), PostUid: nilUIDString, CommentUid: nilUIDString, RuleUid: ruleUID.String(),
+	}
+	res, err := s.CreateReport(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.RuleUid != ruleUID.String() {
+		t.Errorf("unexpected report %v", res)
+	}
+
+	req.RuleUid = foreignRuleUID.String()
+	_, err = s.CreateReport(context.Background(), req)
+	if err != statusRuleNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListReportsByRule(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListReportsRequest{CategoryUid: restrictedUID.String(), RuleUid: ruleUID.String(), GroupByRule: true}
+	res, err := s.ListReports(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.RuleCounts) != 2 || res.RuleCounts[0].RuleUid != ruleUID.String() || res.RuleCounts[1].RuleUid != "" {
+		t.Errorf("unexpected rule counts %v", res.RuleCounts)
+	}
+
+	req.RuleUid = "nay"
+	_, err = s.ListReports(context.Background(), req)
+	if !hasViolations(err, "ruleUid") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -13,21 +13,39 @@ import (
 
 // Server implements category service
 type Server struct {
-	db datastore
+	db            datastore
+	reservedNames reservedNames
+	events        *eventHub
 }
 
-// NewServer returns a new server
-func NewServer(connString string) (*Server, error) {
+// NewServer returns a new server which doesn't allow to create categories with reservedNames.
+// Default list of reserved names is used if reservedNames is empty
+func NewServer(connString string, reservedNames []string) (*Server, error) {
 	db, err := newDB(connString)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Server{db}, nil
+	if len(reservedNames) == 0 {
+		reservedNames = defaultReservedNames
+	}
+
+	events := newEventHub()
+	if err := events.listen(connString, db.sequenceEvents); err != nil {
+		return nil, err
+	}
+
+	return &Server{db, newReservedNames(reservedNames), events}, nil
 }
 
 // Start starts a server
 func (s *Server) Start(port int, tracer opentracing.Tracer) error {
+	if err := s.backfillSlugs(); err != nil {
+		return err
+	}
+
+	go s.runRetention()
+
 	creds, err := credentials.NewServerTLSFromFile("/cert.pem", "/key.pem")
 	if err != nil {
 		return err
@@ -36,6 +54,7 @@ func (s *Server) Start(port int, tracer opentracing.Tracer) error {
 	server := grpc.NewServer(
 		grpc.Creds(creds),
 		grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(tracer)),
+		grpc.StreamInterceptor(otgrpc.OpenTracingStreamServerInterceptor(tracer)),
 	)
 	pb.RegisterCategoryServer(server, s)
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
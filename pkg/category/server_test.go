@@ -2,13 +2,18 @@ package category
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/google/uuid"
 	_ "github.com/lib/pq"
 	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -18,55 +23,135 @@ var (
 
 type mockdb struct{}
 
-func (mdb *mockdb) getAllCategories(pageSize, pageNumber int32) ([]*Category, error) {
+func (mdb *mockdb) getAllCategories(viewerUID uuid.UUID, pageSize, pageNumber int32) ([]*Category, error) {
 	result := make([]*Category, 0)
 	uid1 := uuid.New()
 	uid2 := uuid.New()
 	uid3 := uuid.New()
 
-	result = append(result, &Category{uid1, uid2, "aaa", "aaa"})
-	result = append(result, &Category{uid2, uid3, "bbb", "bbb"})
-	result = append(result, &Category{uid3, uid1, "ccc", "ccc"})
+	result = append(result, &Category{UID: uid1, UserUID: uid2, Name: "aaa", Description: "aaa"})
+	result = append(result, &Category{UID: uid2, UserUID: uid3, Name: "bbb", Description: "bbb"})
+	result = append(result, &Category{UID: uid3, UserUID: uid1, Name: "ccc", Description: "ccc"})
 	return result, nil
 }
 
 func (mdb *mockdb) getCategoryInfo(uid uuid.UUID) (*Category, error) {
-	return &Category{uuid.Nil, uuid.Nil, "aaa", "aaa"}, nil
+	switch uid {
+	case privateUID:
+		return &Category{UID: uid, UserUID: ownerUID, Name: "private", Description: "aaa", Visibility: VisibilityPrivate}, nil
+	case restrictedUID:
+		return &Category{UID: uid, UserUID: ownerUID, Name: "restricted", Description: "aaa", Visibility: VisibilityRestricted}, nil
+	}
+
+	return &Category{UID: uuid.Nil, UserUID: uuid.Nil, Name: "aaa", Description: "aaa"}, nil
+}
+
+func (mdb *mockdb) getCategoryUIDByCanonicalName(canonicalName string) (uuid.UUID, error) {
+	if canonicalName == "taken" {
+		return uuid.Nil, nil
+	}
+
+	return uuid.Nil, errNotFound
+}
+
+func (mdb *mockdb) getCategoryBySlug(slug string) (*Category, error) {
+	if slug == "golang" {
+		return &Category{UID: uuid.Nil, UserUID: uuid.Nil, Name: "Golang", Description: "aaa", Slug: slug}, nil
+	}
+
+	return nil, errNotFound
 }
 
-func (mdb *mockdb) createCategory(name, description string, userUID uuid.UUID) (*Category, error) {
+func (mdb *mockdb) createCategory(name, canonicalName, baseSlug, description, language string, visibility Visibility, userUID, parentUID uuid.UUID) (*Category, error) {
+	if canonicalName == "taken" {
+		return nil, errCategoryNameTaken
+	}
+
+	if parentUID == deepParentUID {
+		return nil, errCategoryTooDeep
+	}
+
 	if name == "success" {
 		uid := uuid.New()
 
-		return &Category{uid, userUID, name, description}, nil
+		return &Category{UID: uid, UserUID: userUID, ParentUID: parentUID, Name: name, Description: description, Language: language, Slug: baseSlug, Visibility: visibility}, nil
 	}
 
 	return nil, errDummy
 }
 
-func (mdb *mockdb) getAllReports(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*Report, error) {
+func (mdb *mockdb) getCategoriesWithoutSlug(limit int32) ([]*Category, error) {
+	return make([]*Category, 0), nil
+}
+
+func (mdb *mockdb) backfillCategorySlug(uid uuid.UUID, baseSlug string) (string, error) {
+	return baseSlug, nil
+}
+
+func (mdb *mockdb) searchCategories(query, language string, viewerUID uuid.UUID, pageSize, pageNumber int32) ([]*CategorySearchResult, error) {
+	if query == "fail" {
+		return nil, errDummy
+	}
+
+	category := &Category{UID: uuid.New(), UserUID: uuid.New(), Name: query, Description: "aaa", Language: language}
+	return []*CategorySearchResult{{category, 0.5, "<mark>" + query + "</mark>", "aaa"}}, nil
+}
+
+func (mdb *mockdb) suggestCategories(prefix string, viewerUID uuid.UUID, limit int32) ([]*Category, error) {
+	if prefix == "fail" {
+		return nil, errDummy
+	}
+
+	result := make([]*Category, 0)
+	for i := int32(0); i < limit && i < 3; i++ {
+		result = append(result, &Category{UID: uuid.New(), UserUID: uuid.New(), Name: prefix, Description: "aaa"})
+	}
+
+	return result, nil
+}
+
+func (mdb *mockdb) getAllReports(filter *reportFilter, order reportOrder, pageSize, pageNumber int32) ([]*Report, error) {
 	result := make([]*Report, 0)
+	if len(filter.UIDs) > 0 {
+		for _, uid := range filter.UIDs {
+			if uid == heldReportUID {
+				now := time.Now()
+				result = append(result, &Report{
+					UID: heldReportUID, CategoryUID: restrictedUID, PostUID: uuid.New(), ReasonCode: ReasonSpam, Routing: RoutingOwner,
+					CreatedAt: now, AssigneeUID: moderatorUID, ClaimExpiresAt: now.Add(claimDuration),
+				})
+			} else if uid == adminRoutedReportUID && filter.Routing != RoutingOwner {
+				result = append(result, &Report{
+					UID: adminRoutedReportUID, CategoryUID: restrictedUID, PostUID: uuid.New(), ReasonCode: ReasonSelfHarm,
+					Routing: RoutingAdmins, CreatedAt: time.Now(),
+				})
+			}
+		}
+
+		return result, nil
+	}
+
 	uid1 := uuid.New()
 	uid2 := uuid.New()
 	uid3 := uuid.New()
 
-	result = append(result, &Report{uid1, uid2, uid3, uuid.Nil, "aaa", time.Now()})
-	result = append(result, &Report{uid2, uid3, uid1, uid2, "bbb", time.Now()})
-	result = append(result, &Report{uid3, uid2, uid2, uuid.Nil, "ccc", time.Now()})
+	result = append(result, &Report{UID: uid1, CategoryUID: uid2, PostUID: uid3, ReasonCode: ReasonOther, Reason: "aaa", Routing: RoutingOwner, CreatedAt: time.Now()})
+	result = append(result, &Report{UID: uid2, CategoryUID: uid3, PostUID: uid1, CommentUID: uid2, RuleUID: ruleUID, ReasonCode: ReasonSpam, Reason: "bbb", Routing: RoutingOwner, CreatedAt: time.Now()})
+	result = append(result, &Report{UID: uid3, CategoryUID: uid2, PostUID: uid2, ReasonCode: ReasonSelfHarm, Routing: RoutingAdmins, CreatedAt: time.Now(), AssigneeUID: moderatorUID})
 	return result, nil
 }
 
-func (mdb *mockdb) createReport(categoryUID, postUID, commentUID uuid.UUID, reason string) (*Report, error) {
-	if reason == "success" {
-		uid := uuid.New()
-
-		return &Report{uid, categoryUID, postUID, commentUID, reason, time.Now()}, nil
+func (mdb *mockdb) createReport(report *Report) error {
+	if report.Reason == "fail" {
+		return errDummy
 	}
 
-	return nil, errDummy
+	report.UID = uuid.New()
+	report.CreatedAt = time.Now()
+	return nil
 }
 
-func (mdb *mockdb) deleteReport(uid uuid.UUID) error {
+func (mdb *mockdb) deleteReport(uid, moderatorUID uuid.UUID) error {
 	if uid == uuid.Nil {
 		return nil
 	}
@@ -75,7 +160,7 @@ func (mdb *mockdb) deleteReport(uid uuid.UUID) error {
 }
 
 func TestListCategories(t *testing.T) {
-	s := &Server{&mockdb{}}
+	s := &Server{db: &mockdb{}}
 	req := &pb.ListCategoriesRequest{}
 	_, err := s.ListCategories(context.Background(), req)
 	if err != nil {
@@ -84,7 +169,7 @@ func TestListCategories(t *testing.T) {
 }
 
 func TestCreateCategory(t *testing.T) {
-	s := &Server{&mockdb{}}
+	s := &Server{db: &mockdb{}}
 	req := &pb.CreateCategoryRequest{Name: "success", UserUid: nilUIDString, Description: "yeah"}
 	_, err := s.CreateCategory(context.Background(), req)
 	if err != nil {
@@ -92,18 +177,35 @@ func TestCreateCategory(t *testing.T) {
 	}
 }
 
+func TestCreateCategorySlug(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.CreateCategoryRequest{Name: "success", UserUid: nilUIDString, Description: "yeah"}
+	res, err := s.CreateCategory(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Slug != "success" {
+		t.Errorf("expected slug success, got %s", res.Slug)
+	}
+}
+
 func TestCreateCategoryFail(t *testing.T) {
-	s := &Server{&mockdb{}}
+	s := &Server{db: &mockdb{}}
 
-	req := &pb.CreateCategoryRequest{Name: "", Description: "nay"}
+	req := &pb.CreateCategoryRequest{Name: "", Description: "nay", UserUid: nilUIDString}
 	_, err := s.CreateCategory(context.Background(), req)
-	if err != statusNoCategoryName {
+	if !hasViolations(err, "name") {
 		t.Errorf("unexpected error %v", err)
 	}
 
-	req = &pb.CreateCategoryRequest{Name: "fail", Description: ""}
+	req = &pb.CreateCategoryRequest{Name: "fail", Description: "", UserUid: nilUIDString}
 	_, err = s.CreateCategory(context.Background(), req)
-	if err != statusNoCategoryDescription {
+	if !hasViolations(err, "description") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req = &pb.CreateCategoryRequest{Name: "fail\x00", Description: "   ", UserUid: "nay"}
+	_, err = s.CreateCategory(context.Background(), req)
+	if !hasViolations(err, "name", "description", "userUid") {
 		t.Errorf("unexpected error %v", err)
 	}
 
@@ -114,8 +216,98 @@ func TestCreateCategoryFail(t *testing.T) {
 	}
 }
 
+func TestCreateCategoryNameTaken(t *testing.T) {
+	s := &Server{db: &mockdb{}, reservedNames: newReservedNames(defaultReservedNames)}
+	req := &pb.CreateCategoryRequest{Name: "TAKEN", UserUid: nilUIDString, Description: "yeah"}
+	_, err := s.CreateCategory(context.Background(), req)
+	st, _ := status.FromError(err)
+	if st.Code() != codes.AlreadyExists {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	details := st.Details()
+	if len(details) != 1 || details[0].(*errdetails.ResourceInfo).ResourceName != nilUIDString {
+		t.Errorf("unexpected details %v", details)
+	}
+
+	req.Name = "Аdmin"
+	_, err = s.CreateCategory(context.Background(), req)
+	if !hasViolations(err, "name") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestCreateCategoryLanguage(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.CreateCategoryRequest{Name: "success", UserUid: nilUIDString, Description: "yeah"}
+	res, err := s.CreateCategory(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Language != defaultLanguage {
+		t.Errorf("expected language %s, got %s", defaultLanguage, res.Language)
+	}
+
+	req.Language = "klingon"
+	_, err = s.CreateCategory(context.Background(), req)
+	if !hasViolations(err, "language") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestSearchCategories(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.SearchCategoriesRequest{Query: "  golang ", Language: "English"}
+	res, err := s.SearchCategories(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Results) != 1 || res.Results[0].Category.Name != "golang" || res.Results[0].Category.Language != "english" {
+		t.Errorf("unexpected results %v", res.Results)
+	}
+}
+
+func TestSearchCategoriesFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.SearchCategoriesRequest{Query: " "}
+	_, err := s.SearchCategories(context.Background(), req)
+	if !hasViolations(err, "query") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req = &pb.SearchCategoriesRequest{Query: "fail"}
+	_, err = s.SearchCategories(context.Background(), req)
+	if err == nil {
+		t.Errorf("expected error, got nothing")
+	}
+}
+
+func TestSuggestCategories(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.SuggestCategoriesRequest{Prefix: "GoLa", Limit: 2}
+	res, err := s.SuggestCategories(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Categories) != 2 || res.Categories[0].Name != "gola" {
+		t.Errorf("unexpected suggestions %v", res.Categories)
+	}
+}
+
+func TestSuggestCategoriesFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.SuggestCategoriesRequest{Prefix: ""}
+	_, err := s.SuggestCategories(context.Background(), req)
+	if !hasViolations(err, "prefix") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req = &pb.SuggestCategoriesRequest{Prefix: "fail"}
+	_, err = s.SuggestCategories(context.Background(), req)
+	if err == nil {
+		t.Errorf("expected error, got nothing")
+	}
+}
+
 func TestGetCategoryAdminByPost(t *testing.T) {
-	s := &Server{&mockdb{}}
+	s := &Server{db: &mockdb{}}
 	req := &pb.GetCategoryInfoRequest{Uid: nilUIDString}
 	_, err := s.GetCategoryInfo(context.Background(), req)
 	if err != nil {
@@ -124,7 +316,7 @@ func TestGetCategoryAdminByPost(t *testing.T) {
 }
 
 func TestGetCategoryAdminByPostFail(t *testing.T) {
-	s := &Server{&mockdb{}}
+	s := &Server{db: &mockdb{}}
 	req := &pb.GetCategoryInfoRequest{Uid: ""}
 	_, err := s.GetCategoryInfo(context.Background(), req)
 	if err == nil {
@@ -132,8 +324,32 @@ func TestGetCategoryAdminByPostFail(t *testing.T) {
 	}
 }
 
+func TestGetCategoryBySlug(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.GetCategoryBySlugRequest{Slug: "golang"}
+	_, err := s.GetCategoryBySlug(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestGetCategoryBySlugFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.GetCategoryBySlugRequest{Slug: "Not A Slug"}
+	_, err := s.GetCategoryBySlug(context.Background(), req)
+	if !hasViolations(err, "slug") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req = &pb.GetCategoryBySlugRequest{Slug: "rust"}
+	_, err = s.GetCategoryBySlug(context.Background(), req)
+	if err != statusCategoryNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
 func TestListReports(t *testing.T) {
-	s := &Server{&mockdb{}}
+	s := &Server{db: &mockdb{}}
 	req := &pb.ListReportsRequest{CategoryUid: nilUIDString}
 	_, err := s.ListReports(context.Background(), req)
 	if err != nil {
@@ -142,7 +358,7 @@ func TestListReports(t *testing.T) {
 }
 
 func TestCreateReport(t *testing.T) {
-	s := &Server{&mockdb{}}
+	s := &Server{db: &mockdb{}}
 	req := &pb.CreateReportRequest{Reason: "success", CategoryUid: nilUIDString, PostUid: nilUIDString, CommentUid: nilUIDString}
 	_, err := s.CreateReport(context.Background(), req)
 	if err != nil {
@@ -151,11 +367,17 @@ func TestCreateReport(t *testing.T) {
 }
 
 func TestCreateReportFail(t *testing.T) {
-	s := &Server{&mockdb{}}
+	s := &Server{db: &mockdb{}}
 
 	req := &pb.CreateReportRequest{Reason: "", CategoryUid: nilUIDString, PostUid: nilUIDString, CommentUid: nilUIDString}
 	_, err := s.CreateReport(context.Background(), req)
-	if err != statusNoReportReason {
+	if !hasViolations(err, "reason") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req = &pb.CreateReportRequest{Reason: "fail", CategoryUid: nilUIDString, PostUid: "nay", CommentUid: nilUIDString}
+	_, err = s.CreateReport(context.Background(), req)
+	if !hasViolations(err, "postUid") {
 		t.Errorf("unexpected error %v", err)
 	}
 
@@ -166,8 +388,77 @@ func TestCreateReportFail(t *testing.T) {
 	}
 }
 
+func TestListAllReports(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	now, _ := ptypes.TimestampProto(time.Now())
+	req := &pb.ListAllReportsRequest{
+		CreatedBefore: now, ReasonText: "spam", CategoryUids: []string{rootUID.String(), childUID.String()}, Order: pb.ReportOrder_MOST_REPORTED,
+	}
+	_, err := s.ListAllReports(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListAllReportsFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	now, _ := ptypes.TimestampProto(time.Now())
+	req := &pb.ListAllReportsRequest{
+		CreatedAfter: now, CreatedBefore: now, CategoryUids: []string{rootUID.String(), "nay"}, PostUid: "nay", Order: pb.ReportOrder(100),
+	}
+	_, err := s.ListAllReports(context.Background(), req)
+	if !hasViolations(err, "createdBefore", "categoryUids[1]", "postUid", "order") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestReportFilterWhere(t *testing.T) {
+	filter := &reportFilter{
+		CategoryUIDs: []uuid.UUID{rootUID}, Routing: RoutingOwner, PostUID: childUID, ReasonText: "100% Spam",
+	}
+	args := new(queryArgs)
+	where := filter.where(args)
+	expected := "TRUE AND category_uid=ANY($1) AND routing=$2 AND post_uid=$3 AND lower(reason) LIKE '%' || $4 || '%'"
+	if where != expected {
+		t.Errorf("unexpected condition %q", where)
+	}
+
+	if len(*args) != 4 || (*args)[3] != `100\% spam` {
+		t.Errorf("unexpected args %v", *args)
+	}
+
+	args = new(queryArgs)
+	if where := (&reportFilter{UIDs: []uuid.UUID{rootUID}}).where(args); where != "TRUE AND uid=ANY($1)" || len(*args) != 1 {
+		t.Errorf("unexpected condition %q", where)
+	}
+
+	args = new(queryArgs)
+	if where := new(reportFilter).where(args); where != "TRUE" || len(*args) != 0 {
+		t.Errorf("unexpected condition %q of empty filter", where)
+	}
+}
+
+func TestReportsQueryMostReported(t *testing.T) {
+	args := new(queryArgs)
+	query := reportsQuery(&reportFilter{CategoryUIDs: []uuid.UUID{rootUID}}, reportOrderMostReported, args)
+	// targets are ordered by report counts of report_targets, filter only applies to the joined reports
+	if !strings.Contains(query, "FROM report_targets t JOIN reports USING (post_uid, comment_uid)") ||
+		!strings.Contains(query, "WHERE TRUE AND category_uid=ANY($1)") ||
+		!strings.Contains(query, "ORDER BY t.report_count DESC") {
+		t.Errorf("unexpected query %q", query)
+	}
+
+	if len(*args) != 1 {
+		t.Errorf("unexpected args %v", *args)
+	}
+
+	if query := reportsQuery(new(reportFilter), reportOrderNewest, new(queryArgs)); strings.Contains(query, "report_targets") {
+		t.Errorf("unexpected query %q", query)
+	}
+}
+
 func TestDeleteReport(t *testing.T) {
-	s := &Server{&mockdb{}}
+	s := &Server{db: &mockdb{}}
 	req := &pb.DeleteReportRequest{Uid: nilUIDString}
 	_, err := s.DeleteReport(context.Background(), req)
 	if err != nil {
@@ -176,10 +467,16 @@ func TestDeleteReport(t *testing.T) {
 }
 
 func TestDeleteReportFail(t *testing.T) {
-	s := &Server{&mockdb{}}
+	s := &Server{db: &mockdb{}}
 	req := &pb.DeleteReportRequest{Uid: ""}
 	_, err := s.DeleteReport(context.Background(), req)
 	if err == nil {
 		t.Errorf("expected error, got nothing")
 	}
+
+	req = &pb.DeleteReportRequest{Uid: nilUIDString, ModeratorUid: "not uuid"}
+	_, err = s.DeleteReport(context.Background(), req)
+	if !hasViolations(err, "moderatorUid") {
+		t.Errorf("unexpected error %v", err)
+	}
 }
@@ -0,0 +1,148 @@
+package category
+
+import (
+	"log"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	maxSlugLength = 60
+	// maxNumericSlugSuffix is the last numeric suffix tried on collisions, then category UID is used
+	maxNumericSlugSuffix = 20
+	// defaultSlug is used for names which have nothing to transliterate
+	defaultSlug = "category"
+	// slugBackfillBatchSize is the number of categories loaded at once by backfillSlugs
+	slugBackfillBatchSize = 100
+)
+
+// transliterations maps non-latin letters to latin ones, other letters with diacritics are handled by stripping marks
+var transliterations = map[rune]string{
+	// cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z", 'и': "i",
+	'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+	'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "",
+	'э': "e", 'ю': "yu", 'я': "ya", 'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g", 'ў': "u",
+	// greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i", 'κ': "k",
+	'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t",
+	'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+	// latin letters which don't decompose
+	'ß': "ss", 'æ': "ae", 'ø': "o", 'œ': "oe", 'ł': "l", 'đ': "d", 'ð': "d", 'þ': "th", 'ı': "i",
+	// symbols allowed in category names
+	'&': "and", '+': "plus", '#': "sharp",
+}
+
+// stripDiacritics transliterates letter which isn't in transliterations by its base letter, so "é" becomes "e"
+func stripDiacritics(r rune) string {
+	var b strings.Builder
+	for _, c := range norm.NFD.String(string(r)) {
+		switch {
+		case unicode.Is(unicode.Mn, c):
+		case c <= unicode.MaxASCII && (unicode.IsLetter(c) || unicode.IsDigit(c)):
+			b.WriteRune(c)
+		default:
+			b.WriteString(transliterations[c])
+		}
+	}
+
+	return b.String()
+}
+
+// slugify converts category name to URL-safe slug. Letters are looked up in transliterations before diacritics
+// are stripped, so letters like "й" keep their own transliteration
+func slugify(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range norm.NFC.String(strings.ToLower(name)) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+
+		s, ok := transliterations[r]
+		if !ok {
+			s = stripDiacritics(r)
+		} else if s == "" {
+			// signs like "ь" have no latin counterpart and don't separate words
+			continue
+		}
+
+		if s == "" {
+			// anything which can't be transliterated separates words
+			dash = b.Len() > 0
+			continue
+		}
+
+		if r == '&' || r == '+' || r == '#' {
+			// "c#" becomes "c-sharp"
+			dash = b.Len() > 0
+		}
+
+		if dash {
+			b.WriteByte('-')
+			dash = false
+		}
+
+		b.WriteString(s)
+	}
+
+	slug := b.String()
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+
+	if slug == "" {
+		return defaultSlug
+	}
+
+	return slug
+}
+
+// slugCandidate returns n-th candidate slug for category with given base slug:
+// base slug itself, then base slug with numeric suffixes and finally with category UID
+func slugCandidate(base string, uid uuid.UUID, n int) string {
+	var suffix string
+	switch {
+	case n <= 1:
+		return base
+	case n <= maxNumericSlugSuffix:
+		suffix = strconv.Itoa(n)
+	case n == maxNumericSlugSuffix+1:
+		suffix = uid.String()[:8]
+	default:
+		suffix = uid.String()
+	}
+
+	if len(base)+len(suffix)+1 > maxSlugLength {
+		base = strings.TrimRight(base[:maxSlugLength-len(suffix)-1], "-")
+	}
+
+	return base + "-" + suffix
+}
+
+// backfillSlugs generates slugs for categories created before slugs were introduced
+func (s *Server) backfillSlugs() error {
+	for {
+		categories, err := s.db.getCategoriesWithoutSlug(slugBackfillBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(categories) == 0 {
+			return nil
+		}
+
+		for _, category := range categories {
+			slug, err := s.db.backfillCategorySlug(category.UID, slugify(category.Name))
+			if err != nil {
+				return err
+			}
+
+			log.Printf("category %s got slug %s", category.UID, slug)
+		}
+	}
+}
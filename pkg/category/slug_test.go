@@ -0,0 +1,63 @@
+package category
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name, slug string
+	}{
+		{"Golang", "golang"},
+		{"  Go   generics ", "go-generics"},
+		{"Café crème", "cafe-creme"},
+		{"Программирование на Go", "programmirovanie-na-go"},
+		{"Мой мир", "moy-mir"},
+		{"Мои\u0306 мир", "moy-mir"},
+		{"Ёлка", "elka"},
+		{"Їжак", "yizhak"},
+		{"Ўзбек", "uzbek"},
+		{"Объявления", "obyavleniya"},
+		{"Καλημέρα", "kalimera"},
+		{"Straße", "strasse"},
+		{"C#", "c-sharp"},
+		{"C++", "c-plus-plus"},
+		{"Go & Rust", "go-and-rust"},
+		{"日本語", defaultSlug},
+		{"--", defaultSlug},
+	}
+
+	for _, tt := range tests {
+		if got := slugify(tt.name); got != tt.slug {
+			t.Errorf("slugify(%q) = %q, want %q", tt.name, got, tt.slug)
+		}
+	}
+
+	long := slugify(strings.Repeat("ab ", 40))
+	if len(long) > maxSlugLength || strings.HasSuffix(long, "-") {
+		t.Errorf("unexpected long slug %q", long)
+	}
+}
+
+func TestSlugCandidate(t *testing.T) {
+	uid := uuid.New()
+	if got := slugCandidate("golang", uid, 1); got != "golang" {
+		t.Errorf("unexpected first candidate %q", got)
+	}
+
+	if got := slugCandidate("golang", uid, 2); got != "golang-2" {
+		t.Errorf("unexpected second candidate %q", got)
+	}
+
+	if got := slugCandidate("golang", uid, maxNumericSlugSuffix+2); got != "golang-"+uid.String() {
+		t.Errorf("unexpected last candidate %q", got)
+	}
+
+	base := strings.Repeat("a", maxSlugLength)
+	if got := slugCandidate(base, uid, maxNumericSlugSuffix+2); len(got) > maxSlugLength {
+		t.Errorf("candidate %q is too long", got)
+	}
+}
@@ -0,0 +1,5 @@
+DROP INDEX categories_search_vector_idx;
+DROP TRIGGER categories_search_vector_update ON categories;
+DROP FUNCTION categories_search_vector_update();
+ALTER TABLE categories DROP COLUMN search_vector;
+ALTER TABLE categories DROP COLUMN language;
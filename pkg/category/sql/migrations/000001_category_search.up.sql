@@ -0,0 +1,21 @@
+ALTER TABLE categories ADD COLUMN language REGCONFIG NOT NULL DEFAULT 'english';
+ALTER TABLE categories ADD COLUMN search_vector TSVECTOR;
+
+CREATE FUNCTION categories_search_vector_update() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector(NEW.language, NEW.name), 'A') ||
+        setweight(to_tsvector(NEW.language, NEW.description), 'B');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER categories_search_vector_update
+    BEFORE INSERT OR UPDATE OF name, description, language ON categories
+    FOR EACH ROW EXECUTE PROCEDURE categories_search_vector_update();
+
+UPDATE categories SET search_vector =
+    setweight(to_tsvector(language, name), 'A') ||
+    setweight(to_tsvector(language, description), 'B');
+
+CREATE INDEX categories_search_vector_idx ON categories USING GIN (search_vector);
@@ -0,0 +1,5 @@
+DROP INDEX categories_name_trgm_idx;
+DROP INDEX categories_name_prefix_idx;
+DROP TRIGGER categories_popularity_update ON reports;
+DROP FUNCTION categories_popularity_update();
+ALTER TABLE categories DROP COLUMN popularity;
@@ -0,0 +1,2 @@
+DROP INDEX categories_canonical_name_idx;
+ALTER TABLE categories DROP COLUMN canonical_name;
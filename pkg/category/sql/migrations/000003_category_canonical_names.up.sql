@@ -0,0 +1,16 @@
+-- canonical_name is computed by the service (see canonicalName), existing rows get its SQL approximation:
+-- lowercased, confusable characters replaced, separators removed
+ALTER TABLE categories ADD COLUMN canonical_name TEXT;
+
+UPDATE categories SET canonical_name = translate(lower(name), 'авекмнорстухѕіјԁαβεικνορτυχ01 -_.', 'abekmhopctyxsijdabeikvoptuxol');
+
+-- names which collided before the constraint existed keep working, but get a unique suffix
+UPDATE categories c SET canonical_name = c.canonical_name || '#' || c.uid
+FROM (
+    SELECT uid, row_number() OVER (PARTITION BY canonical_name ORDER BY uid) AS n
+    FROM categories
+) duplicates
+WHERE c.uid = duplicates.uid AND duplicates.n > 1;
+
+ALTER TABLE categories ALTER COLUMN canonical_name SET NOT NULL;
+CREATE UNIQUE INDEX categories_canonical_name_idx ON categories (canonical_name);
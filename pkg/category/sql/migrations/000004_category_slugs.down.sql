@@ -0,0 +1,2 @@
+DROP TABLE category_slugs;
+ALTER TABLE categories DROP COLUMN slug;
@@ -0,0 +1,10 @@
+-- slugs of existing categories are generated by the service on start
+ALTER TABLE categories ADD COLUMN slug VARCHAR(80) UNIQUE;
+
+CREATE TABLE category_slugs (
+    slug VARCHAR(80) PRIMARY KEY,
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX category_slugs_category_uid_idx ON category_slugs (category_uid);
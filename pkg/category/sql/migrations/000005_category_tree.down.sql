@@ -0,0 +1,3 @@
+DROP INDEX reports_category_uid_created_at_idx;
+DROP INDEX categories_parent_uid_idx;
+ALTER TABLE categories DROP COLUMN parent_uid;
@@ -0,0 +1,4 @@
+ALTER TABLE categories ADD COLUMN parent_uid UUID REFERENCES categories (uid);
+
+CREATE INDEX categories_parent_uid_idx ON categories (parent_uid, name);
+CREATE INDEX reports_category_uid_created_at_idx ON reports (category_uid, created_at DESC);
@@ -0,0 +1,23 @@
+DROP INDEX categories_name_prefix_idx;
+ALTER TABLE categories ADD COLUMN popularity INTEGER NOT NULL DEFAULT 0;
+
+CREATE FUNCTION categories_popularity_update() RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE categories SET popularity = popularity + 1 WHERE uid = NEW.category_uid;
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER categories_popularity_update
+    AFTER INSERT ON reports
+    FOR EACH ROW EXECUTE PROCEDURE categories_popularity_update();
+
+UPDATE categories c SET popularity = r.count
+FROM (SELECT category_uid, count(*) FROM reports GROUP BY category_uid) r
+WHERE r.category_uid = c.uid;
+
+CREATE INDEX categories_name_prefix_idx ON categories (lower(name) text_pattern_ops, popularity DESC);
+
+ALTER TABLE categories DROP COLUMN subscriber_count;
+
+DROP TABLE subscriptions;
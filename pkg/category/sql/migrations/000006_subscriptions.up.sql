@@ -0,0 +1,18 @@
+CREATE TABLE subscriptions (
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    user_uid UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (category_uid, user_uid)
+);
+
+CREATE INDEX subscriptions_user_uid_idx ON subscriptions (user_uid, created_at DESC);
+CREATE INDEX subscriptions_category_uid_created_at_idx ON subscriptions (category_uid, created_at DESC);
+
+ALTER TABLE categories ADD COLUMN subscriber_count BIGINT NOT NULL DEFAULT 0;
+
+-- subscriber count replaces report based popularity
+DROP INDEX categories_name_prefix_idx;
+DROP TRIGGER categories_popularity_update ON reports;
+DROP FUNCTION categories_popularity_update();
+ALTER TABLE categories DROP COLUMN popularity;
+CREATE INDEX categories_name_prefix_idx ON categories (lower(name) text_pattern_ops, subscriber_count DESC);
@@ -0,0 +1,3 @@
+DROP TABLE join_requests;
+DROP TABLE category_members;
+ALTER TABLE categories DROP COLUMN visibility;
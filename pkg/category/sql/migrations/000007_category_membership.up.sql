@@ -0,0 +1,24 @@
+ALTER TABLE categories ADD COLUMN visibility VARCHAR(16) NOT NULL DEFAULT 'public'
+    CHECK (visibility IN ('public', 'restricted', 'private'));
+
+CREATE TABLE category_members (
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    user_uid UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (category_uid, user_uid)
+);
+
+CREATE INDEX category_members_user_uid_idx ON category_members (user_uid);
+
+CREATE TABLE join_requests (
+    uid UUID PRIMARY KEY,
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    user_uid UUID NOT NULL,
+    status VARCHAR(16) NOT NULL CHECK (status IN ('pending', 'approved', 'denied')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    decided_at TIMESTAMP WITH TIME ZONE
+);
+
+-- user can have only one pending request per category
+CREATE UNIQUE INDEX join_requests_pending_idx ON join_requests (category_uid, user_uid) WHERE status = 'pending';
+CREATE INDEX join_requests_category_uid_created_at_idx ON join_requests (category_uid, created_at) WHERE status = 'pending';
@@ -0,0 +1,11 @@
+CREATE TABLE invites (
+    code VARCHAR(32) PRIMARY KEY,
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    user_uid UUID NOT NULL,
+    max_uses INTEGER NOT NULL CHECK (max_uses >= 0),
+    uses INTEGER NOT NULL DEFAULT 0 CHECK (max_uses = 0 OR uses <= max_uses),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX invites_category_uid_created_at_idx ON invites (category_uid, created_at DESC);
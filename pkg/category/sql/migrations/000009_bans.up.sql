@@ -0,0 +1,11 @@
+CREATE TABLE bans (
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    user_uid UUID NOT NULL,
+    moderator_uid UUID NOT NULL,
+    reason VARCHAR(160) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (category_uid, user_uid)
+);
+
+CREATE INDEX bans_category_uid_created_at_idx ON bans (category_uid, created_at DESC);
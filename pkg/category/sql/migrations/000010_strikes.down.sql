@@ -0,0 +1,3 @@
+DROP TABLE suspensions;
+DROP TABLE strikes;
+DROP TABLE escalation_policies;
@@ -0,0 +1,31 @@
+CREATE TABLE escalation_policies (
+    category_uid UUID PRIMARY KEY REFERENCES categories (uid) ON DELETE CASCADE,
+    strike_limit INTEGER NOT NULL CHECK (strike_limit > 0),
+    window_seconds BIGINT NOT NULL CHECK (window_seconds > 0),
+    suspension_seconds BIGINT NOT NULL CHECK (suspension_seconds > 0),
+    strike_lifetime_seconds BIGINT NOT NULL CHECK (strike_lifetime_seconds > 0)
+);
+
+CREATE TABLE strikes (
+    uid UUID PRIMARY KEY,
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    user_uid UUID NOT NULL,
+    moderator_uid UUID NOT NULL,
+    report_uid UUID,
+    reason VARCHAR(160) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX strikes_category_uid_user_uid_created_at_idx ON strikes (category_uid, user_uid, created_at DESC);
+
+CREATE TABLE suspensions (
+    uid UUID PRIMARY KEY,
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    user_uid UUID NOT NULL,
+    strike_uid UUID NOT NULL REFERENCES strikes (uid) ON DELETE CASCADE,
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX suspensions_category_uid_user_uid_ends_at_idx ON suspensions (category_uid, user_uid, ends_at DESC);
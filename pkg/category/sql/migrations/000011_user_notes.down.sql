@@ -0,0 +1,2 @@
+DROP TABLE user_notes;
+DROP TABLE note_access_grants;
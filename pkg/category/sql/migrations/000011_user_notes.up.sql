@@ -0,0 +1,20 @@
+CREATE TABLE note_access_grants (
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    user_uid UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (category_uid, user_uid)
+);
+
+CREATE TABLE user_notes (
+    uid UUID PRIMARY KEY,
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    user_uid UUID NOT NULL,
+    author_uid UUID NOT NULL,
+    report_uid UUID,
+    text VARCHAR(1000) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT user_notes_report_uid_fkey FOREIGN KEY (report_uid) REFERENCES reports (uid) ON DELETE SET NULL
+);
+
+CREATE INDEX user_notes_category_uid_user_uid_created_at_idx ON user_notes (category_uid, user_uid, created_at DESC);
+CREATE INDEX user_notes_report_uid_idx ON user_notes (report_uid);
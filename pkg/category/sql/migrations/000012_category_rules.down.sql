@@ -0,0 +1,3 @@
+DROP INDEX reports_category_uid_rule_uid_idx;
+ALTER TABLE reports DROP COLUMN rule_uid;
+DROP TABLE category_rules;
@@ -0,0 +1,13 @@
+CREATE TABLE category_rules (
+    uid UUID PRIMARY KEY,
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (category_uid, position)
+);
+
+ALTER TABLE reports ADD COLUMN rule_uid UUID REFERENCES category_rules (uid) ON DELETE SET NULL;
+
+CREATE INDEX reports_category_uid_rule_uid_idx ON reports (category_uid, rule_uid);
@@ -0,0 +1,2 @@
+DROP INDEX reports_admins_created_at_idx;
+ALTER TABLE reports DROP COLUMN routing, DROP COLUMN reason_code;
@@ -0,0 +1,5 @@
+ALTER TABLE reports
+    ADD COLUMN reason_code VARCHAR(32) NOT NULL DEFAULT 'other',
+    ADD COLUMN routing VARCHAR(16) NOT NULL DEFAULT 'owner';
+
+CREATE INDEX reports_admins_created_at_idx ON reports (created_at DESC) WHERE routing='admins';
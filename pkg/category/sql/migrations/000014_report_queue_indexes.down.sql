@@ -0,0 +1,3 @@
+DROP INDEX reports_reason_trgm_idx;
+DROP INDEX reports_post_uid_comment_uid_idx;
+DROP INDEX reports_created_at_idx;
@@ -0,0 +1,3 @@
+CREATE INDEX reports_created_at_idx ON reports (created_at DESC);
+CREATE INDEX reports_post_uid_comment_uid_idx ON reports (post_uid, comment_uid);
+CREATE INDEX reports_reason_trgm_idx ON reports USING GIN (lower(reason) gin_trgm_ops);
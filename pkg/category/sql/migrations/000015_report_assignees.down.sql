@@ -0,0 +1,2 @@
+DROP INDEX reports_assignee_uid_created_at_idx;
+ALTER TABLE reports DROP COLUMN claim_expires_at, DROP COLUMN assignee_uid;
@@ -0,0 +1,5 @@
+ALTER TABLE reports
+    ADD COLUMN assignee_uid UUID,
+    ADD COLUMN claim_expires_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX reports_assignee_uid_created_at_idx ON reports (assignee_uid, created_at DESC);
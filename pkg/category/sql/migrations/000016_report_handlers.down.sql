@@ -0,0 +1,3 @@
+DROP INDEX reports_category_uid_assignee_uid_idx;
+DROP TABLE report_handlers;
+DROP TABLE assignment_policies;
@@ -0,0 +1,16 @@
+CREATE TABLE assignment_policies (
+    category_uid UUID PRIMARY KEY REFERENCES categories (uid) ON DELETE CASCADE,
+    strategy VARCHAR(16) NOT NULL
+);
+
+CREATE TABLE report_handlers (
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    user_uid UUID NOT NULL,
+    away BOOLEAN NOT NULL DEFAULT FALSE,
+    keywords TEXT[] NOT NULL DEFAULT '{}',
+    last_assigned_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (category_uid, user_uid)
+);
+
+CREATE INDEX reports_category_uid_assignee_uid_idx ON reports (category_uid, assignee_uid);
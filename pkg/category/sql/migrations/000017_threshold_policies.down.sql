@@ -0,0 +1,4 @@
+DROP TABLE events;
+DROP INDEX reports_post_uid_created_at_idx;
+DROP TABLE auto_actions;
+DROP TABLE threshold_policies;
@@ -0,0 +1,32 @@
+CREATE TABLE threshold_policies (
+    uid UUID PRIMARY KEY,
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    report_count INTEGER NOT NULL CHECK (report_count > 0),
+    window_seconds BIGINT NOT NULL CHECK (window_seconds > 0),
+    reason_codes TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX threshold_policies_category_uid_idx ON threshold_policies (category_uid, created_at);
+
+CREATE TABLE auto_actions (
+    uid UUID PRIMARY KEY,
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    post_uid UUID NOT NULL,
+    policy_uid UUID REFERENCES threshold_policies (uid) ON DELETE SET NULL,
+    report_count INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX auto_actions_category_uid_created_at_idx ON auto_actions (category_uid, created_at DESC);
+CREATE UNIQUE INDEX auto_actions_unreviewed_post_uid_idx ON auto_actions (post_uid) WHERE reviewed_at IS NULL;
+
+CREATE INDEX reports_post_uid_created_at_idx ON reports (post_uid, created_at);
+
+CREATE TABLE events (
+    id BIGSERIAL PRIMARY KEY,
+    type VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,3 @@
+DROP TABLE archived_report_notes;
+DROP TABLE report_notes;
+ALTER TABLE reports DROP COLUMN note_count;
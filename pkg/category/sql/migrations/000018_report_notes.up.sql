@@ -0,0 +1,30 @@
+ALTER TABLE reports ADD COLUMN note_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE report_notes (
+    uid UUID PRIMARY KEY,
+    report_uid UUID NOT NULL REFERENCES reports (uid) ON DELETE CASCADE,
+    parent_uid UUID,
+    author_uid UUID NOT NULL,
+    text VARCHAR(2000) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (report_uid, uid),
+    CONSTRAINT report_notes_parent_fkey FOREIGN KEY (report_uid, parent_uid) REFERENCES report_notes (report_uid, uid)
+);
+
+CREATE INDEX report_notes_report_uid_created_at_idx ON report_notes (report_uid, created_at);
+
+-- notes of deleted reports are kept with the context of their report until purge_after
+CREATE TABLE archived_report_notes (
+    uid UUID PRIMARY KEY,
+    report_uid UUID NOT NULL,
+    category_uid UUID NOT NULL,
+    post_uid UUID NOT NULL,
+    parent_uid UUID,
+    author_uid UUID NOT NULL,
+    text VARCHAR(2000) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    purge_after TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX archived_report_notes_report_uid_idx ON archived_report_notes (report_uid, created_at);
+CREATE INDEX archived_report_notes_purge_after_idx ON archived_report_notes (purge_after);
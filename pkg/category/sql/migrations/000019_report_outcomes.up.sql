@@ -0,0 +1,15 @@
+CREATE TABLE report_outcomes (
+    report_uid UUID PRIMARY KEY,
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    post_uid UUID NOT NULL,
+    comment_uid UUID NOT NULL,
+    reason_code VARCHAR(32) NOT NULL,
+    moderator_uid UUID NOT NULL,
+    outcome VARCHAR(32) NOT NULL,
+    ban_seconds BIGINT CHECK (ban_seconds > 0 AND outcome = 'user_banned'),
+    justification VARCHAR(1000),
+    reported_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    resolved_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX report_outcomes_category_uid_resolved_at_idx ON report_outcomes (category_uid, resolved_at DESC);
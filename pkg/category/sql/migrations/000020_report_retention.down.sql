@@ -0,0 +1,3 @@
+DROP TABLE retention_policies;
+DROP TABLE retention_defaults;
+ALTER TABLE reports DROP COLUMN anonymized_at;
@@ -0,0 +1,18 @@
+ALTER TABLE reports ADD COLUMN anonymized_at TIMESTAMP WITH TIME ZONE;
+
+-- the default policy applies to categories without their own policy, there is at most one
+CREATE TABLE retention_defaults (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    action VARCHAR(16) NOT NULL,
+    age_seconds BIGINT CHECK (age_seconds > 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CHECK ((action = 'keep') = (age_seconds IS NULL))
+);
+
+CREATE TABLE retention_policies (
+    category_uid UUID PRIMARY KEY REFERENCES categories (uid) ON DELETE CASCADE,
+    action VARCHAR(16) NOT NULL,
+    age_seconds BIGINT CHECK (age_seconds > 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CHECK ((action = 'keep') = (age_seconds IS NULL))
+);
@@ -0,0 +1,2 @@
+DROP TABLE moderation_daily_stats;
+DROP TABLE handled_reports;
@@ -0,0 +1,21 @@
+-- moderator_uid is nil UUID when it isn't known who handled report
+CREATE TABLE handled_reports (
+    report_uid UUID PRIMARY KEY,
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    moderator_uid UUID NOT NULL,
+    reported_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    handled_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX handled_reports_category_uid_handled_at_idx ON handled_reports (category_uid, handled_at DESC);
+
+-- daily rollups of handled_reports by UTC day of handling, handle_buckets is histogram of time to handle,
+-- bucket bounds are defined by the service
+CREATE TABLE moderation_daily_stats (
+    category_uid UUID NOT NULL REFERENCES categories (uid) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    moderator_uid UUID NOT NULL,
+    handled_count BIGINT NOT NULL,
+    handle_buckets BIGINT[] NOT NULL,
+    PRIMARY KEY (category_uid, day, moderator_uid)
+);
@@ -0,0 +1,3 @@
+DROP TRIGGER report_targets_update ON reports;
+DROP FUNCTION report_targets_update();
+DROP TABLE report_targets;
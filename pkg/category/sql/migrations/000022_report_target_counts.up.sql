@@ -0,0 +1,31 @@
+-- number of reports of every reported post or comment, so reports can be ordered by it without counting them
+CREATE TABLE report_targets (
+    post_uid UUID NOT NULL,
+    comment_uid UUID NOT NULL,
+    report_count BIGINT NOT NULL,
+    PRIMARY KEY (post_uid, comment_uid)
+);
+
+CREATE INDEX report_targets_report_count_idx ON report_targets (report_count DESC, post_uid, comment_uid);
+
+CREATE FUNCTION report_targets_update() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO report_targets AS t (post_uid, comment_uid, report_count) VALUES (NEW.post_uid, NEW.comment_uid, 1)
+        ON CONFLICT (post_uid, comment_uid) DO UPDATE SET report_count = t.report_count + 1;
+        RETURN NEW;
+    END IF;
+
+    UPDATE report_targets SET report_count = report_count - 1
+    WHERE post_uid = OLD.post_uid AND comment_uid = OLD.comment_uid;
+    DELETE FROM report_targets WHERE post_uid = OLD.post_uid AND comment_uid = OLD.comment_uid AND report_count <= 0;
+    RETURN OLD;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER report_targets_update
+    AFTER INSERT OR DELETE ON reports
+    FOR EACH ROW EXECUTE PROCEDURE report_targets_update();
+
+INSERT INTO report_targets (post_uid, comment_uid, report_count)
+SELECT post_uid, comment_uid, count(*) FROM reports GROUP BY post_uid, comment_uid;
@@ -0,0 +1,2 @@
+ALTER TABLE auto_actions DROP COLUMN kept_hidden;
+ALTER TABLE auto_actions DROP COLUMN reviewer_uid;
@@ -0,0 +1,3 @@
+-- kept_hidden is set when reviewer decided post must stay hidden
+ALTER TABLE auto_actions ADD COLUMN reviewer_uid UUID;
+ALTER TABLE auto_actions ADD COLUMN kept_hidden BOOLEAN NOT NULL DEFAULT FALSE;
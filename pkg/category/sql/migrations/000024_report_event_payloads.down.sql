@@ -0,0 +1 @@
+-- removed fields of report created events can't be restored
@@ -0,0 +1,3 @@
+-- report created events only keep UIDs, so reasons of reports don't outlive retention policies in events
+UPDATE events SET payload = jsonb_build_object('reportUid', payload->>'uid', 'categoryUid', payload->>'categoryUid')
+WHERE type = 'report.created';
@@ -0,0 +1,18 @@
+UPDATE events e SET id = n.id
+FROM (
+    SELECT seq, (SELECT COALESCE(max(id), 0) FROM events) + row_number() OVER (ORDER BY tx_id, seq) AS id
+    FROM events WHERE id IS NULL
+) n
+WHERE e.seq = n.seq;
+
+DROP INDEX events_unsequenced_idx;
+DROP INDEX events_id_idx;
+ALTER TABLE events DROP CONSTRAINT events_pkey;
+ALTER TABLE events DROP COLUMN tx_id;
+ALTER TABLE events DROP COLUMN seq;
+
+CREATE SEQUENCE events_id_seq OWNED BY events.id;
+SELECT setval('events_id_seq', COALESCE(max(id), 0) + 1, false) FROM events;
+ALTER TABLE events ALTER COLUMN id SET DEFAULT nextval('events_id_seq');
+ALTER TABLE events ALTER COLUMN id SET NOT NULL;
+ALTER TABLE events ADD PRIMARY KEY (id);
@@ -0,0 +1,12 @@
+-- events get IDs when they are read after their transaction and every older one finished, see sequenceEvents.
+-- seq is the order of insertion and tx_id is the transaction which emitted event
+ALTER TABLE events ADD COLUMN seq BIGSERIAL;
+ALTER TABLE events ADD COLUMN tx_id BIGINT NOT NULL DEFAULT txid_current();
+ALTER TABLE events DROP CONSTRAINT events_pkey;
+ALTER TABLE events ALTER COLUMN id DROP DEFAULT;
+ALTER TABLE events ALTER COLUMN id DROP NOT NULL;
+DROP SEQUENCE events_id_seq;
+ALTER TABLE events ADD PRIMARY KEY (seq);
+
+CREATE UNIQUE INDEX events_id_idx ON events (id);
+CREATE INDEX events_unsequenced_idx ON events (tx_id, seq) WHERE id IS NULL;
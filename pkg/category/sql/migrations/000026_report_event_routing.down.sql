@@ -0,0 +1,2 @@
+UPDATE events SET payload = payload - 'routing'
+WHERE type IN ('report.created', 'report.deleted');
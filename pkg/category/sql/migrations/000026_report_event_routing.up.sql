@@ -0,0 +1,5 @@
+-- routing of reports which are gone since isn't known, their events are only shown to site admins
+UPDATE events e SET payload = e.payload || jsonb_build_object('routing', COALESCE(r.routing, 'admins'))
+FROM events e2
+LEFT JOIN reports r ON r.uid::text = e2.payload->>'reportUid'
+WHERE e.seq = e2.seq AND e.type IN ('report.created', 'report.deleted');
@@ -0,0 +1 @@
+ALTER TABLE strikes DROP CONSTRAINT strikes_report_uid_fkey;
@@ -0,0 +1,3 @@
+-- strikes given before keep UIDs of reports which may be gone since
+ALTER TABLE strikes ADD CONSTRAINT strikes_report_uid_fkey
+    FOREIGN KEY (report_uid) REFERENCES reports (uid) ON DELETE SET NULL NOT VALID;
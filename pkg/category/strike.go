@@ -0,0 +1,254 @@
+package category
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxStrikeLimit        = 100
+	maxEscalationDuration = 365 * 24 * time.Hour
+)
+
+var statusOwnerStruck = status.Error(codes.FailedPrecondition, "category owner can't be given a strike")
+
+// SingleStrike converts Strike to SingleStrike
+func (s *Strike) SingleStrike() (*pb.SingleStrike, error) {
+	createdAtProto, err := ptypes.TimestampProto(s.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	expiresAtProto, err := ptypes.TimestampProto(s.ExpiresAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleStrike)
+	res.Uid = s.UID.String()
+	res.CategoryUid = s.CategoryUID.String()
+	res.UserUid = s.UserUID.String()
+	res.ModeratorUid = s.ModeratorUID.String()
+	if s.ReportUID != uuid.Nil {
+		res.ReportUid = s.ReportUID.String()
+	}
+
+	res.Reason = s.Reason
+	res.CreatedAt = createdAtProto
+	res.ExpiresAt = expiresAtProto
+
+	return res, nil
+}
+
+// SingleSuspension converts Suspension to SingleSuspension
+func (s *Suspension) SingleSuspension() (*pb.SingleSuspension, error) {
+	startsAtProto, err := ptypes.TimestampProto(s.StartsAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	endsAtProto, err := ptypes.TimestampProto(s.EndsAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleSuspension)
+	res.Uid = s.UID.String()
+	res.CategoryUid = s.CategoryUID.String()
+	res.UserUid = s.UserUID.String()
+	res.StrikeUid = s.StrikeUID.String()
+	res.StartsAt = startsAtProto
+	res.EndsAt = endsAtProto
+
+	return res, nil
+}
+
+// SingleEscalationPolicy converts EscalationPolicy to SingleEscalationPolicy
+func (p *EscalationPolicy) SingleEscalationPolicy() *pb.SingleEscalationPolicy {
+	res := new(pb.SingleEscalationPolicy)
+	res.CategoryUid = p.CategoryUID.String()
+	res.StrikeLimit = p.StrikeLimit
+	res.Window = ptypes.DurationProto(p.Window)
+	res.SuspensionDuration = ptypes.DurationProto(p.SuspensionDuration)
+	res.StrikeLifetime = ptypes.DurationProto(p.StrikeLifetime)
+
+	return res
+}
+
+// AddStrike gives strike to user for a report or for a free-form reason.
+// If the strike crosses limit of escalation policy, user is suspended and the suspension is returned too.
+// Moderator must be owner or report handler of category, report must be a report of the category
+func (s *Server) AddStrike(ctx context.Context, req *pb.AddStrikeRequest) (*pb.AddStrikeResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	moderatorUID := v.uuid("moderatorUid", req.ModeratorUid)
+	reportUID := v.optionalUUID("reportUid", req.ReportUid)
+	var reason string
+	switch {
+	case req.Reason != "":
+		reason = v.text("reason", req.Reason, strikeReasonRules)
+	case req.ReportUid == "":
+		v.addViolation("reason", "either reason or report is required")
+	}
+
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	category, err := s.getModeratedCategory(categoryUID, moderatorUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if category.UserUID == userUID {
+		return nil, statusOwnerStruck
+	}
+
+	strike, suspension, err := s.db.addStrike(categoryUID, userUID, moderatorUID, reportUID, reason)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	case errReportNotFound:
+		return nil, statusReportNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	res := new(pb.AddStrikeResponse)
+	res.Strike, err = strike.SingleStrike()
+	if err != nil {
+		return nil, err
+	}
+
+	if suspension != nil {
+		res.Suspension, err = suspension.SingleSuspension()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// ListStrikes returns strikes of user in category, newest first. Decayed strikes are returned only if asked
+func (s *Server) ListStrikes(ctx context.Context, req *pb.ListStrikesRequest) (*pb.ListStrikesResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	strikes, err := s.db.getStrikes(categoryUID, userUID, req.IncludeExpired, pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListStrikesResponse)
+	for _, strike := range strikes {
+		strikeResponse, err := strike.SingleStrike()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Strikes = append(res.Strikes, strikeResponse)
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
+
+// IsSuspended tells if user is suspended in category
+func (s *Server) IsSuspended(ctx context.Context, req *pb.IsSuspendedRequest) (*pb.IsSuspendedResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	suspension, err := s.db.getActiveSuspension(categoryUID, userUID)
+	switch err {
+	case nil:
+	case errNotFound:
+		return new(pb.IsSuspendedResponse), nil
+	default:
+		return nil, internalError(err)
+	}
+
+	res := new(pb.IsSuspendedResponse)
+	res.Suspended = true
+	res.Suspension, err = suspension.SingleSuspension()
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetEscalationPolicy returns escalation policy of category, default policy is returned if category hasn't set one
+func (s *Server) GetEscalationPolicy(ctx context.Context, req *pb.GetEscalationPolicyRequest) (*pb.SingleEscalationPolicy, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.db.getEscalationPolicy(categoryUID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return policy.SingleEscalationPolicy(), nil
+}
+
+// SetEscalationPolicy changes escalation policy of category, only owner can do it.
+// New policy doesn't change lifetime of strikes given before
+func (s *Server) SetEscalationPolicy(ctx context.Context, req *pb.SetEscalationPolicyRequest) (*pb.SingleEscalationPolicy, error) {
+	v := new(validator)
+	policy := new(EscalationPolicy)
+	policy.CategoryUID = v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if req.StrikeLimit < 1 || req.StrikeLimit > maxStrikeLimit {
+		v.addViolation("strikeLimit", fmt.Sprintf("strike limit must be between 1 and %d", maxStrikeLimit))
+	}
+
+	policy.StrikeLimit = req.StrikeLimit
+	policy.Window = v.duration("window", req.Window, maxEscalationDuration)
+	policy.SuspensionDuration = v.duration("suspensionDuration", req.SuspensionDuration, maxEscalationDuration)
+	policy.StrikeLifetime = v.duration("strikeLifetime", req.StrikeLifetime, maxEscalationDuration)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(policy.CategoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.setEscalationPolicy(policy); err {
+	case nil:
+		return policy.SingleEscalationPolicy(), nil
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
@@ -0,0 +1,274 @@
+package category
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// strikesReportFKey is the name of foreign key from strikes to reports
+const strikesReportFKey = "strikes_report_uid_fkey"
+
+// EscalationPolicy describes when strikes turn into suspension:
+// StrikeLimit strikes given within Window suspend user for SuspensionDuration.
+// Strikes decay after StrikeLifetime and are no longer counted
+type EscalationPolicy struct {
+	CategoryUID        uuid.UUID
+	StrikeLimit        int32
+	Window             time.Duration
+	SuspensionDuration time.Duration
+	StrikeLifetime     time.Duration
+}
+
+// defaultEscalationPolicy is used by categories which haven't set their own policy
+var defaultEscalationPolicy = EscalationPolicy{
+	StrikeLimit:        3,
+	Window:             30 * 24 * time.Hour,
+	SuspensionDuration: 7 * 24 * time.Hour,
+	StrikeLifetime:     90 * 24 * time.Hour,
+}
+
+// Strike describes warning given to user by moderator, either for a report or for a free-form reason
+type Strike struct {
+	UID          uuid.UUID
+	CategoryUID  uuid.UUID
+	UserUID      uuid.UUID
+	ModeratorUID uuid.UUID
+	ReportUID    uuid.UUID
+	Reason       string
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+// Suspension describes period when user can't post in category, suspensions are given automatically by escalation policy
+type Suspension struct {
+	UID         uuid.UUID
+	CategoryUID uuid.UUID
+	UserUID     uuid.UUID
+	StrikeUID   uuid.UUID
+	StartsAt    time.Time
+	EndsAt      time.Time
+}
+
+func (db *db) getEscalationPolicy(categoryUID uuid.UUID) (*EscalationPolicy, error) {
+	return getEscalationPolicy(db, categoryUID)
+}
+
+func getEscalationPolicy(q queryer, categoryUID uuid.UUID) (*EscalationPolicy, error) {
+	query := `SELECT strike_limit, window_seconds, suspension_seconds, strike_lifetime_seconds
+	          FROM escalation_policies WHERE category_uid=$1`
+	var window, suspension, lifetime int64
+	result := new(EscalationPolicy)
+	err := q.QueryRow(query, categoryUID.String()).Scan(&result.StrikeLimit, &window, &suspension, &lifetime)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		policy := defaultEscalationPolicy
+		policy.CategoryUID = categoryUID
+		return &policy, nil
+	default:
+		return nil, err
+	}
+
+	result.CategoryUID = categoryUID
+	result.Window = time.Duration(window) * time.Second
+	result.SuspensionDuration = time.Duration(suspension) * time.Second
+	result.StrikeLifetime = time.Duration(lifetime) * time.Second
+
+	return result, nil
+}
+
+func (db *db) setEscalationPolicy(policy *EscalationPolicy) error {
+	query := `INSERT INTO escalation_policies (category_uid, strike_limit, window_seconds, suspension_seconds, strike_lifetime_seconds)
+	          VALUES ($1, $2, $3, $4, $5)
+	          ON CONFLICT (category_uid) DO UPDATE
+	          SET strike_limit=EXCLUDED.strike_limit, window_seconds=EXCLUDED.window_seconds,
+	              suspension_seconds=EXCLUDED.suspension_seconds, strike_lifetime_seconds=EXCLUDED.strike_lifetime_seconds`
+	_, err := db.Exec(query, policy.CategoryUID.String(), policy.StrikeLimit,
+		int64(policy.Window/time.Second), int64(policy.SuspensionDuration/time.Second), int64(policy.StrikeLifetime/time.Second),
+	)
+	if isForeignKeyViolation(err) {
+		return errNotFound
+	}
+
+	return err
+}
+
+// addStrike records strike and applies escalation policy of category to it.
+// Suspension is returned when the strike crosses the limit, strikes given before previous suspension aren't counted again
+func (db *db) addStrike(categoryUID, userUID, moderatorUID, reportUID uuid.UUID, reason string) (*Strike, *Suspension, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer tx.Rollback()
+
+	// strikes of the same user are counted one at a time
+	_, err = tx.Exec("SELECT pg_advisory_xact_lock(hashtext($1), hashtext($2))", categoryUID.String(), userUID.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if reportUID != uuid.Nil {
+		var reportCategoryUID string
+		err = tx.QueryRow("SELECT category_uid FROM reports WHERE uid=$1", reportUID.String()).Scan(&reportCategoryUID)
+		switch {
+		case err == sql.ErrNoRows || (err == nil && reportCategoryUID != categoryUID.String()):
+			return nil, nil, errReportNotFound
+		case err != nil:
+			return nil, nil, err
+		}
+	}
+
+	policy, err := getEscalationPolicy(tx, categoryUID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	strike := new(Strike)
+	strike.UID = uuid.New()
+	strike.CategoryUID = categoryUID
+	strike.UserUID = userUID
+	strike.ModeratorUID = moderatorUID
+	strike.ReportUID = reportUID
+	strike.Reason = reason
+	strike.CreatedAt = time.Now()
+	strike.ExpiresAt = strike.CreatedAt.Add(policy.StrikeLifetime)
+
+	query := `INSERT INTO strikes (uid, category_uid, user_uid, moderator_uid, report_uid, reason, created_at, expires_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err = tx.Exec(query, strike.UID.String(), categoryUID.String(), userUID.String(), moderatorUID.String(),
+		nullableUUID(reportUID), reason, strike.CreatedAt, strike.ExpiresAt,
+	)
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == foreignKeyViolation {
+		if pqErr.Constraint == strikesReportFKey {
+			return nil, nil, errReportNotFound
+		}
+
+		return nil, nil, errNotFound
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query = `SELECT count(*) FROM strikes
+	         WHERE category_uid=$1 AND user_uid=$2 AND expires_at > $3 AND created_at > $4
+	           AND created_at > COALESCE((SELECT max(starts_at) FROM suspensions WHERE category_uid=$1 AND user_uid=$2), '-infinity')`
+	var count int32
+	err = tx.QueryRow(query, categoryUID.String(), userUID.String(), strike.CreatedAt, strike.CreatedAt.Add(-policy.Window)).
+		Scan(&count)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if count < policy.StrikeLimit {
+		return strike, nil, tx.Commit()
+	}
+
+	suspension := new(Suspension)
+	suspension.UID = uuid.New()
+	suspension.CategoryUID = categoryUID
+	suspension.UserUID = userUID
+	suspension.StrikeUID = strike.UID
+	suspension.StartsAt = strike.CreatedAt
+	suspension.EndsAt = strike.CreatedAt.Add(policy.SuspensionDuration)
+
+	query = `INSERT INTO suspensions (uid, category_uid, user_uid, strike_uid, starts_at, ends_at)
+	         VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err = tx.Exec(query, suspension.UID.String(), categoryUID.String(), userUID.String(), strike.UID.String(),
+		suspension.StartsAt, suspension.EndsAt,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return strike, suspension, tx.Commit()
+}
+
+func (db *db) getStrikes(categoryUID, userUID uuid.UUID, includeExpired bool, pageSize, pageNumber int32) ([]*Strike, error) {
+	query := `SELECT uid, moderator_uid, report_uid, reason, created_at, expires_at FROM strikes
+	          WHERE category_uid=$1 AND user_uid=$2 AND ($3 OR expires_at > now())
+	          ORDER BY created_at DESC LIMIT $4 OFFSET $5`
+	lastRecord := pageNumber * pageSize
+	rows, err := db.Query(query, categoryUID.String(), userUID.String(), includeExpired, pageSize, lastRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*Strike, 0)
+	for rows.Next() {
+		strike := new(Strike)
+		var uid, moderatorUID string
+		var reportUID sql.NullString
+		err := rows.Scan(&uid, &moderatorUID, &reportUID, &strike.Reason, &strike.CreatedAt, &strike.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+
+		strike.UID, err = uuid.Parse(uid)
+		if err != nil {
+			return nil, err
+		}
+
+		strike.ModeratorUID, err = uuid.Parse(moderatorUID)
+		if err != nil {
+			return nil, err
+		}
+
+		if reportUID.Valid {
+			strike.ReportUID, err = uuid.Parse(reportUID.String)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		strike.CategoryUID = categoryUID
+		strike.UserUID = userUID
+
+		result = append(result, strike)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// getActiveSuspension returns suspension of user in category which ends last
+func (db *db) getActiveSuspension(categoryUID, userUID uuid.UUID) (*Suspension, error) {
+	query := `SELECT uid, strike_uid, starts_at, ends_at FROM suspensions
+	          WHERE category_uid=$1 AND user_uid=$2 AND ends_at > now()
+	          ORDER BY ends_at DESC LIMIT 1`
+	suspension := new(Suspension)
+	var uid, strikeUID string
+	err := db.QueryRow(query, categoryUID.String(), userUID.String()).
+		Scan(&uid, &strikeUID, &suspension.StartsAt, &suspension.EndsAt)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+
+	suspension.UID, err = uuid.Parse(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	suspension.StrikeUID, err = uuid.Parse(strikeUID)
+	if err != nil {
+		return nil, err
+	}
+
+	suspension.CategoryUID = categoryUID
+	suspension.UserUID = userUID
+
+	return suspension, nil
+}
@@ -0,0 +1,191 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+// suspendedUserUID gets suspended by any strike
+var suspendedUserUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000040"))
+
+func (mdb *mockdb) getEscalationPolicy(categoryUID uuid.UUID) (*EscalationPolicy, error) {
+	policy := defaultEscalationPolicy
+	policy.CategoryUID = categoryUID
+	return &policy, nil
+}
+
+func (mdb *mockdb) setEscalationPolicy(policy *EscalationPolicy) error {
+	return nil
+}
+
+func (mdb *mockdb) addStrike(categoryUID, userUID, moderatorUID, reportUID uuid.UUID, reason string) (*Strike, *Suspension, error) {
+	if reportUID == missingReportUID {
+		return nil, nil, errReportNotFound
+	}
+
+	now := time.Now()
+	strike := &Strike{
+		UID: uuid.New(), CategoryUID: categoryUID, UserUID: userUID, ModeratorUID: moderatorUID, ReportUID: reportUID,
+		Reason: reason, CreatedAt: now, ExpiresAt: now.Add(defaultEscalationPolicy.StrikeLifetime),
+	}
+	if userUID != suspendedUserUID {
+		return strike, nil, nil
+	}
+
+	suspension := &Suspension{
+		UID: uuid.New(), CategoryUID: categoryUID, UserUID: userUID, StrikeUID: strike.UID,
+		StartsAt: now, EndsAt: now.Add(defaultEscalationPolicy.SuspensionDuration),
+	}
+	return strike, suspension, nil
+}
+
+func (mdb *mockdb) getStrikes(categoryUID, userUID uuid.UUID, includeExpired bool, pageSize, pageNumber int32) ([]*Strike, error) {
+	now := time.Now()
+	result := []*Strike{{UID: uuid.New(), CategoryUID: categoryUID, UserUID: userUID, Reason: "spam", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}}
+	if includeExpired {
+		result = append(result, &Strike{UID: uuid.New(), CategoryUID: categoryUID, UserUID: userUID, Reason: "spam", CreatedAt: now.AddDate(-1, 0, 0), ExpiresAt: now.AddDate(0, -9, 0)})
+	}
+
+	return result, nil
+}
+
+func (mdb *mockdb) getActiveSuspension(categoryUID, userUID uuid.UUID) (*Suspension, error) {
+	if userUID != suspendedUserUID {
+		return nil, errNotFound
+	}
+
+	now := time.Now()
+	return &Suspension{UID: uuid.New(), CategoryUID: categoryUID, UserUID: userUID, StrikeUID: uuid.New(), StartsAt: now, EndsAt: now.Add(time.Hour)}, nil
+}
+
+func TestAddStrike(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.AddStrikeRequest{
+		CategoryUid: privateUID.String(), UserUid: memberUID.String(), ModeratorUid: moderatorUID.String(), ReportUid: rootUID.String(),
+	}
+	res, err := s.AddStrike(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Strike.ReportUid != rootUID.String() || res.Suspension != nil {
+		t.Errorf("unexpected response %v", res)
+	}
+
+	req = &pb.AddStrikeRequest{
+		CategoryUid: privateUID.String(), UserUid: suspendedUserUID.String(), ModeratorUid: moderatorUID.String(), Reason: "rude",
+	}
+	res, err = s.AddStrike(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Strike.ReportUid != "" || res.Suspension == nil || res.Suspension.StrikeUid != res.Strike.Uid {
+		t.Errorf("unexpected response %v", res)
+	}
+
+	req.ReportUid = missingReportUID.String()
+	_, err = s.AddStrike(context.Background(), req)
+	if err != statusReportNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.ReportUid = ""
+	req.UserUid = ownerUID.String()
+	_, err = s.AddStrike(context.Background(), req)
+	if err != statusOwnerStruck {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = memberUID.String()
+	req.ModeratorUid = memberUID.String()
+	_, err = s.AddStrike(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Reason = ""
+	_, err = s.AddStrike(context.Background(), req)
+	if !hasViolations(err, "reason") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListStrikes(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListStrikesRequest{CategoryUid: privateUID.String(), UserUid: memberUID.String()}
+	res, err := s.ListStrikes(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Strikes) != 1 {
+		t.Errorf("expected 1 strike, got %d", len(res.Strikes))
+	}
+
+	req.IncludeExpired = true
+	res, err = s.ListStrikes(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Strikes) != 2 {
+		t.Errorf("expected 2 strikes, got %d", len(res.Strikes))
+	}
+}
+
+func TestIsSuspended(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.IsSuspendedRequest{CategoryUid: privateUID.String(), UserUid: suspendedUserUID.String()}
+	res, err := s.IsSuspended(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if !res.Suspended || res.Suspension == nil {
+		t.Errorf("unexpected response %v", res)
+	}
+
+	req.UserUid = memberUID.String()
+	res, err = s.IsSuspended(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.Suspended {
+		t.Errorf("unexpected response %v", res)
+	}
+}
+
+func TestEscalationPolicy(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	res, err := s.GetEscalationPolicy(context.Background(), &pb.GetEscalationPolicyRequest{CategoryUid: privateUID.String()})
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.StrikeLimit != defaultEscalationPolicy.StrikeLimit {
+		t.Errorf("expected default policy, got %v", res)
+	}
+
+	req := &pb.SetEscalationPolicyRequest{
+		CategoryUid:        privateUID.String(),
+		UserUid:            ownerUID.String(),
+		StrikeLimit:        5,
+		Window:             ptypes.DurationProto(7 * 24 * time.Hour),
+		SuspensionDuration: ptypes.DurationProto(24 * time.Hour),
+		StrikeLifetime:     ptypes.DurationProto(30 * 24 * time.Hour),
+	}
+	res, err = s.SetEscalationPolicy(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.StrikeLimit != 5 || res.SuspensionDuration.Seconds != 24*60*60 {
+		t.Errorf("unexpected policy %v", res)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.SetEscalationPolicy(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.StrikeLimit = 0
+	req.Window = nil
+	req.SuspensionDuration = ptypes.DurationProto(-time.Hour)
+	req.StrikeLifetime = ptypes.DurationProto(2 * maxEscalationDuration)
+	_, err = s.SetEscalationPolicy(context.Background(), req)
+	if !hasViolations(err, "strikeLimit", "window", "suspensionDuration", "strikeLifetime") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
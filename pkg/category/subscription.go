@@ -0,0 +1,137 @@
+package category
+
+import (
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+)
+
+// SingleSubscription converts Subscription to SingleSubscription
+func (s *Subscription) SingleSubscription() (*pb.SingleSubscription, error) {
+	createdAtProto, err := ptypes.TimestampProto(s.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleSubscription)
+	res.CategoryUid = s.CategoryUID.String()
+	res.UserUid = s.UserUID.String()
+	res.CreatedAt = createdAtProto
+
+	return res, nil
+}
+
+// Subscribe subscribes user to category, subscribing twice is not an error.
+// Private categories can be subscribed to by their members only
+func (s *Server) Subscribe(ctx context.Context, req *pb.SubscribeRequest) (*pb.SubscribeResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getVisibleCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.subscribe(categoryUID, userUID); err {
+	case nil:
+		return new(pb.SubscribeResponse), nil
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// Unsubscribe unsubscribes user from category, unsubscribing when not subscribed is not an error
+func (s *Server) Unsubscribe(ctx context.Context, req *pb.UnsubscribeRequest) (*pb.UnsubscribeResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.unsubscribe(categoryUID, userUID); err != nil {
+		return nil, internalError(err)
+	}
+
+	return new(pb.UnsubscribeResponse), nil
+}
+
+// ListSubscribers returns users subscribed to category, most recent first. Subscribers of private category
+// are listed only to its members
+func (s *Server) ListSubscribers(ctx context.Context, req *pb.ListSubscribersRequest) (*pb.ListSubscribersResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	viewerUID := v.optionalUUID("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getVisibleCategory(categoryUID, viewerUID); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.db.getSubscribers(categoryUID, pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListSubscribersResponse)
+	for _, subscription := range subscriptions {
+		subscriptionResponse, err := subscription.SingleSubscription()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Subscriptions = append(res.Subscriptions, subscriptionResponse)
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
+
+// ListSubscriptions returns categories user is subscribed to, most recently subscribed first.
+// Private categories are only returned if viewer can see them
+func (s *Server) ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest) (*pb.ListCategoriesResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	v := new(validator)
+	userUID := v.uuid("userUid", req.UserUid)
+	viewerUID := v.optionalUUID("viewerUid", req.ViewerUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.db.getSubscriptions(userUID, viewerUID, pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListCategoriesResponse)
+	for _, category := range categories {
+		res.Categories = append(res.Categories, category.SingleCategory())
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
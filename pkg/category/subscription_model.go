@@ -0,0 +1,139 @@
+package category
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// foreignKeyViolation is Postgres error code returned when referenced row doesn't exist
+const foreignKeyViolation = "23503"
+
+// Subscription describes user subscribed to category
+type Subscription struct {
+	CategoryUID uuid.UUID
+	UserUID     uuid.UUID
+	CreatedAt   time.Time
+}
+
+func isForeignKeyViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == foreignKeyViolation
+}
+
+func (db *db) subscribe(categoryUID, userUID uuid.UUID) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	query := `INSERT INTO subscriptions (category_uid, user_uid, created_at) VALUES ($1, $2, $3)
+	          ON CONFLICT DO NOTHING`
+	result, err := tx.Exec(query, categoryUID.String(), userUID.String(), time.Now())
+	if isForeignKeyViolation(err) {
+		return errNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if nRows == 0 {
+		// already subscribed
+		return nil
+	}
+
+	_, err = tx.Exec("UPDATE categories SET subscriber_count=subscriber_count+1 WHERE uid=$1", categoryUID.String())
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (db *db) unsubscribe(categoryUID, userUID uuid.UUID) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	query := "DELETE FROM subscriptions WHERE category_uid=$1 AND user_uid=$2"
+	result, err := tx.Exec(query, categoryUID.String(), userUID.String())
+	if err != nil {
+		return err
+	}
+
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if nRows == 0 {
+		// not subscribed
+		return nil
+	}
+
+	_, err = tx.Exec("UPDATE categories SET subscriber_count=subscriber_count-1 WHERE uid=$1", categoryUID.String())
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (db *db) getSubscribers(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*Subscription, error) {
+	query := `SELECT user_uid, created_at FROM subscriptions
+	          WHERE category_uid=$1
+	          ORDER BY created_at DESC LIMIT $2 OFFSET $3`
+	lastRecord := pageNumber * pageSize
+	rows, err := db.Query(query, categoryUID.String(), pageSize, lastRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*Subscription, 0)
+	for rows.Next() {
+		subscription := new(Subscription)
+		var userUID string
+		err := rows.Scan(&userUID, &subscription.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		subscription.UserUID, err = uuid.Parse(userUID)
+		if err != nil {
+			return nil, err
+		}
+
+		subscription.CategoryUID = categoryUID
+
+		result = append(result, subscription)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (db *db) getSubscriptions(userUID, viewerUID uuid.UUID, pageSize, pageNumber int32) ([]*Category, error) {
+	query := `SELECT ` + categoryColumns + `
+	          FROM (SELECT category_uid, created_at AS subscribed_at FROM subscriptions WHERE user_uid=$1) s
+	          JOIN categories ON uid=s.category_uid
+	          WHERE ` + visibleTo(4) + `
+	          ORDER BY s.subscribed_at DESC LIMIT $2 OFFSET $3`
+	lastRecord := pageNumber * pageSize
+	return db.queryCategories(query, userUID.String(), pageSize, lastRecord, nullableUUID(viewerUID))
+}
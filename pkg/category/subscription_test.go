@@ -0,0 +1,118 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+var subscriberUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000010"))
+
+func (mdb *mockdb) subscribe(categoryUID, userUID uuid.UUID) error {
+	if categoryUID != rootUID {
+		return errNotFound
+	}
+
+	return nil
+}
+
+func (mdb *mockdb) unsubscribe(categoryUID, userUID uuid.UUID) error {
+	return nil
+}
+
+func (mdb *mockdb) getSubscribers(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*Subscription, error) {
+	if categoryUID != rootUID {
+		return make([]*Subscription, 0), nil
+	}
+
+	return []*Subscription{{CategoryUID: categoryUID, UserUID: subscriberUID, CreatedAt: time.Now()}}, nil
+}
+
+func (mdb *mockdb) getSubscriptions(userUID, viewerUID uuid.UUID, pageSize, pageNumber int32) ([]*Category, error) {
+	result := make([]*Category, 0)
+	if userUID != subscriberUID {
+		return result, nil
+	}
+
+	if viewerUID == ownerUID {
+		result = append(result, &Category{UID: privateUID, Name: "private", Visibility: VisibilityPrivate, SubscriberCount: 1})
+	}
+
+	result = append(result, &Category{UID: rootUID, Name: "Programming", SubscriberCount: 1})
+	return result, nil
+}
+
+func TestSubscribe(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.SubscribeRequest{CategoryUid: rootUID.String(), UserUid: subscriberUID.String()}
+	_, err := s.Subscribe(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.CategoryUid = nilUIDString
+	_, err = s.Subscribe(context.Background(), req)
+	if err != statusCategoryNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = ""
+	_, err = s.Subscribe(context.Background(), req)
+	if !hasViolations(err, "userUid") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.UnsubscribeRequest{CategoryUid: rootUID.String(), UserUid: subscriberUID.String()}
+	_, err := s.Unsubscribe(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListSubscribers(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListSubscribersRequest{CategoryUid: rootUID.String()}
+	res, err := s.ListSubscribers(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Subscriptions) != 1 || res.Subscriptions[0].UserUid != subscriberUID.String() {
+		t.Errorf("unexpected subscribers %v", res.Subscriptions)
+	}
+
+	req = &pb.ListSubscribersRequest{CategoryUid: privateUID.String()}
+	_, err = s.ListSubscribers(context.Background(), req)
+	if err != statusCategoryNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListSubscriptions(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListSubscriptionsRequest{UserUid: subscriberUID.String()}
+	res, err := s.ListSubscriptions(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Categories) != 1 || res.Categories[0].SubscriberCount != 1 {
+		t.Errorf("unexpected subscriptions %v", res.Categories)
+	}
+
+	req.ViewerUid = ownerUID.String()
+	res, err = s.ListSubscriptions(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Categories) != 2 || res.Categories[0].Uid != privateUID.String() {
+		t.Errorf("unexpected subscriptions %v", res.Categories)
+	}
+
+	req.ViewerUid = "not uuid"
+	_, err = s.ListSubscriptions(context.Background(), req)
+	if !hasViolations(err, "viewerUid") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,259 @@
+package category
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	minThresholdReportCount = 2
+	maxThresholdReportCount = 1000
+	maxThresholdWindow      = 30 * 24 * time.Hour
+)
+
+var (
+	statusThresholdPolicyNotFound  = status.Error(codes.NotFound, "threshold policy not found")
+	statusTooManyThresholdPolicies = status.Error(codes.FailedPrecondition, fmt.Sprintf("category can't have more than %d threshold policies", maxThresholdPolicies))
+	statusAutoActionNotFound       = status.Error(codes.NotFound, "auto action not found")
+	statusAutoActionReviewed       = status.Error(codes.FailedPrecondition, "auto action is already reviewed")
+)
+
+// SingleThresholdPolicy converts ThresholdPolicy to SingleThresholdPolicy
+func (p *ThresholdPolicy) SingleThresholdPolicy() (*pb.SingleThresholdPolicy, error) {
+	createdAtProto, err := ptypes.TimestampProto(p.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleThresholdPolicy)
+	res.Uid = p.UID.String()
+	res.CategoryUid = p.CategoryUID.String()
+	res.ReportCount = p.ReportCount
+	res.Window = ptypes.DurationProto(p.Window)
+	for _, code := range p.ReasonCodes {
+		res.ReasonCodes = append(res.ReasonCodes, reasonCodesProto[code])
+	}
+
+	res.CreatedAt = createdAtProto
+
+	return res, nil
+}
+
+// SingleAutoAction converts AutoAction to SingleAutoAction
+func (a *AutoAction) SingleAutoAction() (*pb.SingleAutoAction, error) {
+	createdAtProto, err := ptypes.TimestampProto(a.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleAutoAction)
+	res.Uid = a.UID.String()
+	res.CategoryUid = a.CategoryUID.String()
+	res.PostUid = a.PostUID.String()
+	if a.PolicyUID != uuid.Nil {
+		res.PolicyUid = a.PolicyUID.String()
+	}
+
+	res.ReportCount = a.ReportCount
+	res.CreatedAt = createdAtProto
+	if !a.ReviewedAt.IsZero() {
+		res.ReviewedAt, err = ptypes.TimestampProto(a.ReviewedAt)
+		if err != nil {
+			return nil, internalError(err)
+		}
+	}
+
+	if a.ReviewerUID != uuid.Nil {
+		res.ReviewerUid = a.ReviewerUID.String()
+	}
+
+	res.KeptHidden = a.KeptHidden
+
+	return res, nil
+}
+
+// CreateThresholdPolicy adds policy hiding posts which get too many reports, only owner can do it
+func (s *Server) CreateThresholdPolicy(ctx context.Context, req *pb.CreateThresholdPolicyRequest) (*pb.SingleThresholdPolicy, error) {
+	v := new(validator)
+	policy := new(ThresholdPolicy)
+	policy.CategoryUID = v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if req.ReportCount < minThresholdReportCount || req.ReportCount > maxThresholdReportCount {
+		v.addViolation("reportCount", fmt.Sprintf("report count must be between %d and %d", minThresholdReportCount, maxThresholdReportCount))
+	}
+
+	policy.ReportCount = req.ReportCount
+	policy.Window = v.duration("window", req.Window, maxThresholdWindow)
+	for i, code := range req.ReasonCodes {
+		code := v.reasonCode(fmt.Sprintf("reasonCodes[%d]", i), code)
+		if !hasReasonCode(policy.ReasonCodes, code) {
+			policy.ReasonCodes = append(policy.ReasonCodes, code)
+		}
+	}
+
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(policy.CategoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.createThresholdPolicy(policy); err {
+	case nil:
+		return policy.SingleThresholdPolicy()
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	case errTooManyThresholdPolicies:
+		return nil, statusTooManyThresholdPolicies
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// DeleteThresholdPolicy deletes threshold policy, only owner of its category can do it.
+// Posts hidden by the policy stay hidden until reviewed
+func (s *Server) DeleteThresholdPolicy(ctx context.Context, req *pb.DeleteThresholdPolicyRequest) (*pb.DeleteThresholdPolicyResponse, error) {
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.db.getThresholdPolicy(uid)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusThresholdPolicyNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	if _, err := s.getOwnedCategory(policy.CategoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.deleteThresholdPolicy(uid); err {
+	case nil:
+		return new(pb.DeleteThresholdPolicyResponse), nil
+	case errNotFound:
+		return nil, statusThresholdPolicyNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ListThresholdPolicies returns threshold policies of category, only owner can do it
+func (s *Server) ListThresholdPolicies(ctx context.Context, req *pb.ListThresholdPoliciesRequest) (*pb.ListThresholdPoliciesResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	policies, err := s.db.getThresholdPolicies(categoryUID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListThresholdPoliciesResponse)
+	for _, policy := range policies {
+		policyResponse, err := policy.SingleThresholdPolicy()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Policies = append(res.Policies, policyResponse)
+	}
+
+	return res, nil
+}
+
+// ListAutoActions returns posts of category hidden by threshold policies, newest first.
+// User must be owner or report handler of category
+func (s *Server) ListAutoActions(ctx context.Context, req *pb.ListAutoActionsRequest) (*pb.ListAutoActionsResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getModeratedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	actions, err := s.db.getAutoActions(categoryUID, pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListAutoActionsResponse)
+	for _, action := range actions {
+		actionResponse, err := action.SingleAutoAction()
+		if err != nil {
+			return nil, err
+		}
+
+		res.AutoActions = append(res.AutoActions, actionResponse)
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
+
+// ReviewAutoAction marks post hidden by threshold policy reviewed, owner or report handler of category can do it.
+// The post is unhidden unless reviewer keeps it hidden, either way new reports can hide it again
+func (s *Server) ReviewAutoAction(ctx context.Context, req *pb.ReviewAutoActionRequest) (*pb.SingleAutoAction, error) {
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	action, err := s.db.getAutoAction(uid)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusAutoActionNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	if _, err := s.getModeratedCategory(action.CategoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	action, err = s.db.reviewAutoAction(uid, userUID, req.KeepHidden)
+	switch err {
+	case nil:
+		return action.SingleAutoAction()
+	case errAutoActionReviewed:
+		return nil, statusAutoActionReviewed
+	default:
+		return nil, internalError(err)
+	}
+}
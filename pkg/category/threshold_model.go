@@ -0,0 +1,429 @@
+package category
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// maxThresholdPolicies is the maximum number of threshold policies in category
+const maxThresholdPolicies = 10
+
+var (
+	errTooManyThresholdPolicies = errors.New("category has too many threshold policies")
+	errAutoActionReviewed       = errors.New("auto action is already reviewed")
+)
+
+// ThresholdPolicy hides post automatically when it gets ReportCount reports within Window.
+// Only reports with one of ReasonCodes are counted, every report is counted when ReasonCodes is empty
+type ThresholdPolicy struct {
+	UID         uuid.UUID
+	CategoryUID uuid.UUID
+	ReportCount int32
+	Window      time.Duration
+	ReasonCodes []ReasonCode
+	CreatedAt   time.Time
+}
+
+// AutoAction records post hidden by threshold policy. Post stays hidden until the action is reviewed,
+// reviewer either unhides it or keeps it hidden
+type AutoAction struct {
+	UID         uuid.UUID
+	CategoryUID uuid.UUID
+	PostUID     uuid.UUID
+	PolicyUID   uuid.UUID
+	ReportCount int32
+	CreatedAt   time.Time
+	ReviewedAt  time.Time
+	ReviewerUID uuid.UUID
+	KeptHidden  bool
+}
+
+// autoHiddenEvent is the payload of EventContentAutoHidden
+type autoHiddenEvent struct {
+	AutoActionUID string    `json:"autoActionUid"`
+	CategoryUID   string    `json:"categoryUid"`
+	PostUID       string    `json:"postUid"`
+	PolicyUID     string    `json:"policyUid"`
+	ReportCount   int32     `json:"reportCount"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// autoUnhiddenEvent is the payload of EventContentAutoUnhidden
+type autoUnhiddenEvent struct {
+	AutoActionUID string    `json:"autoActionUid"`
+	CategoryUID   string    `json:"categoryUid"`
+	PostUID       string    `json:"postUid"`
+	ReviewerUID   string    `json:"reviewerUid"`
+	ReviewedAt    time.Time `json:"reviewedAt"`
+}
+
+const thresholdPolicyColumns = "uid, category_uid, report_count, window_seconds, reason_codes, created_at"
+
+func scanThresholdPolicy(row scanner) (*ThresholdPolicy, error) {
+	policy := new(ThresholdPolicy)
+	var uid, categoryUID string
+	var window int64
+	var reasonCodes []string
+	err := row.Scan(&uid, &categoryUID, &policy.ReportCount, &window, pq.Array(&reasonCodes), &policy.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.Window = time.Duration(window) * time.Second
+	for _, code := range reasonCodes {
+		policy.ReasonCodes = append(policy.ReasonCodes, ReasonCode(code))
+	}
+
+	policy.UID, err = uuid.Parse(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.CategoryUID, err = uuid.Parse(categoryUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+const autoActionColumns = "uid, category_uid, post_uid, policy_uid, report_count, created_at, reviewed_at, reviewer_uid, kept_hidden"
+
+func scanAutoAction(row scanner) (*AutoAction, error) {
+	action := new(AutoAction)
+	var uid, categoryUID, postUID string
+	var policyUID, reviewerUID sql.NullString
+	var reviewedAt pq.NullTime
+	err := row.Scan(&uid, &categoryUID, &postUID, &policyUID, &action.ReportCount, &action.CreatedAt,
+		&reviewedAt, &reviewerUID, &action.KeptHidden,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if reviewedAt.Valid {
+		action.ReviewedAt = reviewedAt.Time
+	}
+
+	action.UID, err = uuid.Parse(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	action.CategoryUID, err = uuid.Parse(categoryUID)
+	if err != nil {
+		return nil, err
+	}
+
+	action.PostUID, err = uuid.Parse(postUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if policyUID.Valid {
+		action.PolicyUID, err = uuid.Parse(policyUID.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if reviewerUID.Valid {
+		action.ReviewerUID, err = uuid.Parse(reviewerUID.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return action, nil
+}
+
+func reasonCodeStrings(codes []ReasonCode) []string {
+	result := make([]string, len(codes))
+	for i, code := range codes {
+		result[i] = string(code)
+	}
+
+	return result
+}
+
+// createThresholdPolicy stores policy, its UID and creation time are set
+func (db *db) createThresholdPolicy(policy *ThresholdPolicy) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	if err := lockCategory(tx, policy.CategoryUID); err != nil {
+		return err
+	}
+
+	var count int32
+	err = tx.QueryRow("SELECT count(*) FROM threshold_policies WHERE category_uid=$1", policy.CategoryUID.String()).Scan(&count)
+	if err != nil {
+		return err
+	}
+
+	if count >= maxThresholdPolicies {
+		return errTooManyThresholdPolicies
+	}
+
+	policy.UID = uuid.New()
+	policy.CreatedAt = time.Now()
+	query := `INSERT INTO threshold_policies (uid, category_uid, report_count, window_seconds, reason_codes, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err = tx.Exec(query, policy.UID.String(), policy.CategoryUID.String(), policy.ReportCount,
+		int64(policy.Window/time.Second), pq.Array(reasonCodeStrings(policy.ReasonCodes)), policy.CreatedAt,
+	)
+	if isForeignKeyViolation(err) {
+		return errNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (db *db) getThresholdPolicy(uid uuid.UUID) (*ThresholdPolicy, error) {
+	query := "SELECT " + thresholdPolicyColumns + " FROM threshold_policies WHERE uid=$1"
+	result, err := scanThresholdPolicy(db.QueryRow(query, uid.String()))
+	switch err {
+	case nil:
+		return result, nil
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (db *db) deleteThresholdPolicy(uid uuid.UUID) error {
+	result, err := db.Exec("DELETE FROM threshold_policies WHERE uid=$1", uid.String())
+	if err != nil {
+		return err
+	}
+
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if nRows == 0 {
+		return errNotFound
+	}
+
+	return nil
+}
+
+func (db *db) getThresholdPolicies(categoryUID uuid.UUID) ([]*ThresholdPolicy, error) {
+	return getThresholdPolicies(db, categoryUID)
+}
+
+func getThresholdPolicies(q queryer, categoryUID uuid.UUID) ([]*ThresholdPolicy, error) {
+	query := "SELECT " + thresholdPolicyColumns + " FROM threshold_policies WHERE category_uid=$1 ORDER BY created_at"
+	rows, err := q.Query(query, categoryUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*ThresholdPolicy, 0)
+	for rows.Next() {
+		policy, err := scanThresholdPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, policy)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// applyThresholdPolicies hides post of new report if it crossed any threshold policy of category.
+// Post which is already hidden and not reviewed yet isn't hidden again
+func applyThresholdPolicies(tx *sql.Tx, report *Report) error {
+	policies, err := getThresholdPolicies(tx, report.CategoryUID)
+	if err != nil {
+		return err
+	}
+
+	if len(policies) == 0 {
+		return nil
+	}
+
+	// reports of the same post are counted one at a time, so concurrent reports see each other once committed
+	_, err = tx.Exec("SELECT pg_advisory_xact_lock(hashtext($1), hashtext($2))", report.CategoryUID.String(),
+		report.PostUID.String(),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if len(policy.ReasonCodes) > 0 && !hasReasonCode(policy.ReasonCodes, report.ReasonCode) {
+			continue
+		}
+
+		query := `SELECT count(*) FROM reports
+		          WHERE post_uid=$1 AND category_uid=$2 AND created_at > $3
+		            AND (cardinality($4::text[]) = 0 OR reason_code=ANY($4))`
+		var count int32
+		err := tx.QueryRow(query, report.PostUID.String(), report.CategoryUID.String(), report.CreatedAt.Add(-policy.Window),
+			pq.Array(reasonCodeStrings(policy.ReasonCodes)),
+		).Scan(&count)
+		if err != nil {
+			return err
+		}
+
+		if count < policy.ReportCount {
+			continue
+		}
+
+		action := &AutoAction{
+			UID: uuid.New(), CategoryUID: report.CategoryUID, PostUID: report.PostUID, PolicyUID: policy.UID,
+			ReportCount: count, CreatedAt: report.CreatedAt,
+		}
+		query = `INSERT INTO auto_actions (uid, category_uid, post_uid, policy_uid, report_count, created_at)
+		         VALUES ($1, $2, $3, $4, $5, $6)
+		         ON CONFLICT DO NOTHING`
+		result, err := tx.Exec(query, action.UID.String(), action.CategoryUID.String(), action.PostUID.String(),
+			action.PolicyUID.String(), action.ReportCount, action.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		nRows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if nRows == 0 {
+			return nil
+		}
+
+		return emitEvent(tx, EventContentAutoHidden, autoHiddenEvent{
+			AutoActionUID: action.UID.String(),
+			CategoryUID:   action.CategoryUID.String(),
+			PostUID:       action.PostUID.String(),
+			PolicyUID:     action.PolicyUID.String(),
+			ReportCount:   action.ReportCount,
+			CreatedAt:     action.CreatedAt,
+		})
+	}
+
+	return nil
+}
+
+func hasReasonCode(codes []ReasonCode, code ReasonCode) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (db *db) getAutoActions(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*AutoAction, error) {
+	query := `SELECT ` + autoActionColumns + ` FROM auto_actions
+	          WHERE category_uid=$1
+	          ORDER BY created_at DESC LIMIT $2 OFFSET $3`
+	lastRecord := pageNumber * pageSize
+	rows, err := db.Query(query, categoryUID.String(), pageSize, lastRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*AutoAction, 0)
+	for rows.Next() {
+		action, err := scanAutoAction(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, action)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (db *db) getAutoAction(uid uuid.UUID) (*AutoAction, error) {
+	query := "SELECT " + autoActionColumns + " FROM auto_actions WHERE uid=$1"
+	result, err := scanAutoAction(db.QueryRow(query, uid.String()))
+	switch err {
+	case nil:
+		return result, nil
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+}
+
+// reviewAutoAction marks auto action reviewed by reviewer. Unless post is kept hidden,
+// EventContentAutoUnhidden is emitted, so the post service shows the post again
+func (db *db) reviewAutoAction(uid, reviewerUID uuid.UUID, keepHidden bool) (*AutoAction, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	action, err := reviewAutoActions(tx, "uid=$4", uid.String(), reviewerUID, keepHidden)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil, errAutoActionReviewed
+	default:
+		return nil, err
+	}
+
+	return action, tx.Commit()
+}
+
+// reviewAutoActions marks auto action matching condition on parameter $4 reviewed by reviewer in tx and emits
+// EventContentAutoUnhidden unless post is kept hidden. Condition must match at most one auto action which isn't reviewed,
+// sql.ErrNoRows is returned if there is none
+func reviewAutoActions(tx *sql.Tx, condition string, arg interface{}, reviewerUID uuid.UUID, keepHidden bool) (*AutoAction, error) {
+	query := `UPDATE auto_actions SET reviewed_at=$1, reviewer_uid=$2, kept_hidden=$3
+	          WHERE ` + condition + ` AND reviewed_at IS NULL
+	          RETURNING ` + autoActionColumns
+	action, err := scanAutoAction(tx.QueryRow(query, time.Now(), reviewerUID.String(), keepHidden, arg))
+	if err != nil {
+		return nil, err
+	}
+
+	if !keepHidden {
+		err := emitEvent(tx, EventContentAutoUnhidden, autoUnhiddenEvent{
+			AutoActionUID: action.UID.String(),
+			CategoryUID:   action.CategoryUID.String(),
+			PostUID:       action.PostUID.String(),
+			ReviewerUID:   action.ReviewerUID.String(),
+			ReviewedAt:    action.ReviewedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return action, nil
+}
@@ -0,0 +1,255 @@
+package category
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+var (
+	// thresholdPolicyUID is a policy of restricted category
+	thresholdPolicyUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000080"))
+	// autoActionUID is an auto action of restricted category which isn't reviewed yet
+	autoActionUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000081"))
+	// reviewedAutoActionUID is an auto action of restricted category which is already reviewed
+	reviewedAutoActionUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000082"))
+)
+
+func (mdb *mockdb) createThresholdPolicy(policy *ThresholdPolicy) error {
+	if policy.ReportCount == maxThresholdReportCount {
+		return errTooManyThresholdPolicies
+	}
+
+	policy.UID = uuid.New()
+	policy.CreatedAt = time.Now()
+	return nil
+}
+
+func (mdb *mockdb) getThresholdPolicy(uid uuid.UUID) (*ThresholdPolicy, error) {
+	if uid != thresholdPolicyUID {
+		return nil, errNotFound
+	}
+
+	return &ThresholdPolicy{UID: uid, CategoryUID: restrictedUID, ReportCount: 5, Window: time.Hour, CreatedAt: time.Now()}, nil
+}
+
+func (mdb *mockdb) deleteThresholdPolicy(uid uuid.UUID) error {
+	return nil
+}
+
+func (mdb *mockdb) getThresholdPolicies(categoryUID uuid.UUID) ([]*ThresholdPolicy, error) {
+	policy, _ := mdb.getThresholdPolicy(thresholdPolicyUID)
+	policy.ReasonCodes = []ReasonCode{ReasonSpam}
+	return []*ThresholdPolicy{policy}, nil
+}
+
+func (mdb *mockdb) getAutoActions(categoryUID uuid.UUID, pageSize, pageNumber int32) ([]*AutoAction, error) {
+	now := time.Now()
+	return []*AutoAction{
+		{UID: uuid.New(), CategoryUID: categoryUID, PostUID: uuid.New(), PolicyUID: thresholdPolicyUID, ReportCount: 5, CreatedAt: now},
+		{UID: uuid.New(), CategoryUID: categoryUID, PostUID: uuid.New(), ReportCount: 3, CreatedAt: now.Add(-time.Hour), ReviewedAt: now},
+	}, nil
+}
+
+func (mdb *mockdb) getAutoAction(uid uuid.UUID) (*AutoAction, error) {
+	if uid != autoActionUID && uid != reviewedAutoActionUID {
+		return nil, errNotFound
+	}
+
+	return &AutoAction{UID: uid, CategoryUID: restrictedUID, PostUID: uuid.New(), PolicyUID: thresholdPolicyUID, ReportCount: 5, CreatedAt: time.Now()}, nil
+}
+
+func (mdb *mockdb) reviewAutoAction(uid, reviewerUID uuid.UUID, keepHidden bool) (*AutoAction, error) {
+	if uid == reviewedAutoActionUID {
+		return nil, errAutoActionReviewed
+	}
+
+	action, _ := mdb.getAutoAction(uid)
+	action.ReviewedAt = time.Now()
+	action.ReviewerUID = reviewerUID
+	action.KeptHidden = keepHidden
+	return action, nil
+}
+
+// mockEvents hides a post, creates and deletes report of restricted category, deletes report of private category
+// and creates and deletes report of restricted category routed to site admins
+func mockEvents() []*Event {
+	now := time.Now()
+	autoHidden, _ := json.Marshal(autoHiddenEvent{PostUID: uuid.Nil.String(), ReportCount: 5})
+	report := reportEventPayload{ReportUID: heldReportUID.String(), CategoryUID: restrictedUID.String(), Routing: RoutingOwner}
+	held, _ := json.Marshal(report)
+	report = reportEventPayload{ReportUID: uuid.New().String(), CategoryUID: privateUID.String(), Routing: RoutingOwner}
+	deletedPrivate, _ := json.Marshal(report)
+	report = reportEventPayload{ReportUID: adminRoutedReportUID.String(), CategoryUID: restrictedUID.String(), Routing: RoutingAdmins}
+	adminRouted, _ := json.Marshal(report)
+	return []*Event{
+		{ID: 1, Type: EventContentAutoHidden, Payload: string(autoHidden), CreatedAt: now},
+		{ID: 2, Type: EventReportCreated, Payload: string(held), CreatedAt: now},
+		{ID: 3, Type: EventReportDeleted, Payload: string(held), CreatedAt: now},
+		{ID: 4, Type: EventReportDeleted, Payload: string(deletedPrivate), CreatedAt: now},
+		{ID: 5, Type: EventReportCreated, Payload: string(adminRouted), CreatedAt: now},
+		{ID: 6, Type: EventReportDeleted, Payload: string(adminRouted), CreatedAt: now},
+	}
+}
+
+func (mdb *mockdb) getEvents(afterID int64, limit int32) ([]*Event, error) {
+	result := make([]*Event, 0)
+	for _, event := range mockEvents() {
+		if event.ID > afterID && len(result) < int(limit) {
+			result = append(result, event)
+		}
+	}
+
+	return result, nil
+}
+
+func (mdb *mockdb) getLastEventID() (int64, error) {
+	return 6, nil
+}
+
+func TestCreateThresholdPolicy(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.CreateThresholdPolicyRequest{
+		CategoryUid: restrictedUID.String(), UserUid: ownerUID.String(), ReportCount: 5, Window: ptypes.DurationProto(time.Hour),
+		ReasonCodes: []pb.ReasonCode{pb.ReasonCode_SPAM, pb.ReasonCode_HARASSMENT, pb.ReasonCode_SPAM},
+	}
+	res, err := s.CreateThresholdPolicy(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.ReasonCodes) != 2 || res.Uid == "" {
+		t.Errorf("unexpected policy %v", res)
+	}
+
+	req.ReportCount = maxThresholdReportCount
+	_, err = s.CreateThresholdPolicy(context.Background(), req)
+	if err != statusTooManyThresholdPolicies {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.CreateThresholdPolicy(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestCreateThresholdPolicyFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.CreateThresholdPolicyRequest{
+		CategoryUid: restrictedUID.String(), UserUid: ownerUID.String(), ReportCount: 1, Window: ptypes.DurationProto(-time.Hour),
+		ReasonCodes: []pb.ReasonCode{pb.ReasonCode(100)},
+	}
+	_, err := s.CreateThresholdPolicy(context.Background(), req)
+	if !hasViolations(err, "reportCount", "window", "reasonCodes[0]") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestDeleteThresholdPolicy(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.DeleteThresholdPolicyRequest{Uid: thresholdPolicyUID.String(), UserUid: ownerUID.String()}
+	_, err := s.DeleteThresholdPolicy(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.DeleteThresholdPolicy(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Uid = uuid.New().String()
+	_, err = s.DeleteThresholdPolicy(context.Background(), req)
+	if err != statusThresholdPolicyNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListThresholdPolicies(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListThresholdPoliciesRequest{CategoryUid: restrictedUID.String(), UserUid: ownerUID.String()}
+	res, err := s.ListThresholdPolicies(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Policies) != 1 || res.Policies[0].ReasonCodes[0] != pb.ReasonCode_SPAM {
+		t.Errorf("unexpected policies %v", res.Policies)
+	}
+}
+
+func TestReviewAutoAction(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ReviewAutoActionRequest{Uid: autoActionUID.String(), UserUid: moderatorUID.String(), KeepHidden: true}
+	res, err := s.ReviewAutoAction(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.ReviewedAt == nil || res.ReviewerUid != moderatorUID.String() || !res.KeptHidden {
+		t.Errorf("unexpected auto action %v", res)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.ReviewAutoAction(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Uid = reviewedAutoActionUID.String()
+	req.UserUid = ownerUID.String()
+	_, err = s.ReviewAutoAction(context.Background(), req)
+	if err != statusAutoActionReviewed {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Uid = uuid.New().String()
+	_, err = s.ReviewAutoAction(context.Background(), req)
+	if err != statusAutoActionNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListAutoActions(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListAutoActionsRequest{CategoryUid: restrictedUID.String(), UserUid: moderatorUID.String()}
+	res, err := s.ListAutoActions(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.AutoActions) != 2 || res.AutoActions[0].ReviewedAt != nil || res.AutoActions[1].PolicyUid != "" {
+		t.Errorf("unexpected auto actions %v", res.AutoActions)
+	}
+
+	req.UserUid = memberUID.String()
+	_, err = s.ListAutoActions(context.Background(), req)
+	if err != statusNotCategoryModerator {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = ""
+	_, err = s.ListAutoActions(context.Background(), req)
+	if !hasViolations(err, "userUid") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListEvents(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	res, err := s.ListEvents(context.Background(), &pb.ListEventsRequest{Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(res.Events) != 2 || res.Events[0].Id != 1 || res.Events[0].Type != EventContentAutoHidden {
+		t.Fatalf("unexpected events %v", res.Events)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(res.Events[0].Payload), &payload); err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if payload["postUid"] != uuid.Nil.String() {
+		t.Errorf("unexpected payload %v", payload)
+	}
+}
@@ -0,0 +1,122 @@
+package category
+
+import (
+	"fmt"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	statusParentNotFound  = status.Error(codes.NotFound, "parent category not found")
+	statusCategoryCycle   = status.Error(codes.FailedPrecondition, "category can't be moved into its own subtree")
+	statusCategoryTooDeep = status.Error(codes.FailedPrecondition, fmt.Sprintf("category tree can't be deeper than %d levels", maxCategoryDepth))
+)
+
+// ListChildCategories returns direct subcategories of category
+func (s *Server) ListChildCategories(ctx context.Context, req *pb.ListChildCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	viewerUID := v.optionalUUID("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.db.getChildCategories(uid, viewerUID, pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListCategoriesResponse)
+	for _, category := range categories {
+		res.Categories = append(res.Categories, category.SingleCategory())
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
+
+// GetCategoryAncestors returns breadcrumb of category: its ancestors starting from the root and the category itself.
+// Breadcrumb starts below the closest private ancestor user isn't member of
+func (s *Server) GetCategoryAncestors(ctx context.Context, req *pb.GetCategoryAncestorsRequest) (*pb.GetCategoryAncestorsResponse, error) {
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	viewerUID := v.optionalUUID("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.db.getCategoryAncestors(uid, viewerUID)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	res := new(pb.GetCategoryAncestorsResponse)
+	for _, category := range categories {
+		res.Categories = append(res.Categories, category.SingleCategory())
+	}
+
+	return res, nil
+}
+
+// MoveCategory moves category with all its subcategories under another parent, empty parent makes category a root.
+// Only owner of both the category and the new parent can do it
+func (s *Server) MoveCategory(ctx context.Context, req *pb.MoveCategoryRequest) (*pb.SingleCategory, error) {
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	parentUID := v.optionalUUID("parentUid", req.ParentUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if uid == parentUID {
+		return nil, statusCategoryCycle
+	}
+
+	if _, err := s.getOwnedCategory(uid, userUID); err != nil {
+		return nil, err
+	}
+
+	if parentUID != uuid.Nil {
+		switch _, err := s.getOwnedCategory(parentUID, userUID); err {
+		case nil:
+		case statusCategoryNotFound:
+			return nil, statusParentNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	category, err := s.db.moveCategory(uid, parentUID)
+	switch err {
+	case nil:
+		return category.SingleCategory(), nil
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	case errParentNotFound:
+		return nil, statusParentNotFound
+	case errCategoryCycle:
+		return nil, statusCategoryCycle
+	case errCategoryTooDeep:
+		return nil, statusCategoryTooDeep
+	default:
+		return nil, internalError(err)
+	}
+}
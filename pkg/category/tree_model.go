@@ -0,0 +1,195 @@
+package category
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// maxCategoryDepth is the maximum number of levels in category tree
+const maxCategoryDepth = 5
+
+// categoryTreeLockKey is the key of advisory lock taken by transactions changing category tree,
+// so concurrent moves can't create cycles or exceed depth limit
+const categoryTreeLockKey = 0x63617465
+
+var (
+	errParentNotFound     = errors.New("parent category not found")
+	errCategoryCycle      = errors.New("category can't be moved into its own subtree")
+	errCategoryTooDeep    = errors.New("category tree is too deep")
+	errCategoryTreeBroken = errors.New("category tree contains a cycle")
+)
+
+type queryer interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// categoryLevel returns number of ancestors of category
+func categoryLevel(q queryer, uid uuid.UUID) (int, error) {
+	query := `WITH RECURSIVE ancestors(uid, parent_uid, level) AS (
+	              SELECT uid, parent_uid, 0 FROM categories WHERE uid=$1
+	              UNION ALL
+	              SELECT c.uid, c.parent_uid, a.level + 1
+	              FROM categories c JOIN ancestors a ON c.uid=a.parent_uid
+	              WHERE a.level <= $2
+	          )
+	          SELECT max(level) FROM ancestors`
+	var level sql.NullInt64
+	if err := q.QueryRow(query, uid.String(), maxCategoryDepth).Scan(&level); err != nil {
+		return 0, err
+	}
+
+	if !level.Valid {
+		return 0, errNotFound
+	}
+
+	if level.Int64 > maxCategoryDepth {
+		return 0, errCategoryTreeBroken
+	}
+
+	return int(level.Int64), nil
+}
+
+// subtreeHeight returns number of levels below category
+func subtreeHeight(q queryer, uid uuid.UUID) (int, error) {
+	query := `WITH RECURSIVE subtree(uid, level) AS (
+	              SELECT uid, 0 FROM categories WHERE uid=$1
+	              UNION ALL
+	              SELECT c.uid, s.level + 1
+	              FROM categories c JOIN subtree s ON c.parent_uid=s.uid
+	              WHERE s.level <= $2
+	          )
+	          SELECT max(level) FROM subtree`
+	var height sql.NullInt64
+	if err := q.QueryRow(query, uid.String(), maxCategoryDepth).Scan(&height); err != nil {
+		return 0, err
+	}
+
+	if !height.Valid {
+		return 0, errNotFound
+	}
+
+	if height.Int64 > maxCategoryDepth {
+		return 0, errCategoryTreeBroken
+	}
+
+	return int(height.Int64), nil
+}
+
+// isAncestor checks if ancestorUID is uid or one of its ancestors
+func isAncestor(q queryer, ancestorUID, uid uuid.UUID) (bool, error) {
+	query := `WITH RECURSIVE ancestors(uid, parent_uid, level) AS (
+	              SELECT uid, parent_uid, 0 FROM categories WHERE uid=$1
+	              UNION ALL
+	              SELECT c.uid, c.parent_uid, a.level + 1
+	              FROM categories c JOIN ancestors a ON c.uid=a.parent_uid
+	              WHERE a.level <= $3
+	          )
+	          SELECT EXISTS (SELECT 1 FROM ancestors WHERE uid=$2)`
+	var result bool
+	err := q.QueryRow(query, uid.String(), ancestorUID.String(), maxCategoryDepth).Scan(&result)
+	return result, err
+}
+
+// lockCategoryTree takes advisory lock released on the end of tx
+func lockCategoryTree(tx *sql.Tx) error {
+	_, err := tx.Exec("SELECT pg_advisory_xact_lock($1)", categoryTreeLockKey)
+	return err
+}
+
+// checkParent checks that a subtree of given height fits under parentUID, category tree must be locked
+func checkParent(tx *sql.Tx, parentUID uuid.UUID, height int) error {
+	level, err := categoryLevel(tx, parentUID)
+	switch err {
+	case nil:
+	case errNotFound:
+		return errParentNotFound
+	default:
+		return err
+	}
+
+	if level+1+height >= maxCategoryDepth {
+		return errCategoryTooDeep
+	}
+
+	return nil
+}
+
+func (db *db) getChildCategories(uid, viewerUID uuid.UUID, pageSize, pageNumber int32) ([]*Category, error) {
+	query := "SELECT " + categoryColumns + " FROM categories WHERE parent_uid=$1 AND " + visibleTo(4) + " ORDER BY name LIMIT $2 OFFSET $3"
+	lastRecord := pageNumber * pageSize
+	return db.queryCategories(query, uid.String(), pageSize, lastRecord, nullableUUID(viewerUID))
+}
+
+// getCategoryAncestors returns ancestors of category readable by viewer starting from the root and the category itself.
+// Walking up the tree stops at the first ancestor viewer can't read, so hidden categories don't leak through breadcrumbs
+func (db *db) getCategoryAncestors(uid, viewerUID uuid.UUID) ([]*Category, error) {
+	query := `WITH RECURSIVE ancestors(uid, parent_uid, level) AS (
+	              SELECT uid, parent_uid, 0 FROM categories WHERE uid=$1 AND ` + visibleTo(3) + `
+	              UNION ALL
+	              SELECT categories.uid, categories.parent_uid, a.level + 1
+	              FROM categories JOIN ancestors a ON categories.uid=a.parent_uid
+	              WHERE a.level <= $2 AND ` + visibleTo(3) + `
+	          )
+	          SELECT ` + categoryColumns + ` FROM categories JOIN (SELECT uid, level FROM ancestors) a USING (uid)
+	          ORDER BY a.level DESC`
+	result, err := db.queryCategories(query, uid.String(), maxCategoryDepth, nullableUUID(viewerUID))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return nil, errNotFound
+	}
+
+	return result, nil
+}
+
+func (db *db) moveCategory(uid, parentUID uuid.UUID) (*Category, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	if err := lockCategoryTree(tx); err != nil {
+		return nil, err
+	}
+
+	height, err := subtreeHeight(tx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	var parent interface{}
+	if parentUID != uuid.Nil {
+		cycle, err := isAncestor(tx, uid, parentUID)
+		if err != nil {
+			return nil, err
+		}
+
+		if cycle {
+			return nil, errCategoryCycle
+		}
+
+		if err := checkParent(tx, parentUID, height); err != nil {
+			return nil, err
+		}
+
+		parent = parentUID.String()
+	}
+
+	query := "UPDATE categories SET parent_uid=$1 WHERE uid=$2 RETURNING " + categoryColumns
+	category, err := scanCategory(tx.QueryRow(query, parent, uid.String()))
+	switch err {
+	case nil:
+		return category, tx.Commit()
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+}
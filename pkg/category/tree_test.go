@@ -0,0 +1,156 @@
+package category
+
+import (
+	"testing"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+var (
+	rootUID       = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000001"))
+	childUID      = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000002"))
+	deepParentUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000003"))
+	// publicChildUID is a public category under private one
+	publicChildUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000004"))
+)
+
+func (mdb *mockdb) getChildCategories(uid, viewerUID uuid.UUID, pageSize, pageNumber int32) ([]*Category, error) {
+	if uid != rootUID {
+		return make([]*Category, 0), nil
+	}
+
+	return []*Category{{UID: childUID, UserUID: uuid.Nil, ParentUID: rootUID, Name: "Go", Description: "aaa"}}, nil
+}
+
+// mockdb has child of root and public child of private category
+func (mdb *mockdb) getCategoryAncestors(uid, viewerUID uuid.UUID) ([]*Category, error) {
+	switch uid {
+	case rootUID:
+		return []*Category{{UID: rootUID, Name: "Programming"}}, nil
+	case childUID:
+		return []*Category{{UID: rootUID, Name: "Programming"}, {UID: childUID, ParentUID: rootUID, Name: "Go"}}, nil
+	case publicChildUID:
+		child := &Category{UID: publicChildUID, ParentUID: privateUID, Name: "Public"}
+		if viewerUID != memberUID {
+			return []*Category{child}, nil
+		}
+
+		return []*Category{{UID: privateUID, Name: "Private", Visibility: VisibilityPrivate}, child}, nil
+	default:
+		return nil, errNotFound
+	}
+}
+
+func (mdb *mockdb) moveCategory(uid, parentUID uuid.UUID) (*Category, error) {
+	switch {
+	case uid == rootUID && parentUID == childUID:
+		return nil, errCategoryCycle
+	case parentUID == deepParentUID:
+		return nil, errCategoryTooDeep
+	case uid != childUID:
+		return nil, errNotFound
+	}
+
+	return &Category{UID: uid, ParentUID: parentUID, Name: "Go"}, nil
+}
+
+func TestCreateSubcategory(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.CreateCategoryRequest{Name: "success", UserUid: nilUIDString, Description: "yeah", ParentUid: rootUID.String()}
+	res, err := s.CreateCategory(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.ParentUid != rootUID.String() {
+		t.Errorf("expected parent %s, got %s", rootUID, res.ParentUid)
+	}
+
+	req.ParentUid = deepParentUID.String()
+	_, err = s.CreateCategory(context.Background(), req)
+	if err != statusCategoryTooDeep {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListChildCategories(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListChildCategoriesRequest{Uid: rootUID.String()}
+	res, err := s.ListChildCategories(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Categories) != 1 || res.Categories[0].ParentUid != rootUID.String() {
+		t.Errorf("unexpected categories %v", res.Categories)
+	}
+}
+
+func TestGetCategoryAncestors(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.GetCategoryAncestorsRequest{Uid: childUID.String()}
+	res, err := s.GetCategoryAncestors(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Categories) != 2 || res.Categories[0].Uid != rootUID.String() || res.Categories[0].ParentUid != "" {
+		t.Errorf("unexpected breadcrumb %v", res.Categories)
+	}
+
+	req = &pb.GetCategoryAncestorsRequest{Uid: nilUIDString}
+	_, err = s.GetCategoryAncestors(context.Background(), req)
+	if err != statusCategoryNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestGetCategoryAncestorsPrivate(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.GetCategoryAncestorsRequest{Uid: publicChildUID.String()}
+	res, err := s.GetCategoryAncestors(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Categories) != 1 || res.Categories[0].Uid != publicChildUID.String() {
+		t.Errorf("unexpected breadcrumb %v", res.Categories)
+	}
+
+	req.UserUid = memberUID.String()
+	res, err = s.GetCategoryAncestors(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Categories) != 2 || res.Categories[0].Uid != privateUID.String() {
+		t.Errorf("unexpected breadcrumb %v", res.Categories)
+	}
+}
+
+func TestMoveCategory(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.MoveCategoryRequest{Uid: childUID.String(), UserUid: nilUIDString}
+	res, err := s.MoveCategory(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.ParentUid != "" {
+		t.Errorf("expected root category, got parent %s", res.ParentUid)
+	}
+}
+
+func TestMoveCategoryFail(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	tests := []struct {
+		uid, parentUID, userUID uuid.UUID
+		err                     error
+	}{
+		{childUID, childUID, uuid.Nil, statusCategoryCycle},
+		{rootUID, childUID, uuid.Nil, statusCategoryCycle},
+		{childUID, deepParentUID, uuid.Nil, statusCategoryTooDeep},
+		{uuid.Nil, rootUID, uuid.Nil, statusCategoryNotFound},
+		{childUID, rootUID, ownerUID, statusNotCategoryOwner},
+		{childUID, restrictedUID, uuid.Nil, statusNotCategoryOwner},
+		{childUID, privateUID, uuid.Nil, statusParentNotFound},
+	}
+
+	for _, tt := range tests {
+		req := &pb.MoveCategoryRequest{Uid: tt.uid.String(), ParentUid: tt.parentUID.String(), UserUid: tt.userUID.String()}
+		_, err := s.MoveCategory(context.Background(), req)
+		if err != tt.err {
+			t.Errorf("moving %s under %s: unexpected error %v", tt.uid, tt.parentUID, err)
+		}
+	}
+}
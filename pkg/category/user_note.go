@@ -0,0 +1,272 @@
+package category
+
+import (
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	statusNoteAccessDenied      = status.Error(codes.PermissionDenied, "user can't access notes of category")
+	statusNoteAccessNotFound    = status.Error(codes.NotFound, "user has no access to notes of category")
+	statusUserNoteNotFound      = status.Error(codes.NotFound, "note not found")
+	statusOwnerNoteAccessChange = status.Error(codes.FailedPrecondition, "category owner always has access to notes")
+)
+
+// SingleNoteAccessGrant converts NoteAccessGrant to SingleNoteAccessGrant
+func (g *NoteAccessGrant) SingleNoteAccessGrant() (*pb.SingleNoteAccessGrant, error) {
+	createdAtProto, err := ptypes.TimestampProto(g.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleNoteAccessGrant)
+	res.CategoryUid = g.CategoryUID.String()
+	res.UserUid = g.UserUID.String()
+	res.CreatedAt = createdAtProto
+
+	return res, nil
+}
+
+// SingleUserNote converts UserNote to SingleUserNote
+func (n *UserNote) SingleUserNote() (*pb.SingleUserNote, error) {
+	createdAtProto, err := ptypes.TimestampProto(n.CreatedAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.SingleUserNote)
+	res.Uid = n.UID.String()
+	res.CategoryUid = n.CategoryUID.String()
+	res.UserUid = n.UserUID.String()
+	res.AuthorUid = n.AuthorUID.String()
+	res.Text = n.Text
+	if n.ReportUID != uuid.Nil {
+		res.ReportUid = n.ReportUID.String()
+	}
+
+	res.CreatedAt = createdAtProto
+
+	return res, nil
+}
+
+// checkNoteAccess returns PermissionDenied status unless user is owner of category or was granted access to its notes
+func (s *Server) checkNoteAccess(categoryUID, userUID uuid.UUID) error {
+	category, err := s.db.getCategoryInfo(categoryUID)
+	switch err {
+	case nil:
+	case errNotFound:
+		return statusCategoryNotFound
+	default:
+		return internalError(err)
+	}
+
+	if category.UserUID == userUID {
+		return nil
+	}
+
+	ok, err := s.db.hasNoteAccess(categoryUID, userUID)
+	if err != nil {
+		return internalError(err)
+	}
+
+	if !ok {
+		return statusNoteAccessDenied
+	}
+
+	return nil
+}
+
+// GrantNoteAccess allows user to read and write notes of category, only owner can do it
+func (s *Server) GrantNoteAccess(ctx context.Context, req *pb.NoteAccessRequest) (*pb.SingleNoteAccessGrant, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	granteeUID := v.uuid("granteeUid", req.GranteeUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	if granteeUID == userUID {
+		return nil, statusOwnerNoteAccessChange
+	}
+
+	grant, err := s.db.grantNoteAccess(categoryUID, granteeUID)
+	switch err {
+	case nil:
+		return grant.SingleNoteAccessGrant()
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// RevokeNoteAccess takes access to notes of category away from user, only owner can do it
+func (s *Server) RevokeNoteAccess(ctx context.Context, req *pb.NoteAccessRequest) (*pb.RevokeNoteAccessResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	granteeUID := v.uuid("granteeUid", req.GranteeUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	if granteeUID == userUID {
+		return nil, statusOwnerNoteAccessChange
+	}
+
+	switch err := s.db.revokeNoteAccess(categoryUID, granteeUID); err {
+	case nil:
+		return new(pb.RevokeNoteAccessResponse), nil
+	case errNotFound:
+		return nil, statusNoteAccessNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ListNoteAccessGrants returns users who were granted access to notes of category, only owner can list them
+func (s *Server) ListNoteAccessGrants(ctx context.Context, req *pb.ListNoteAccessGrantsRequest) (*pb.ListNoteAccessGrantsResponse, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedCategory(categoryUID, userUID); err != nil {
+		return nil, err
+	}
+
+	grants, err := s.db.getNoteAccessGrants(categoryUID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListNoteAccessGrantsResponse)
+	for _, grant := range grants {
+		grantResponse, err := grant.SingleNoteAccessGrant()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Grants = append(res.Grants, grantResponse)
+	}
+
+	return res, nil
+}
+
+// CreateUserNote adds note about user to category, author must have access to notes of category
+func (s *Server) CreateUserNote(ctx context.Context, req *pb.CreateUserNoteRequest) (*pb.SingleUserNote, error) {
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	authorUID := v.uuid("authorUid", req.AuthorUid)
+	text := v.text("text", req.Text, userNoteRules)
+	reportUID := v.optionalUUID("reportUid", req.ReportUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNoteAccess(categoryUID, authorUID); err != nil {
+		return nil, err
+	}
+
+	note, err := s.db.createUserNote(categoryUID, userUID, authorUID, reportUID, text)
+	switch err {
+	case nil:
+		return note.SingleUserNote()
+	case errNotFound:
+		return nil, statusCategoryNotFound
+	case errReportNotFound:
+		return nil, statusReportNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
+
+// ListUserNotes returns notes about user in category, newest first. Viewer must have access to notes of category
+func (s *Server) ListUserNotes(ctx context.Context, req *pb.ListUserNotesRequest) (*pb.ListUserNotesResponse, error) {
+	var pageSize int32
+	if req.PageSize == 0 {
+		pageSize = 10
+	} else {
+		pageSize = req.PageSize
+	}
+
+	v := new(validator)
+	categoryUID := v.uuid("categoryUid", req.CategoryUid)
+	userUID := v.uuid("userUid", req.UserUid)
+	viewerUID := v.uuid("viewerUid", req.ViewerUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNoteAccess(categoryUID, viewerUID); err != nil {
+		return nil, err
+	}
+
+	notes, err := s.db.getUserNotes(categoryUID, userUID, pageSize, req.PageNumber)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := new(pb.ListUserNotesResponse)
+	for _, note := range notes {
+		noteResponse, err := note.SingleUserNote()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Notes = append(res.Notes, noteResponse)
+	}
+
+	res.PageSize = pageSize
+	res.PageNumber = req.PageNumber
+
+	return res, nil
+}
+
+// DeleteUserNote deletes note, viewer must have access to notes of its category
+func (s *Server) DeleteUserNote(ctx context.Context, req *pb.DeleteUserNoteRequest) (*pb.DeleteUserNoteResponse, error) {
+	v := new(validator)
+	uid := v.uuid("uid", req.Uid)
+	viewerUID := v.uuid("viewerUid", req.ViewerUid)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	note, err := s.db.getUserNote(uid)
+	switch err {
+	case nil:
+	case errNotFound:
+		return nil, statusUserNoteNotFound
+	default:
+		return nil, internalError(err)
+	}
+
+	if err := s.checkNoteAccess(note.CategoryUID, viewerUID); err != nil {
+		return nil, err
+	}
+
+	switch err := s.db.deleteUserNote(uid); err {
+	case nil:
+		return new(pb.DeleteUserNoteResponse), nil
+	case errNotFound:
+		return nil, statusUserNoteNotFound
+	default:
+		return nil, internalError(err)
+	}
+}
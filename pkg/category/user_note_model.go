@@ -0,0 +1,255 @@
+package category
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// userNotesReportFKey is the name of foreign key from user_notes to reports
+const userNotesReportFKey = "user_notes_report_uid_fkey"
+
+var errReportNotFound = errors.New("report not found")
+
+// UserNote describes private note of category moderator about user
+type UserNote struct {
+	UID         uuid.UUID
+	CategoryUID uuid.UUID
+	UserUID     uuid.UUID
+	AuthorUID   uuid.UUID
+	ReportUID   uuid.UUID
+	Text        string
+	CreatedAt   time.Time
+}
+
+// NoteAccessGrant describes user allowed by category owner to read and write user notes
+type NoteAccessGrant struct {
+	CategoryUID uuid.UUID
+	UserUID     uuid.UUID
+	CreatedAt   time.Time
+}
+
+func (db *db) hasNoteAccess(categoryUID, userUID uuid.UUID) (bool, error) {
+	query := "SELECT EXISTS (SELECT 1 FROM note_access_grants WHERE category_uid=$1 AND user_uid=$2)"
+	var result bool
+	err := db.QueryRow(query, categoryUID.String(), userUID.String()).Scan(&result)
+	return result, err
+}
+
+func (db *db) grantNoteAccess(categoryUID, userUID uuid.UUID) (*NoteAccessGrant, error) {
+	grant := new(NoteAccessGrant)
+	grant.CategoryUID = categoryUID
+	grant.UserUID = userUID
+	grant.CreatedAt = time.Now()
+
+	query := `INSERT INTO note_access_grants (category_uid, user_uid, created_at) VALUES ($1, $2, $3)
+	          ON CONFLICT (category_uid, user_uid) DO UPDATE SET created_at=note_access_grants.created_at
+	          RETURNING created_at`
+	err := db.QueryRow(query, categoryUID.String(), userUID.String(), grant.CreatedAt).Scan(&grant.CreatedAt)
+	if isForeignKeyViolation(err) {
+		return nil, errNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return grant, nil
+}
+
+func (db *db) revokeNoteAccess(categoryUID, userUID uuid.UUID) error {
+	query := "DELETE FROM note_access_grants WHERE category_uid=$1 AND user_uid=$2"
+	result, err := db.Exec(query, categoryUID.String(), userUID.String())
+	if err != nil {
+		return err
+	}
+
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if nRows == 0 {
+		return errNotFound
+	}
+
+	return nil
+}
+
+func (db *db) getNoteAccessGrants(categoryUID uuid.UUID) ([]*NoteAccessGrant, error) {
+	query := "SELECT user_uid, created_at FROM note_access_grants WHERE category_uid=$1 ORDER BY created_at"
+	rows, err := db.Query(query, categoryUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*NoteAccessGrant, 0)
+	for rows.Next() {
+		grant := new(NoteAccessGrant)
+		var userUID string
+		if err := rows.Scan(&userUID, &grant.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		grant.UserUID, err = uuid.Parse(userUID)
+		if err != nil {
+			return nil, err
+		}
+
+		grant.CategoryUID = categoryUID
+
+		result = append(result, grant)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (db *db) createUserNote(categoryUID, userUID, authorUID, reportUID uuid.UUID, text string) (*UserNote, error) {
+	note := new(UserNote)
+
+	query := `INSERT INTO user_notes (uid, category_uid, user_uid, author_uid, report_uid, text, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	uid := uuid.New()
+
+	note.UID = uid
+	note.CategoryUID = categoryUID
+	note.UserUID = userUID
+	note.AuthorUID = authorUID
+	note.ReportUID = reportUID
+	note.Text = text
+	note.CreatedAt = time.Now()
+
+	_, err := db.Exec(query,
+		uid.String(), categoryUID.String(), userUID.String(), authorUID.String(), nullableUUID(reportUID), text, note.CreatedAt,
+	)
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == foreignKeyViolation {
+		if pqErr.Constraint == userNotesReportFKey {
+			return nil, errReportNotFound
+		}
+
+		return nil, errNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+func (db *db) getUserNote(uid uuid.UUID) (*UserNote, error) {
+	query := "SELECT category_uid, user_uid, author_uid, report_uid, text, created_at FROM user_notes WHERE uid=$1"
+	note := new(UserNote)
+	var categoryUID, userUID, authorUID string
+	var reportUID sql.NullString
+	err := db.QueryRow(query, uid.String()).Scan(&categoryUID, &userUID, &authorUID, &reportUID, &note.Text, &note.CreatedAt)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil, errNotFound
+	default:
+		return nil, err
+	}
+
+	note.UID = uid
+	note.CategoryUID, err = uuid.Parse(categoryUID)
+	if err != nil {
+		return nil, err
+	}
+
+	note.UserUID, err = uuid.Parse(userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	note.AuthorUID, err = uuid.Parse(authorUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if reportUID.Valid {
+		note.ReportUID, err = uuid.Parse(reportUID.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return note, nil
+}
+
+func (db *db) getUserNotes(categoryUID, userUID uuid.UUID, pageSize, pageNumber int32) ([]*UserNote, error) {
+	query := `SELECT uid, author_uid, report_uid, text, created_at FROM user_notes
+	          WHERE category_uid=$1 AND user_uid=$2
+	          ORDER BY created_at DESC LIMIT $3 OFFSET $4`
+	lastRecord := pageNumber * pageSize
+	rows, err := db.Query(query, categoryUID.String(), userUID.String(), pageSize, lastRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	result := make([]*UserNote, 0)
+	for rows.Next() {
+		note := new(UserNote)
+		var uid, authorUID string
+		var reportUID sql.NullString
+		if err := rows.Scan(&uid, &authorUID, &reportUID, &note.Text, &note.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		note.UID, err = uuid.Parse(uid)
+		if err != nil {
+			return nil, err
+		}
+
+		note.AuthorUID, err = uuid.Parse(authorUID)
+		if err != nil {
+			return nil, err
+		}
+
+		if reportUID.Valid {
+			note.ReportUID, err = uuid.Parse(reportUID.String)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		note.CategoryUID = categoryUID
+		note.UserUID = userUID
+
+		result = append(result, note)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (db *db) deleteUserNote(uid uuid.UUID) error {
+	query := "DELETE FROM user_notes WHERE uid=$1"
+	result, err := db.Exec(query, uid.String())
+	if err != nil {
+		return err
+	}
+
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if nRows == 0 {
+		return errNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+var userNoteUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000050"))
+
+// moderatorUID has access to notes of every category
+func (mdb *mockdb) hasNoteAccess(categoryUID, userUID uuid.UUID) (bool, error) {
+	return userUID == moderatorUID, nil
+}
+
+func (mdb *mockdb) grantNoteAccess(categoryUID, userUID uuid.UUID) (*NoteAccessGrant, error) {
+	return &NoteAccessGrant{CategoryUID: categoryUID, UserUID: userUID, CreatedAt: time.Now()}, nil
+}
+
+func (mdb *mockdb) revokeNoteAccess(categoryUID, userUID uuid.UUID) error {
+	if userUID != moderatorUID {
+		return errNotFound
+	}
+
+	return nil
+}
+
+func (mdb *mockdb) getNoteAccessGrants(categoryUID uuid.UUID) ([]*NoteAccessGrant, error) {
+	return []*NoteAccessGrant{{CategoryUID: categoryUID, UserUID: moderatorUID, CreatedAt: time.Now()}}, nil
+}
+
+func (mdb *mockdb) createUserNote(categoryUID, userUID, authorUID, reportUID uuid.UUID, text string) (*UserNote, error) {
+	if reportUID == rootUID {
+		return nil, errReportNotFound
+	}
+
+	return &UserNote{
+		UID: uuid.New(), CategoryUID: categoryUID, UserUID: userUID, AuthorUID: authorUID, ReportUID: reportUID, Text: text, CreatedAt: time.Now(),
+	}, nil
+}
+
+func (mdb *mockdb) getUserNote(uid uuid.UUID) (*UserNote, error) {
+	if uid != userNoteUID {
+		return nil, errNotFound
+	}
+
+	return &UserNote{UID: uid, CategoryUID: privateUID, UserUID: memberUID, AuthorUID: moderatorUID, Text: "aaa", CreatedAt: time.Now()}, nil
+}
+
+func (mdb *mockdb) getUserNotes(categoryUID, userUID uuid.UUID, pageSize, pageNumber int32) ([]*UserNote, error) {
+	return []*UserNote{{UID: userNoteUID, CategoryUID: categoryUID, UserUID: userUID, AuthorUID: moderatorUID, Text: "aaa", CreatedAt: time.Now()}}, nil
+}
+
+func (mdb *mockdb) deleteUserNote(uid uuid.UUID) error {
+	return nil
+}
+
+func TestGrantNoteAccess(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.NoteAccessRequest{CategoryUid: privateUID.String(), UserUid: ownerUID.String(), GranteeUid: memberUID.String()}
+	res, err := s.GrantNoteAccess(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if res.UserUid != memberUID.String() {
+		t.Errorf("unexpected grant %v", res)
+	}
+
+	req.GranteeUid = ownerUID.String()
+	_, err = s.GrantNoteAccess(context.Background(), req)
+	if err != statusOwnerNoteAccessChange {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.UserUid = moderatorUID.String()
+	_, err = s.GrantNoteAccess(context.Background(), req)
+	if err != statusCategoryNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestRevokeNoteAccess(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.NoteAccessRequest{CategoryUid: restrictedUID.String(), UserUid: ownerUID.String(), GranteeUid: moderatorUID.String()}
+	_, err := s.RevokeNoteAccess(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.GranteeUid = memberUID.String()
+	_, err = s.RevokeNoteAccess(context.Background(), req)
+	if err != statusNoteAccessNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListNoteAccessGrants(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListNoteAccessGrantsRequest{CategoryUid: restrictedUID.String(), UserUid: ownerUID.String()}
+	res, err := s.ListNoteAccessGrants(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Grants) != 1 {
+		t.Errorf("unexpected grants %v", res.Grants)
+	}
+
+	req.UserUid = moderatorUID.String()
+	_, err = s.ListNoteAccessGrants(context.Background(), req)
+	if err != statusNotCategoryOwner {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestCreateUserNote(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	for _, authorUID := range []uuid.UUID{ownerUID, moderatorUID} {
+		req := &pb.CreateUserNoteRequest{
+			CategoryUid: restrictedUID.String(), UserUid: memberUID.String(), AuthorUid: authorUID.String(), Text: "repeat offender",
+		}
+		res, err := s.CreateUserNote(context.Background(), req)
+		if err != nil {
+			t.Errorf("author %s: unexpected error %v", authorUID, err)
+		} else if res.ReportUid != "" || res.AuthorUid != authorUID.String() {
+			t.Errorf("unexpected note %v", res)
+		}
+	}
+
+	req := &pb.CreateUserNoteRequest{
+		CategoryUid: restrictedUID.String(), UserUid: memberUID.String(), AuthorUid: memberUID.String(), Text: "aaa",
+	}
+	_, err := s.CreateUserNote(context.Background(), req)
+	if err != statusNoteAccessDenied {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.AuthorUid = moderatorUID.String()
+	req.ReportUid = rootUID.String()
+	_, err = s.CreateUserNote(context.Background(), req)
+	if err != statusReportNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Text = ""
+	_, err = s.CreateUserNote(context.Background(), req)
+	if !hasViolations(err, "text") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListUserNotes(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.ListUserNotesRequest{CategoryUid: restrictedUID.String(), UserUid: memberUID.String(), ViewerUid: moderatorUID.String()}
+	res, err := s.ListUserNotes(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	} else if len(res.Notes) != 1 {
+		t.Errorf("unexpected notes %v", res.Notes)
+	}
+
+	req.ViewerUid = memberUID.String()
+	_, err = s.ListUserNotes(context.Background(), req)
+	if err != statusNoteAccessDenied {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestDeleteUserNote(t *testing.T) {
+	s := &Server{db: &mockdb{}}
+	req := &pb.DeleteUserNoteRequest{Uid: userNoteUID.String(), ViewerUid: ownerUID.String()}
+	_, err := s.DeleteUserNote(context.Background(), req)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.ViewerUid = memberUID.String()
+	_, err = s.DeleteUserNote(context.Background(), req)
+	if err != statusNoteAccessDenied {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	req.Uid = nilUIDString
+	_, err = s.DeleteUserNote(context.Background(), req)
+	if err != statusUserNoteNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
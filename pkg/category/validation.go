@@ -0,0 +1,456 @@
+package category
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	minCategoryNameLength        = 2
+	maxCategoryNameLength        = 80
+	maxCategoryDescriptionLength = 160
+	maxReportReasonLength        = 160
+	maxBanReasonLength           = 160
+	maxStrikeReasonLength        = 160
+	maxUserNoteLength            = 1000
+	maxReportNoteLength          = 2000
+	maxJustificationLength       = 1000
+	maxRuleTitleLength           = 100
+	maxRuleDescriptionLength     = 500
+	maxSearchQueryLength         = 100
+	maxReportFilterCategories    = 100
+	maxHandlerKeywords           = 20
+	maxHandlerKeywordLength      = 50
+)
+
+// defaultLanguage is the text search configuration used when none is given
+const defaultLanguage = "english"
+
+// languages lists supported Postgres text search configurations
+var languages = map[string]bool{
+	"simple":     true,
+	"english":    true,
+	"russian":    true,
+	"german":     true,
+	"french":     true,
+	"spanish":    true,
+	"italian":    true,
+	"portuguese": true,
+	"dutch":      true,
+}
+
+// categoryNamePunctuation lists non-alphanumeric characters allowed in category names
+const categoryNamePunctuation = " -_.&'+#"
+
+// textRules describes how a free-text field is normalized and checked
+type textRules struct {
+	name       string
+	minLength  int
+	maxLength  int
+	singleLine bool
+	allowed    func(rune) bool
+}
+
+var (
+	categoryNameRules = textRules{
+		name:       "category name",
+		minLength:  minCategoryNameLength,
+		maxLength:  maxCategoryNameLength,
+		singleLine: true,
+		allowed:    isCategoryNameRune,
+	}
+	categoryDescriptionRules = textRules{
+		name:      "category description",
+		minLength: 1,
+		maxLength: maxCategoryDescriptionLength,
+		allowed:   isTextRune,
+	}
+	reportReasonRules = textRules{
+		name:      "report reason",
+		minLength: 1,
+		maxLength: maxReportReasonLength,
+		allowed:   isTextRune,
+	}
+	banReasonRules = textRules{
+		name:      "ban reason",
+		minLength: 1,
+		maxLength: maxBanReasonLength,
+		allowed:   isTextRune,
+	}
+	strikeReasonRules = textRules{
+		name:      "strike reason",
+		minLength: 1,
+		maxLength: maxStrikeReasonLength,
+		allowed:   isTextRune,
+	}
+	userNoteRules = textRules{
+		name:      "note",
+		minLength: 1,
+		maxLength: maxUserNoteLength,
+		allowed:   isTextRune,
+	}
+	reportNoteRules = textRules{
+		name:      "note",
+		minLength: 1,
+		maxLength: maxReportNoteLength,
+		allowed:   isTextRune,
+	}
+	justificationRules = textRules{
+		name:      "justification",
+		minLength: 1,
+		maxLength: maxJustificationLength,
+		allowed:   isTextRune,
+	}
+	ruleTitleRules = textRules{
+		name:       "rule title",
+		minLength:  1,
+		maxLength:  maxRuleTitleLength,
+		singleLine: true,
+		allowed:    isTextRune,
+	}
+	ruleDescriptionRules = textRules{
+		name:      "rule description",
+		minLength: 1,
+		maxLength: maxRuleDescriptionLength,
+		allowed:   isTextRune,
+	}
+	suggestPrefixRules = textRules{
+		name:       "prefix",
+		minLength:  1,
+		maxLength:  maxCategoryNameLength,
+		singleLine: true,
+		allowed:    isTextRune,
+	}
+	reportSearchRules = textRules{
+		name:       "reason text",
+		minLength:  1,
+		maxLength:  maxReportReasonLength,
+		singleLine: true,
+		allowed:    isTextRune,
+	}
+	handlerKeywordRules = textRules{
+		name:       "keyword",
+		minLength:  1,
+		maxLength:  maxHandlerKeywordLength,
+		singleLine: true,
+		allowed:    isTextRune,
+	}
+	searchQueryRules = textRules{
+		name:       "search query",
+		minLength:  1,
+		maxLength:  maxSearchQueryLength,
+		singleLine: true,
+		allowed:    isTextRune,
+	}
+)
+
+func isCategoryNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || strings.ContainsRune(categoryNamePunctuation, r)
+}
+
+func isTextRune(r rune) bool {
+	return r == '\n' || r == '\t' || unicode.IsPrint(r) || unicode.IsSpace(r)
+}
+
+// normalizeText converts s to NFC, unifies line endings and trims surrounding whitespace.
+// Single line values also have inner whitespace runs collapsed to a single space.
+func normalizeText(s string, singleLine bool) string {
+	s = norm.NFC.String(s)
+	s = strings.Replace(s, "\r\n", "\n", -1)
+	if singleLine {
+		return strings.Join(strings.Fields(s), " ")
+	}
+
+	return strings.TrimSpace(s)
+}
+
+// validator collects field violations of a single request
+type validator struct {
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func (v *validator) addViolation(field, description string) {
+	v.violations = append(v.violations, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+}
+
+// uuid parses value of field as UUID
+func (v *validator) uuid(field, value string) uuid.UUID {
+	uid, err := uuid.Parse(value)
+	if err != nil {
+		v.addViolation(field, "invalid UUID")
+	}
+
+	return uid
+}
+
+// optionalUUID parses value of field as UUID, empty value is parsed as uuid.Nil
+func (v *validator) optionalUUID(field, value string) uuid.UUID {
+	if value == "" {
+		return uuid.Nil
+	}
+
+	return v.uuid(field, value)
+}
+
+// text normalizes value of field and checks it against rules
+func (v *validator) text(field, value string, rules textRules) string {
+	value = normalizeText(value, rules.singleLine)
+	length := utf8.RuneCountInString(value)
+	switch {
+	case length == 0:
+		v.addViolation(field, rules.name+" is required")
+		return value
+	case length < rules.minLength:
+		v.addViolation(field, fmt.Sprintf("%s must be at least %d characters long", rules.name, rules.minLength))
+	case length > rules.maxLength:
+		v.addViolation(field, fmt.Sprintf("%s must be at most %d characters long", rules.name, rules.maxLength))
+	}
+
+	for _, r := range value {
+		if r == utf8.RuneError || !rules.allowed(r) {
+			v.addViolation(field, fmt.Sprintf("%s contains forbidden character %q", rules.name, r))
+			break
+		}
+	}
+
+	return value
+}
+
+// optionalText is like text, but empty value of field is allowed
+func (v *validator) optionalText(field, value string, rules textRules) string {
+	if normalizeText(value, rules.singleLine) == "" {
+		return ""
+	}
+
+	return v.text(field, value, rules)
+}
+
+// slug checks that value of field looks like a slug generated by slugify
+func (v *validator) slug(field, value string) string {
+	if value == "" {
+		v.addViolation(field, "slug is required")
+		return value
+	}
+
+	if len(value) > maxSlugLength || !isSlug(value) {
+		v.addViolation(field, "invalid slug")
+	}
+
+	return value
+}
+
+func isSlug(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// inviteCode checks that value of field looks like a code generated by newInviteCode
+func (v *validator) inviteCode(field, value string) string {
+	if value == "" {
+		v.addViolation(field, "invite code is required")
+		return value
+	}
+
+	if len(value) != base64.RawURLEncoding.EncodedLen(inviteCodeBytes) || !isInviteCode(value) {
+		v.addViolation(field, "invalid invite code")
+	}
+
+	return value
+}
+
+func isInviteCode(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// language checks that value of field is a supported text search language, empty value means default language
+func (v *validator) language(field, value string) string {
+	if value == "" {
+		return defaultLanguage
+	}
+
+	value = strings.ToLower(value)
+	if !languages[value] {
+		v.addViolation(field, fmt.Sprintf("unsupported language %q", value))
+	}
+
+	return value
+}
+
+// visibility converts value of field to Visibility
+func (v *validator) visibility(field string, value pb.Visibility) Visibility {
+	visibility, ok := visibilities[value]
+	if !ok {
+		v.addViolation(field, fmt.Sprintf("unknown visibility %d", value))
+	}
+
+	return visibility
+}
+
+// reasonCode converts value of field to ReasonCode
+func (v *validator) reasonCode(field string, value pb.ReasonCode) ReasonCode {
+	code, ok := reasonCodes[value]
+	if !ok {
+		v.addViolation(field, fmt.Sprintf("unknown reason code %d", value))
+	}
+
+	return code
+}
+
+// outcome converts value of field to Outcome
+func (v *validator) outcome(field string, value pb.Outcome) Outcome {
+	outcome, ok := outcomes[value]
+	if !ok {
+		v.addViolation(field, fmt.Sprintf("unknown outcome %d", value))
+	}
+
+	return outcome
+}
+
+// retentionAction converts value of field to RetentionAction
+func (v *validator) retentionAction(field string, value pb.RetentionAction) RetentionAction {
+	action, ok := retentionActions[value]
+	if !ok {
+		v.addViolation(field, fmt.Sprintf("unknown retention action %d", value))
+	}
+
+	return action
+}
+
+// reportOrder converts value of field to reportOrder
+func (v *validator) reportOrder(field string, value pb.ReportOrder) reportOrder {
+	order, ok := reportOrders[value]
+	if !ok {
+		v.addViolation(field, fmt.Sprintf("unknown report order %d", value))
+	}
+
+	return order
+}
+
+// keywords normalizes values of field to distinct lower case keywords
+func (v *validator) keywords(field string, values []string) []string {
+	if len(values) > maxHandlerKeywords {
+		v.addViolation(field, fmt.Sprintf("at most %d keywords can be given", maxHandlerKeywords))
+		return nil
+	}
+
+	result := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for i, value := range values {
+		keyword := strings.ToLower(v.text(fmt.Sprintf("%s[%d]", field, i), value, handlerKeywordRules))
+		if !seen[keyword] {
+			seen[keyword] = true
+			result = append(result, keyword)
+		}
+	}
+
+	return result
+}
+
+// assignmentStrategy converts value of field to AssignmentStrategy
+func (v *validator) assignmentStrategy(field string, value pb.AssignmentStrategy) AssignmentStrategy {
+	strategy, ok := assignmentStrategies[value]
+	if !ok {
+		v.addViolation(field, fmt.Sprintf("unknown assignment strategy %d", value))
+	}
+
+	return strategy
+}
+
+// optionalTime converts optional value of field to time, missing value is zero time
+func (v *validator) optionalTime(field string, value *timestamp.Timestamp) time.Time {
+	if value == nil {
+		return time.Time{}
+	}
+
+	result, err := ptypes.Timestamp(value)
+	if err != nil {
+		v.addViolation(field, "invalid timestamp")
+	}
+
+	return result
+}
+
+// expirationTime converts optional value of field to time which must be in the future, missing value is zero time
+func (v *validator) expirationTime(field string, value *timestamp.Timestamp) time.Time {
+	if value == nil {
+		return time.Time{}
+	}
+
+	result, err := ptypes.Timestamp(value)
+	switch {
+	case err != nil:
+		v.addViolation(field, "invalid timestamp")
+	case !result.After(time.Now()):
+		v.addViolation(field, "expiration time must be in the future")
+	}
+
+	return result
+}
+
+// duration converts required value of field to positive duration not longer than max
+func (v *validator) duration(field string, value *duration.Duration, max time.Duration) time.Duration {
+	if value == nil {
+		v.addViolation(field, "duration is required")
+		return 0
+	}
+
+	result, err := ptypes.Duration(value)
+	switch {
+	case err != nil:
+		v.addViolation(field, "invalid duration")
+	case result <= 0:
+		v.addViolation(field, "duration must be positive")
+	case result > max:
+		v.addViolation(field, fmt.Sprintf("duration must be at most %s", max))
+	}
+
+	return result
+}
+
+// err returns InvalidArgument status with BadRequest details or nil if there are no violations
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, len(v.violations))
+	for i, violation := range v.violations {
+		descriptions[i] = violation.Field + ": " + violation.Description
+	}
+
+	st := status.New(codes.InvalidArgument, strings.Join(descriptions, "; "))
+	st, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v.violations})
+	if err != nil {
+		return internalError(err)
+	}
+
+	return st.Err()
+}
@@ -0,0 +1,91 @@
+package category
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// hasViolations checks that err is InvalidArgument with violations of exactly given fields
+func hasViolations(err error, fields ...string) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return false
+	}
+
+	violated := make(map[string]bool)
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				violated[violation.Field] = true
+			}
+		}
+	}
+
+	if len(violated) != len(fields) {
+		return false
+	}
+
+	for _, field := range fields {
+		if !violated[field] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestNormalizeText(t *testing.T) {
+	tests := []struct {
+		in         string
+		singleLine bool
+		out        string
+	}{
+		{"  golang  ", true, "golang"},
+		{"go \t lang", true, "go lang"},
+		{"line\r\nbreak", true, "line break"},
+		{" line\r\nbreak ", false, "line\nbreak"},
+		{"cafe\u0301", true, "caf\u00e9"},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeText(tt.in, tt.singleLine); got != tt.out {
+			t.Errorf("normalizeText(%q, %v) = %q, want %q", tt.in, tt.singleLine, got, tt.out)
+		}
+	}
+}
+
+func TestValidatorText(t *testing.T) {
+	tests := []struct {
+		value string
+		ok    bool
+	}{
+		{"Go & Rust", true},
+		{"Программирование", true},
+		{"x", false},
+		{strings.Repeat("a", maxCategoryNameLength+1), false},
+		{"<script>", false},
+		{"tab\x00", false},
+	}
+
+	for _, tt := range tests {
+		v := new(validator)
+		v.text("name", tt.value, categoryNameRules)
+		if err := v.err(); (err == nil) != tt.ok {
+			t.Errorf("validating %q: unexpected result %v", tt.value, err)
+		}
+	}
+}
+
+func TestValidatorUUID(t *testing.T) {
+	v := new(validator)
+	v.uuid("uid", nilUIDString)
+	v.uuid("postUid", "nay")
+	v.uuid("commentUid", "")
+	if err := v.err(); !hasViolations(err, "postUid", "commentUid") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,268 @@
+package category
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// watchBatchSize is the number of events read at once by report watchers
+	watchBatchSize = 100
+	// eventsSequenceInterval is the time after which listener sequences events without notification, so notifications
+	// lost while listener reconnects and events waiting for a long running transaction without events only delay events
+	eventsSequenceInterval = 30 * time.Second
+	listenerMinReconnect   = time.Second
+	listenerMaxReconnect   = time.Minute
+)
+
+var statusInvalidResumeToken = status.Error(codes.InvalidArgument, "invalid resume token")
+
+// eventHub wakes subscribers when events are emitted by any replica of the service.
+// Hub gives IDs to new events before it wakes subscribers, so subscribers only read events.
+// Notifications only tell that there are new events, subscribers read events themselves
+type eventHub struct {
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{subscribers: make(map[chan struct{}]struct{})}
+}
+
+// listen listens to events channel of database with connString until the process exits.
+// Events are sequenced once listening starts, on every notification and every eventsSequenceInterval
+func (h *eventHub) listen(connString string, sequence func() error) error {
+	listener := pq.NewListener(connString, listenerMinReconnect, listenerMaxReconnect, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("events listener: %v", err)
+		}
+	})
+	if err := listener.Listen(eventsChannel); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(eventsSequenceInterval)
+		defer ticker.Stop()
+		for {
+			// events committed while the service was down or listener reconnected are sequenced too
+			if err := sequence(); err != nil {
+				log.Printf("events sequencing: %v", err)
+			} else {
+				h.notify()
+			}
+
+			select {
+			case <-listener.Notify:
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// subscribe returns channel which gets a value when new events are emitted
+func (h *eventHub) subscribe() chan struct{} {
+	ch := make(chan struct{}, 1)
+	h.mu.Lock()
+	h.subscribers[ch] = struct{}{}
+	h.mu.Unlock()
+	return ch
+}
+
+func (h *eventHub) unsubscribe(ch chan struct{}) {
+	h.mu.Lock()
+	delete(h.subscribers, ch)
+	h.mu.Unlock()
+}
+
+// notify wakes every subscriber, subscribers which weren't woken yet aren't woken twice
+func (h *eventHub) notify() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// reportEvent converts report event to ReportEvent without report and returns routing of the report,
+// it returns nil for other events
+func reportEvent(event *Event) (*pb.ReportEvent, Routing, error) {
+	res := new(pb.ReportEvent)
+	switch event.Type {
+	case EventReportCreated:
+		res.Type = pb.ReportEventType_REPORT_CREATED
+	case EventReportDeleted:
+		res.Type = pb.ReportEventType_REPORT_DELETED
+	default:
+		return nil, "", nil
+	}
+
+	payload := new(reportEventPayload)
+	if err := json.Unmarshal([]byte(event.Payload), payload); err != nil {
+		return nil, "", err
+	}
+
+	res.ReportUid = payload.ReportUID
+	res.CategoryUid = payload.CategoryUID
+
+	createdAtProto, err := ptypes.TimestampProto(event.CreatedAt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res.ResumeToken = strconv.FormatInt(event.ID, 10)
+	res.CreatedAt = createdAtProto
+
+	return res, payload.Routing, nil
+}
+
+// setEventReports sets reports of report creation events. Reports are read as they are now, so report which
+// was deleted or anonymized since is sent without report or with anonymized report. Only reports with given
+// routing are set if it isn't empty
+func (s *Server) setEventReports(events []*pb.ReportEvent, routing Routing) error {
+	created := make(map[string]*pb.ReportEvent)
+	filter := &reportFilter{Routing: routing}
+	for _, event := range events {
+		if event.Type != pb.ReportEventType_REPORT_CREATED {
+			continue
+		}
+
+		uid, err := uuid.Parse(event.ReportUid)
+		if err != nil {
+			return err
+		}
+
+		created[event.ReportUid] = event
+		filter.UIDs = append(filter.UIDs, uid)
+	}
+
+	if len(filter.UIDs) == 0 {
+		return nil
+	}
+
+	reports, err := s.db.getAllReports(filter, reportOrderNewest, int32(len(filter.UIDs)), 0)
+	if err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		event, ok := created[report.UID.String()]
+		if !ok {
+			continue
+		}
+
+		event.Report, err = report.SingleReport()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WatchReports streams creation and deletion of reports of given categories or of all categories as they happen.
+// Every event has resume token, watching with the token of the last received event continues after that event,
+// watching without token starts with events emitted after the call. Caller must be site admin or moderator
+// of the categories, it is checked by gateway. Reports routed to site admins are only streamed when no categories
+// are given
+func (s *Server) WatchReports(req *pb.WatchReportsRequest, stream pb.Category_WatchReportsServer) error {
+	v := new(validator)
+	if len(req.CategoryUids) > maxReportFilterCategories {
+		v.addViolation("categoryUids", fmt.Sprintf("at most %d categories can be given", maxReportFilterCategories))
+	}
+
+	categories := make(map[string]bool, len(req.CategoryUids))
+	for i, categoryUID := range req.CategoryUids {
+		if uid := v.uuid(fmt.Sprintf("categoryUids[%d]", i), categoryUID); uid != uuid.Nil {
+			categories[uid.String()] = true
+		}
+	}
+
+	if err := v.err(); err != nil {
+		return err
+	}
+
+	var routing Routing
+	if len(categories) > 0 {
+		routing = RoutingOwner
+	}
+
+	var afterID int64
+	var err error
+	if req.ResumeToken == "" {
+		afterID, err = s.db.getLastEventID()
+		if err != nil {
+			return internalError(err)
+		}
+	} else {
+		afterID, err = strconv.ParseInt(req.ResumeToken, 10, 64)
+		if err != nil || afterID < 0 {
+			return statusInvalidResumeToken
+		}
+	}
+
+	// subscribe before the first read, so events sequenced after it wake the watcher
+	wake := s.events.subscribe()
+	defer s.events.unsubscribe(wake)
+
+	ctx := stream.Context()
+	for {
+		events, err := s.db.getEvents(afterID, watchBatchSize)
+		if err != nil {
+			return internalError(err)
+		}
+
+		watched := make([]*pb.ReportEvent, 0, len(events))
+		for _, event := range events {
+			afterID = event.ID
+			res, eventRouting, err := reportEvent(event)
+			if err != nil {
+				return internalError(err)
+			}
+
+			if res == nil || (routing != "" && eventRouting != routing) {
+				continue
+			}
+
+			if len(categories) == 0 || categories[res.CategoryUid] {
+				watched = append(watched, res)
+			}
+		}
+
+		if err := s.setEventReports(watched, routing); err != nil {
+			return internalError(err)
+		}
+
+		for _, res := range watched {
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+
+		if len(events) == watchBatchSize {
+			continue
+		}
+
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, ctx.Err().Error())
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package category
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/andreymgn/RSOI-category/pkg/category/proto"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchStream collects events sent by WatchReports and stops watching after expected number of events
+type watchStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	cancel   context.CancelFunc
+	expected int
+	events   []*pb.ReportEvent
+}
+
+func newWatchStream(expected int) *watchStream {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	return &watchStream{ctx: ctx, cancel: cancel, expected: expected}
+}
+
+func (s *watchStream) Send(event *pb.ReportEvent) error {
+	s.events = append(s.events, event)
+	if len(s.events) == s.expected {
+		s.cancel()
+	}
+
+	return nil
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+// adminRoutedReportUID is report of restricted category routed to site admins
+var adminRoutedReportUID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000093"))
+
+func TestWatchReports(t *testing.T) {
+	s := &Server{db: &mockdb{}, events: newEventHub()}
+	stream := newWatchStream(2)
+	req := &pb.WatchReportsRequest{CategoryUids: []string{restrictedUID.String()}, ResumeToken: "1"}
+	err := s.WatchReports(req, stream)
+	if st, _ := status.FromError(err); st.Code() != codes.Canceled {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(stream.events) != 2 {
+		t.Fatalf("unexpected events %v", stream.events)
+	}
+
+	created, deleted := stream.events[0], stream.events[1]
+	if created.Type != pb.ReportEventType_REPORT_CREATED || created.ResumeToken != "2" || created.Report == nil {
+		t.Errorf("unexpected event %v", created)
+	} else if created.Report.Uid != heldReportUID.String() || created.Report.AssigneeUid != moderatorUID.String() {
+		t.Errorf("unexpected report %v", created.Report)
+	}
+
+	if deleted.Type != pb.ReportEventType_REPORT_DELETED || deleted.ReportUid != heldReportUID.String() || deleted.Report != nil {
+		t.Errorf("unexpected event %v", deleted)
+	}
+}
+
+func TestWatchReportsAdminRouted(t *testing.T) {
+	s := &Server{db: &mockdb{}, events: newEventHub()}
+	stream := newWatchStream(1)
+	stream.ctx, stream.cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	req := &pb.WatchReportsRequest{CategoryUids: []string{restrictedUID.String()}, ResumeToken: "4"}
+	s.WatchReports(req, stream)
+	if len(stream.events) != 0 {
+		t.Errorf("unexpected events %v", stream.events)
+	}
+}
+
+func TestWatchReportsAllCategories(t *testing.T) {
+	s := &Server{db: &mockdb{}, events: newEventHub()}
+	stream := newWatchStream(5)
+	s.WatchReports(&pb.WatchReportsRequest{ResumeToken: "0"}, stream)
+	if len(stream.events) != 5 || stream.events[2].CategoryUid != privateUID.String() {
+		t.Fatalf("unexpected events %v", stream.events)
+	}
+
+	created, deleted := stream.events[3], stream.events[4]
+	if created.Report == nil || created.Report.Uid != adminRoutedReportUID.String() {
+		t.Errorf("unexpected event %v", created)
+	}
+
+	if deleted.Type != pb.ReportEventType_REPORT_DELETED || deleted.ReportUid != adminRoutedReportUID.String() {
+		t.Errorf("unexpected event %v", deleted)
+	}
+}
+
+func TestWatchReportsNewEvents(t *testing.T) {
+	s := &Server{db: &mockdb{}, events: newEventHub()}
+	stream := newWatchStream(1)
+	stream.ctx, stream.cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	s.WatchReports(&pb.WatchReportsRequest{}, stream)
+	if len(stream.events) != 0 {
+		t.Errorf("unexpected events %v", stream.events)
+	}
+}
+
+func TestWatchReportsFail(t *testing.T) {
+	s := &Server{db: &mockdb{}, events: newEventHub()}
+	err := s.WatchReports(&pb.WatchReportsRequest{CategoryUids: []string{"not uuid"}}, newWatchStream(1))
+	if !hasViolations(err, "categoryUids[0]") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	err = s.WatchReports(&pb.WatchReportsRequest{ResumeToken: "abc"}, newWatchStream(1))
+	if err != statusInvalidResumeToken {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestEventHub(t *testing.T) {
+	h := newEventHub()
+	first := h.subscribe()
+	second := h.subscribe()
+	h.unsubscribe(second)
+	h.notify()
+	h.notify()
+
+	select {
+	case <-first:
+	default:
+		t.Errorf("subscriber wasn't woken")
+	}
+
+	select {
+	case <-first:
+		t.Errorf("subscriber was woken twice")
+	case <-second:
+		t.Errorf("unsubscribed subscriber was woken")
+	default:
+	}
+}